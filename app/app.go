@@ -6,21 +6,369 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	blk "github.com/denniswon/validationcloud/app/block"
+	"github.com/denniswon/validationcloud/app/chain"
+	cfg "github.com/denniswon/validationcloud/app/config"
+	d "github.com/denniswon/validationcloud/app/data"
+	"github.com/denniswon/validationcloud/app/db"
+	"github.com/go-redis/redis/v8"
 	"github.com/gookit/color"
+	"gorm.io/gorm"
 
 	"github.com/denniswon/validationcloud/app/rest"
 )
 
-// Run - Application to be invoked from main runner using this function
+// Run - Application to be invoked from main runner using this function, indexes
+// all configured chains & serves API, in same process
 func Run(configFile string) {
 
 	ctx, cancel := context.WithCancel(context.Background())
-	_connection, _redisClient, _redisInfo, _db, _status, _queue := bootstrap(configFile)
+	_chains, _redisClient, _db := bootstrap(configFile)
+
+	shutdownOnInterrupt(cancel, _db, _redisClient)
+
+	startIndexing(ctx, _db, _chains)
+
+	// Starting http server on main thread
+	rest.RunHTTPServer(_db, _chains, _redisClient, true)
+
+}
+
+// Serve - Serves API only, while blocks are indexed by some other instance, running `index`,
+// so that both can be scaled separately
+//
+// Latest block & finality are still tracked, so that sync state & finality of blocks
+// can be reported, while block processor queue starts empty, without its state being
+// persisted, as it's owned by indexer. Dead letters are read from what indexer
+// persists & admin API(s) which change state of queue, are not served
+func Serve(configFile string) {
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	bootstrapConfig(configFile)
+
+	_redisClient := bootstrapRedis(false)
+	_db := bootstrapDB()
+	_chains := bootstrapChains(_db, _redisClient, false)
+
+	shutdownOnInterrupt(cancel, _db, _redisClient)
+
+	for _, _chain := range _chains {
+
+		go _chain.Queue.Start(ctx)
+
+		monitorNodes(ctx, _chain)
+
+		if _chain.Queue.FinalityTags {
+			go blk.TrackFinality(ctx, _chain.Connection, _chain.Status, _chain.Queue)
+		}
+
+		go blk.TrackHead(ctx, _chain.Connection, _chain.Status, _chain.Queue)
+
+	}
+
+	rest.RunHTTPServer(_db, _chains, _redisClient, false)
+
+}
+
+// Index - Indexes all configured chains, without serving API, runs until interrupted
+func Index(configFile string) {
+
+	ctx, cancel := context.WithCancel(context.Background())
+	_chains, _redisClient, _db := bootstrap(configFile)
+
+	shutdownOnInterrupt(cancel, _db, _redisClient)
+
+	startIndexing(ctx, _db, _chains)
+
+	// Blocking main thread, process exits on being interrupted
+	select {}
+
+}
+
+// Backfill - Fetches & persists all blocks in range(from, to), both inclusive, which
+// are missing in DB, retrying failed ones, & exits once done
+//
+// Processed blocks are not confirmed, so range is expected to be well below
+// finalized head, recent blocks being taken care of by indexer
+func Backfill(configFile string, chainKey string, from uint64, to uint64) {
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	bootstrapConfig(configFile)
+
+	_redisClient := bootstrapRedis(false)
+	_db := bootstrapDB()
+
+	// Queue starts empty, so that blocks being retried by indexer are not touched
+	_chain := findChain(bootstrapChains(_db, _redisClient, false), chainKey)
+
+	shutdownOnInterrupt(cancel, _db, _redisClient)
+
+	go _chain.Queue.Start(ctx)
+
+	// Finality of blocks is learnt once, only finalized ones are written in bulk
+	latest, err := _chain.Connection.RPC.BlockNumber(ctx)
+	if err != nil {
+		log.Fatalf("[!] Failed to fetch latest block number : %s\n", err.Error())
+	}
+
+	blk.FollowHead(_chain.Status, _chain.Queue, latest)
+
+	if _chain.Queue.FinalityTags {
+		if err := blk.UpdateFinality(ctx, _chain.Connection, _chain.Status, _chain.Queue); err != nil {
+			log.Printf("[!] Failed to fetch finalized block, writing blocks one by one : %s\n", err.Error())
+		}
+	}
+
+	log.Printf("[*] Backfilling blocks [%d, %d] of chain %s\n", from, to, _chain.Label())
+
+	_chain.Status.SetStartedAt()
+
+	blk.SyncRange(_chain.Connection, _db, _chain.Redis, _chain.Queue, from, to, _chain.Status)
+	blk.RetryUntilDone(_chain.Connection, _db, _chain.Redis, _chain.Queue, _chain.Status)
+
+	deadLetters := _chain.Queue.DeadLetters()
+
+	cancel()
+	release(_db, _redisClient)
+
+	for _, v := range deadLetters {
+		log.Print(color.Red.Sprintf("[!] Gave up on block %d after %d attempt(s) : %s", v.Number, v.Attempts, v.LastError))
+	}
+
+	if len(deadLetters) != 0 {
+		log.Fatalf("[!] Failed to backfill %d block(s)\n", len(deadLetters))
+	}
+
+	log.Print(color.Green.Sprintf("[+] Backfilled %d block(s) in %s", _chain.Status.Done(), _chain.Status.ElapsedTime()))
+
+}
+
+// Verify - Checks whether all blocks in range(from, to), both inclusive, are present in DB
+// & match canonical chain, as seen by node, exits with non-zero status if they don't
+func Verify(configFile string, chainKey string, from uint64, to uint64) {
+
+	bootstrapConfig(configFile)
+
+	_db := bootstrapDB()
+	_chain := findChain(bootstrapChains(_db, nil, false), chainKey)
+
+	log.Printf("[*] Verifying blocks [%d, %d] of chain %s\n", from, to, _chain.Label())
+
+	mismatches, err := blk.VerifyBlocksByRange(_chain.Connection, _db, from, to)
+
+	release(_db, nil)
+
+	if err != nil {
+		log.Fatalf("[!] Failed to verify blocks : %s\n", err.Error())
+	}
+
+	for _, v := range mismatches {
+		log.Print(color.Red.Sprintf("[!] Block %d : %s", v.Number, v.Reason))
+	}
+
+	if len(mismatches) != 0 {
+		log.Fatalf("[!] %d of %d block(s) don't match\n", len(mismatches), to-from+1)
+	}
+
+	log.Print(color.Green.Sprintf("[+] All %d block(s) match", to-from+1))
+
+}
+
+// VerifyStored - Checks integrity of stored blocks in range(from, to), both inclusive, by
+// rebuilding their tx & receipt tries, along with parent hash links, without asking node,
+// exits with non-zero status if any of them is inconsistent
+//
+// When asked to requeue, inconsistent blocks are deleted & processed afresh,
+// before exiting, while ones which couldn't be verified are only reported
+func VerifyStored(configFile string, chainKey string, from uint64, to uint64, requeue bool) {
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	bootstrapConfig(configFile)
+
+	// Only needed when blocks are to be processed again
+	var _redisClient *redis.Client
+	if requeue {
+		_redisClient = bootstrapRedis(false)
+	}
+
+	_db := bootstrapDB()
+	_chain := findChain(bootstrapChains(_db, _redisClient, false), chainKey)
+
+	shutdownOnInterrupt(cancel, _db, _redisClient)
+
+	log.Printf("[*] Checking integrity of blocks [%d, %d] of chain %s\n", from, to, _chain.Label())
+
+	mismatches, unverifiable, err := blk.CheckIntegrity(_db, _chain.ID, from, to)
+	if err != nil {
+		release(_db, _redisClient)
+		log.Fatalf("[!] Failed to check integrity of blocks : %s\n", err.Error())
+	}
+
+	// Not known to be inconsistent, so neither failing nor requeued
+	for _, v := range unverifiable {
+		log.Print(color.Yellow.Sprintf("[!] Block %d can't be verified : %s", v.Number, v.Reason))
+	}
+
+	for _, v := range mismatches {
+		log.Print(color.Red.Sprintf("[!] Block %d : %s", v.Number, v.Reason))
+	}
+
+	if len(mismatches) == 0 {
+		release(_db, _redisClient)
+		log.Print(color.Green.Sprintf("[+] All verifiable stored block(s) consistent, %d couldn't be verified", len(unverifiable)))
+		return
+	}
+
+	if !requeue {
+		release(_db, _redisClient)
+		log.Fatalf("[!] %d block(s) inconsistent\n", len(mismatches))
+	}
+
+	go _chain.Queue.Start(ctx)
+
+	requeued, err := blk.RequeueInconsistentBlocks(_db, _chain.ID, _chain.Queue, mismatches)
+	if err != nil {
+		release(_db, _redisClient)
+		log.Fatalf("[!] Failed to requeue inconsistent blocks : %s\n", err.Error())
+	}
+
+	log.Printf("[*] Requeued %d inconsistent block(s)\n", requeued)
+
+	blk.RetryUntilDone(_chain.Connection, _db, _chain.Redis, _chain.Queue, _chain.Status)
+
+	deadLetters := _chain.Queue.DeadLetters()
+
+	cancel()
+	release(_db, _redisClient)
+
+	for _, v := range deadLetters {
+		log.Print(color.Red.Sprintf("[!] Gave up on block %d after %d attempt(s) : %s", v.Number, v.Attempts, v.LastError))
+	}
+
+	if len(deadLetters) != 0 {
+		log.Fatalf("[!] Failed to process %d block(s) afresh\n", len(deadLetters))
+	}
+
+	log.Print(color.Green.Sprintf("[+] Processed %d inconsistent block(s) afresh", requeued))
+
+}
+
+// Migrate - Migrates database schema to latest & exits
+func Migrate(configFile string) {
+
+	bootstrapConfig(configFile)
+
+	_db := db.Connect()
+	release(_db, nil)
+
+	log.Print(color.Green.Sprintf("[+] Migrated database schema"))
+
+}
+
+// Prune - Prunes old blocks of all configured chains or only given one, once,
+// as per configured `PruneDepth` & `PruneAge`, & exits
+func Prune(configFile string, chainKey string) {
+
+	bootstrapConfig(configFile)
+
+	if !cfg.IsPruningEnabled() {
+		log.Fatalf("[!] Neither `PruneDepth` nor `PruneAge` configured\n")
+	}
+
+	_db := bootstrapDB()
+
+	_chains := bootstrapChains(_db, nil, false)
+	if chainKey != "" {
+		_chains = d.Chains{findChain(_chains, chainKey)}
+	}
+
+	defer release(_db, nil)
+
+	for _, _chain := range _chains {
+
+		// Depth is counted from latest block
+		latest, err := _chain.Connection.RPC.BlockNumber(context.Background())
+		if err != nil {
+			log.Fatalf("[!] Failed to fetch latest block number of chain %s : %s\n", _chain.Label(), err.Error())
+		}
+
+		_chain.Status.SetLatestBlockNumber(latest)
+
+		pruned, err := blk.Prune(_db, _chain.ID, _chain.Status, time.Now().UTC())
+		if err != nil {
+			log.Fatalf("[!] Failed to prune blocks of chain %s : %s\n", _chain.Label(), err.Error())
+		}
+
+		log.Print(color.Green.Sprintf("[+] Pruned %d block(s) of chain %s below %d", pruned, _chain.Label(), _chain.Status.PrunedBelow()))
+
+	}
+
+}
+
+// startIndexing - Starts processing blocks of each chain, independently, while
+// sharing same database
+func startIndexing(ctx context.Context, _db *gorm.DB, _chains d.Chains) {
+
+	for _, _chain := range _chains {
+
+		go _chain.Queue.Start(ctx)
+
+		monitorNodes(ctx, _chain)
+
+		// Keeping only configured window of blocks in database
+		if cfg.IsPruningEnabled() {
+			go blk.Pruner(ctx, _db, _chain.ID, _chain.Status)
+		}
+
+		// Keeping track of node's `safe` & `finalized` heads, when those
+		// are used for deciding finality
+		if _chain.Queue.FinalityTags {
+			go blk.TrackFinality(ctx, _chain.Connection, _chain.Status, _chain.Queue)
+		}
+
+		// Pushing block header propagation listener to another thread of execution
+		go blk.SubscribeToNewBlocks(_chain.Connection, _db, _chain.Status, _chain.Redis, _chain.Queue)
+
+	}
+
+}
+
+// monitorNodes - Keeping an eye on health of blockchain node endpoints, so that
+// calls get routed to healthiest one
+func monitorNodes(ctx context.Context, _chain *d.Chain) {
+
+	interval := time.Duration(cfg.GetNodeHealthCheckInterval()) * time.Second
+
+	for _, v := range []chain.ChainSource{_chain.Connection.RPC, _chain.Connection.Websocket} {
+		if pool, ok := v.(*chain.Pool); ok {
+			go pool.Monitor(ctx, interval)
+		}
+	}
+
+}
+
+// findChain - Chain to be worked on, identified by either name or chain ID,
+// first configured one if not given
+func findChain(_chains d.Chains, key string) *d.Chain {
+
+	_chain := _chains.Find(key)
+	if _chain == nil {
+		log.Fatalf("[!] Chain %s not configured\n", key)
+	}
+
+	return _chain
+
+}
+
+// shutdownOnInterrupt - Attempting to listen to Ctrl+C signal
+// and when received gracefully shutting down the service
+func shutdownOnInterrupt(cancel context.CancelFunc, _db *gorm.DB, _redisClient *redis.Client) {
 
-	// Attempting to listen to Ctrl+C signal
-	// and when received gracefully shutting down the service
 	interruptChan := make(chan os.Signal, 1)
 	signal.Notify(interruptChan, syscall.SIGTERM, syscall.SIGINT)
 
@@ -39,19 +387,7 @@ func Run(configFile string) {
 		// @note This can ( needs to ) be improved
 		cancel()
 
-		sql, err := _db.DB()
-		if err != nil {
-			log.Print(color.Red.Sprintf("[!] Failed to get underlying DB connection : %s", err.Error()))
-			return
-		}
-
-		if err := sql.Close(); err != nil {
-			log.Print(color.Red.Sprintf("[!] Failed to close underlying DB connection : %s", err.Error()))
-			return
-		}
-
-		if err := _redisInfo.Client.Close(); err != nil {
-			log.Print(color.Red.Sprintf("[!] Failed to close connection to Redis : %s", err.Error()))
+		if !release(_db, _redisClient) {
 			return
 		}
 
@@ -61,12 +397,32 @@ func Run(configFile string) {
 
 	}()
 
-	go _queue.Start(ctx)
+}
 
-	// Pushing block header propagation listener to another thread of execution
-	go blk.SubscribeToNewBlocks(_connection, _db, _status, _redisInfo, _queue)
+// release - Closes connections to database & redis, if any, returns
+// whether all of them got closed
+func release(_db *gorm.DB, _redisClient *redis.Client) bool {
 
-	// Starting http server on main thread
-	rest.RunHTTPServer(_db, _status, _redisClient)
+	sql, err := _db.DB()
+	if err != nil {
+		log.Print(color.Red.Sprintf("[!] Failed to get underlying DB connection : %s", err.Error()))
+		return false
+	}
+
+	if err := sql.Close(); err != nil {
+		log.Print(color.Red.Sprintf("[!] Failed to close underlying DB connection : %s", err.Error()))
+		return false
+	}
+
+	if _redisClient == nil {
+		return true
+	}
+
+	if err := _redisClient.Close(); err != nil {
+		log.Print(color.Red.Sprintf("[!] Failed to close connection to Redis : %s", err.Error()))
+		return false
+	}
+
+	return true
 
 }
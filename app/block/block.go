@@ -2,40 +2,105 @@ package block
 
 import (
 	"log"
-	"runtime"
 	"time"
 
 	cfg "github.com/denniswon/validationcloud/app/config"
 	d "github.com/denniswon/validationcloud/app/data"
 	"github.com/denniswon/validationcloud/app/db"
 	q "github.com/denniswon/validationcloud/app/queue"
+	"github.com/denniswon/validationcloud/app/watchlist"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/ethclient"
-	"github.com/gammazero/workerpool"
+	"github.com/gookit/color"
 	"gorm.io/gorm"
 )
 
 // ProcessBlockContent - Processes everything inside this block i.e. block data, tx data, event data
-func ProcessBlockContent(client *ethclient.Client, block *types.Block, _db *gorm.DB, redis *d.RedisInfo, publishable bool, queue *q.BlockProcessorQueue, status *d.StatusHolder, startingAt time.Time) bool {
+func ProcessBlockContent(connection *d.BlockChainNodeConnection, block *types.Block, _db *gorm.DB, redis *d.RedisInfo, publishable bool, queue *q.BlockProcessorQueue, status *d.StatusHolder, startingAt time.Time) bool {
+
+	packedBlock, ok := PackBlockContent(connection, block, _db, redis, publishable, queue, status)
+	if !ok {
+		return false
+	}
+
+	if !StoreBlockContent(_db, redis, packedBlock, status, queue) {
+		return false
+	}
+
+	// Successfully processed block
+	if block.NumberU64() % 1000 == 0 {
+		log.Printf("Block %d with %d tx(s) [ Took : %s ]\n", block.NumberU64(), len(packedBlock.Transactions), time.Now().UTC().Sub(startingAt))
+	}
+	status.IncrementBlocksProcessed()
+
+	return true
+
+}
+
+// StoreBlockContent - Persists packed block, if some other block got replaced by it, while
+// it was being packed, orphaned blocks rolled back by then, get retracted from pubsub topics
+func StoreBlockContent(_db *gorm.DB, redis *d.RedisInfo, packedBlock *db.PackedBlock, status *d.StatusHolder, queue *q.BlockProcessorQueue) bool {
+
+	orphaned, err := db.StoreBlock(_db, packedBlock, status, queue)
+	if err != nil {
+
+		log.Printf("Failed to process block %d : %s\n", packedBlock.Block.Number, err.Error())
+
+		queue.Errored(packedBlock.Block.Number, err)
+		return false
+
+	}
+
+	if len(orphaned) != 0 && !RetractBlocks(_db, packedBlock.Block.Chain, redis) {
+		log.Print(color.Red.Sprintf("[!] Failed to retract %d orphaned block(s) from %d, to be retried", len(orphaned), packedBlock.Block.Number))
+	}
+
+	return true
+
+}
+
+// PackBlockContent - Fetches everything inside this block i.e. tx data, event data, publishing
+// it if required, & packs it along with block data, ready to be persisted
+func PackBlockContent(connection *d.BlockChainNodeConnection, block *types.Block, _db *gorm.DB, redis *d.RedisInfo, publishable bool, queue *q.BlockProcessorQueue, status *d.StatusHolder) (*db.PackedBlock, bool) {
+
+	// If block at this height got replaced due to chain reorganization, orphaned
+	// data is rolled back & retracted, before new one gets published
+	replaced, err := RollbackReplacedBlock(block, _db, connection.ChainID, redis, queue, status)
+	if err != nil {
+
+		log.Printf("Failed to rollback replaced block %d : %s\n", block.NumberU64(), err.Error())
+
+		queue.Errored(block.NumberU64(), err)
+		return nil, false
+
+	}
 
 	// Closure managing publishing whole block data i.e. block header, txn(s), event logs on redis pubsub channel
 	pubsubWorker := func(txns []*db.PackedTransaction) (*db.PackedBlock, bool) {
 
 		// Constructing block data to published & persisted
 		packedBlock := BuildPackedBlock(block, txns)
+		packedBlock.OnChain(connection.ChainID)
 
-		if (publishable) {
+		if (publishable || replaced) {
 			// -- 3 step pub/sub attempt
 			//
 			// Attempting to publish whole block data to redis pubsub channel
 
 			// 1. Asking queue whether we need to publish block or not
-			if !queue.CanPublish(block.NumberU64()) {
+			//
+			// Replacement of retracted block always needs to be published
+			if !replaced && !queue.CanPublish(block.NumberU64()) {
 				return packedBlock, true
 			}
 
-			// 2. Attempting to publish block on Pub/Sub topic
-			if !PublishBlock(packedBlock, redis) {
+			// 2. Attempting to publish block on Pub/Sub topic, after retractions
+			// which failed earlier, so that subscribers never see block of
+			// new branch, before orphaned one is retracted
+			if !RetractPending(_db, connection.ChainID, redis) {
+				return nil, false
+			}
+
+			if !PublishBlock(packedBlock, status.FinalityOf(block.NumberU64()), false, redis) {
 				return nil, false
 			}
 
@@ -51,123 +116,88 @@ func ProcessBlockContent(client *ethclient.Client, block *types.Block, _db *gorm
 
 	}
 
-	if block.Transactions().Len() == 0 {
+	// In watchlist mode, if none of tx(s) can touch watched addresses,
+	// it's processed as if it doesn't contain any tx
+	watching := cfg.IsWatchlistModeEnabled()
+
+	var watched *watchlist.Set
+	if watching {
+		watched = watchlist.Current(_db)
+	}
+
+	if block.Transactions().Len() == 0 || (watching && !MayTouchWatchlist(block, watched)) {
 
 		// Constructing block data to be persisted
 		//
 		// This is what we just published on pubsub channel
-		packedBlock, ok := pubsubWorker(nil)
-		if !ok {
-			return false
-		}
+		return pubsubWorker(nil)
 
-		// If block doesn't contain any tx, we'll attempt to persist only block
-		if err := db.StoreBlock(_db, packedBlock, status, queue); err != nil {
+	}
 
-			log.Printf("Failed to process block %d : %s\n", block.NumberU64(), err.Error())
-			return false
+	var packedTxs []*db.PackedTransaction
 
-		}
+	if cfg.GetReceiptFetchMode() == "single" {
 
-		// Successfully processed block
-		if block.NumberU64() % 1000 == 0 {
-			log.Printf("Block %d with 0 tx(s) [ Took : %s ]\n", block.NumberU64(), time.Now().UTC().Sub(startingAt))
+		_packedTxs, ok := FetchTransactionsOneByOne(connection.RPC, block, _db, redis, status, queue)
+		if !ok {
+			return nil, false
 		}
-		status.IncrementBlocksProcessed()
 
-		return true
+		packedTxs = _packedTxs
 
-	}
-
-	// Communication channel to be shared between multiple executing go routines
-	// which are trying to fetch all tx(s) present in block, concurrently
-	returnValChan := make(chan *db.PackedTransaction, runtime.NumCPU() * int(cfg.GetConcurrencyFactor()))
+	} else {
 
-	// -- Tx processing starting
-	// Creating job processor queue which will process all tx(s), concurrently
-	wp := workerpool.New(runtime.NumCPU() * int(cfg.GetConcurrencyFactor()))
+		// Receipts of all tx(s) fetched together, while senders are
+		// derived locally, saving us lots of round trips
+		_packedTxs, err := FetchTransactionsOfBlock(connection.RPC, block)
+		if err != nil {
 
-	// Concurrently trying to process all tx(s) for this block, in hope of better performance
-	for _, v := range block.Transactions() {
+			log.Printf("Failed to fetch tx(s) of block %d : %s\n", block.NumberU64(), err.Error())
 
-		// Concurrently trying to fetch multiple tx(s) present in block
-		// and expecting their return value to be published on shared channel
-		//
-		// Which is being read 👇
-		func(tx *types.Transaction) {
-			wp.Submit(func() {
+			queue.Errored(block.NumberU64(), err)
+			return nil, false
 
-				FetchTransactionByHash(client,
-					block,
-					tx,
-					_db,
-					redis,
-					status,
-					returnValChan)
+		}
 
-			})
-		}(v)
+		packedTxs = _packedTxs
 
 	}
 
-	// Keeping track of how many of these tx fetchers succeded & how many of them failed
-	result := d.ResultStatus{}
-	// Data received from tx fetchers, to be stored here
-	packedTxs := make([]*db.PackedTransaction, block.Transactions().Len())
+	// Optionally, calls made during tx execution are traced, so that internal
+	// value transfers & contract creations are also indexed
+	if cfg.IsCallTracingEnabled() {
 
-	for v := range returnValChan {
-		if v != nil {
-			result.Success++
-		} else {
-			result.Failure++
-		}
+		if err := FetchTracesOfBlock(connection.RPC, connection.ChainID, block, packedTxs); err != nil {
 
-		// #-of tx fetchers completed their job till now
-		//
-		// Either successfully or failed some how
-		total := int(result.Total())
-		// Storing tx data received from just completed go routine
-		packedTxs[total-1] = v
-
-		// All go routines have completed their job
-		if total == block.Transactions().Len() {
-			break
-		}
-	}
+			log.Printf("Failed to fetch call traces of block %d : %s\n", block.NumberU64(), err.Error())
 
-	// Stopping job processor forcefully
-	// because by this time all jobs have been completed
-	//
-	// Otherwise control flow will not be able to come here
-	// it'll keep looping in 👆 loop, reading from channel
-	wp.Stop()
-	// -- Tx processing ending
+			queue.Errored(block.NumberU64(), err)
+			return nil, false
+
+		}
 
-	if !(result.Failure == 0) {
-		return false
 	}
 
-	// Constructing block data to be persisted
-	//
-	// This is what we just published on pubsub channel
-	packedBlock, ok := pubsubWorker(packedTxs)
-	if !ok {
-		return false
+	// Only tx(s) & event(s) touching watched addresses are
+	// persisted & published
+	if watching {
+		packedTxs = FilterByWatchlist(packedTxs, watched)
 	}
 
-	// If block doesn't contain any tx, we'll attempt to persist only block
-	if err := db.StoreBlock(_db, packedBlock, status, queue); err != nil {
+	// Contracts deployed in this block get registered, while proxies which
+	// got upgraded get their implementation refreshed
+	if err := FetchContractsOfBlock(connection.RPC, block, packedTxs); err != nil {
 
-		log.Printf("Failed to process block %d : %s\n", block.NumberU64(), err.Error())
-		return false
+		log.Printf("Failed to fetch contracts of block %d : %s\n", block.NumberU64(), err.Error())
 
-	}
+		queue.Errored(block.NumberU64(), err)
+		return nil, false
 
-	// Successfully processed block
-	if block.NumberU64() % 1000 == 0 {
-		log.Printf("Block %d with %d tx(s) [ Took : %s ]\n", block.NumberU64(), block.Transactions().Len(), time.Now().UTC().Sub(startingAt))
 	}
-	status.IncrementBlocksProcessed()
-	return true
+
+	// Constructing block data to be persisted
+	//
+	// This is what we just published on pubsub channel
+	return pubsubWorker(packedTxs)
 
 }
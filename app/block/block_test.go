@@ -0,0 +1,322 @@
+package block
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/denniswon/validationcloud/app/chain"
+	"github.com/denniswon/validationcloud/app/db"
+	q "github.com/denniswon/validationcloud/app/queue"
+	"github.com/ethereum/go-ethereum/core/types"
+	"gorm.io/gorm"
+)
+
+// processAll - Runs blocks through pipeline, same way listener does
+func processAll(t *testing.T, fake *chain.FakeChain, _db *gorm.DB, publishable bool, queue *q.BlockProcessorQueue, blocks []*types.Block) {
+
+	connection := newTestConnection(fake)
+	status := newTestStatus()
+	info := newTestRedis(t)
+
+	for _, v := range blocks {
+
+		queue.Put(v.NumberU64())
+
+		if !ProcessBlockContent(connection, v, _db, info, publishable, queue, status, time.Now().UTC()) {
+			t.Fatalf("failed to process block %d", v.NumberU64())
+		}
+
+		queue.UnconfirmedDone(v.NumberU64())
+
+	}
+
+}
+
+// countRows - How many rows present in table, backing given model
+func countRows(t *testing.T, _db *gorm.DB, model interface{}) int64 {
+
+	var count int64
+	if err := _db.Model(model).Count(&count).Error; err != nil {
+		t.Fatalf("failed to count rows : %s", err.Error())
+	}
+
+	return count
+
+}
+
+func TestProcessBlockContent(t *testing.T) {
+
+	_db := newTestDB(t)
+	fake := chain.NewFakeChain()
+
+	blocks := append(fake.Extend(3, 2), fake.Extend(1, 0)...)
+	processAll(t, fake, _db, false, newTestQueue(t), blocks)
+
+	for _, v := range blocks {
+
+		stored := db.GetBlock(_db, testChainID, v.NumberU64())
+		if stored == nil || stored.Hash != v.Hash().Hex() {
+			t.Fatalf("block %d not persisted", v.NumberU64())
+		}
+
+	}
+
+	if count := countRows(t, _db, &db.Transactions{}); count != 6 {
+		t.Fatalf("expected 6 tx(s), got %d", count)
+	}
+
+	if count := countRows(t, _db, &db.Events{}); count != 6 {
+		t.Fatalf("expected 6 event(s), got %d", count)
+	}
+
+}
+
+func TestProcessBlockContentMultipleChains(t *testing.T) {
+
+	_db := newTestDB(t)
+	fake := chain.NewFakeChain()
+	queue := newTestQueue(t)
+
+	blocks := fake.Extend(2, 1)
+	processAll(t, fake, _db, false, queue, blocks)
+
+	// Same blocks, as seen on another chain, mustn't collide with
+	// already indexed ones
+	other := newTestConnection(fake)
+	other.ChainID = testChainID + 1
+
+	for _, v := range blocks {
+
+		if !ProcessBlockContent(other, v, _db, nil, false, queue, newTestStatus(), time.Now().UTC()) {
+			t.Fatalf("failed to process block %d on another chain", v.NumberU64())
+		}
+
+	}
+
+	for _, chainID := range []uint64{testChainID, testChainID + 1} {
+
+		if count := db.GetBlockCount(_db, chainID); count != 2 {
+			t.Fatalf("expected 2 block(s) on chain %d, got %d", chainID, count)
+		}
+
+		if tx := db.GetTransactionsByBlockNumber(_db, chainID, 1); tx == nil || len(tx.Transactions) != 1 || tx.Transactions[0].Chain != chainID {
+			t.Fatalf("expected tx of block 1 on chain %d", chainID)
+		}
+
+	}
+
+	if count := countRows(t, _db, &db.Transactions{}); count != 4 {
+		t.Fatalf("expected 4 tx(s), got %d", count)
+	}
+
+}
+
+func TestProcessBlockContentMissingReceipt(t *testing.T) {
+
+	_db := newTestDB(t)
+	fake := chain.NewFakeChain()
+	queue := newTestQueue(t)
+
+	block := fake.Extend(1, 3)[0]
+	fake.WithholdReceipt(block.Transactions()[1].Hash(), true)
+
+	queue.Put(block.NumberU64())
+
+	if ProcessBlockContent(newTestConnection(fake), block, _db, nil, false, queue, newTestStatus(), time.Now().UTC()) {
+		t.Fatalf("expected block with missing receipt to fail")
+	}
+
+	if db.GetBlock(_db, testChainID, block.NumberU64()) != nil {
+		t.Fatalf("block with missing receipt got persisted")
+	}
+
+}
+
+func TestProcessBlockContentReplacedBlock(t *testing.T) {
+
+	_db := newTestDB(t)
+	fake := chain.NewFakeChain()
+	queue := newTestQueue(t)
+	info := newTestRedis(t)
+
+	processAll(t, fake, _db, true, queue, fake.Extend(3, 1))
+
+	branch, err := fake.Fork(2, 2, 1)
+	if err != nil {
+		t.Fatalf("failed to fork : %s", err.Error())
+	}
+
+	messages := subscribeAll(t, info)
+	status := newTestStatus()
+
+	if !ProcessBlockContent(newTestConnection(fake), branch[0], _db, info, true, queue, status, time.Now().UTC()) {
+		t.Fatalf("failed to process replacement block")
+	}
+
+	stored := db.GetBlock(_db, testChainID, 2)
+	if stored == nil || stored.Hash != branch[0].Hash().Hex() {
+		t.Fatalf("block 2 not replaced")
+	}
+
+	if db.GetBlock(_db, testChainID, 3) != nil {
+		t.Fatalf("orphaned descendant block 3 not rolled back")
+	}
+
+	if count := countRows(t, _db, &db.Reorgs{}); count != 1 {
+		t.Fatalf("expected 1 reorg recorded, got %d", count)
+	}
+
+	if status.State.BlocksRemoved != 2 {
+		t.Fatalf("expected 2 blocks removed, got %d", status.State.BlocksRemoved)
+	}
+
+	// Retractions of block 3 & 2, each with 1 tx & 1 event, followed by replacement
+	received := receive(t, messages, 9)
+
+	for k, v := range received {
+
+		var payload struct {
+			Removed bool `json:"removed"`
+		}
+
+		if err := json.Unmarshal([]byte(v.Payload), &payload); err != nil {
+			t.Fatalf("bad payload on %s : %s", v.Channel, err.Error())
+		}
+
+		if payload.Removed != (k < 6) {
+			t.Fatalf("message %d on %s has wrong `removed` flag", k, v.Channel)
+		}
+
+	}
+
+}
+
+func TestHandleChainReorgShorterBranch(t *testing.T) {
+
+	_db := newTestDB(t)
+	fake := chain.NewFakeChain()
+	queue := newTestQueue(t)
+	info := newTestRedis(t)
+
+	processAll(t, fake, _db, true, queue, fake.Extend(4, 1))
+
+	// New branch, with head at 3, replaces 2, 3 & 4
+	branch, err := fake.Fork(2, 2, 1)
+	if err != nil {
+		t.Fatalf("failed to fork : %s", err.Error())
+	}
+
+	status := newTestStatus()
+
+	if !HandleChainReorg(newTestConnection(fake), _db, info, queue, status, branch[1].Header()) {
+		t.Fatalf("expected chain reorganization to be handled")
+	}
+
+	if db.GetBlock(_db, testChainID, 4) != nil {
+		t.Fatalf("orphaned block 4, above new head, not rolled back")
+	}
+
+	if stored := db.GetBlock(_db, testChainID, 2); stored == nil || stored.Hash != branch[0].Hash().Hex() {
+		t.Fatalf("block 2 not replaced")
+	}
+
+	if status.State.BlocksRemoved != 3 {
+		t.Fatalf("expected 3 blocks removed, got %d", status.State.BlocksRemoved)
+	}
+
+	var reorg db.Reorgs
+	if err := _db.Where("chain_id = ?", testChainID).First(&reorg).Error; err != nil {
+		t.Fatalf("failed to find reorg : %s", err.Error())
+	}
+
+	if reorg.Number != 2 || reorg.Depth != 3 {
+		t.Fatalf("expected reorg of depth 3 from block 2, got %d from %d", reorg.Depth, reorg.Number)
+	}
+
+}
+
+func TestStoreBlockContentReplacedBlock(t *testing.T) {
+
+	_db := newTestDB(t)
+	fake := chain.NewFakeChain()
+	queue := newTestQueue(t)
+	info := newTestRedis(t)
+
+	packed := packBlocks(t, fake, 3, 1)
+
+	for _, v := range packed {
+
+		v.OnChain(testChainID)
+
+		if _, err := db.StoreBlock(_db, v, nil, nil); err != nil {
+			t.Fatalf("failed to store block : %s", err.Error())
+		}
+
+	}
+
+	// Deployed in block, which is to be orphaned
+	contract := &db.Contracts{Chain: testChainID, Address: "0x4774fEd3f2838f504006BE53155cA9cbDDEe9f0c", BlockHash: packed[2].Block.Hash, BlockNumber: 3}
+	if err := db.UpsertContract(_db, contract); err != nil {
+		t.Fatalf("failed to put contract : %s", err.Error())
+	}
+
+	branch, err := fake.Fork(2, 1, 1)
+	if err != nil {
+		t.Fatalf("failed to fork : %s", err.Error())
+	}
+
+	packedTxs, err := FetchTransactionsOfBlock(fake, branch[0])
+	if err != nil {
+		t.Fatalf("failed to fetch tx(s) : %s", err.Error())
+	}
+
+	// Packed as if replaced block wasn't yet in DB, so it's
+	// found to be replaced only while being stored
+	replacement := BuildPackedBlock(branch[0], packedTxs)
+	replacement.OnChain(testChainID)
+
+	messages := subscribeAll(t, info)
+
+	if !StoreBlockContent(_db, info, replacement, newTestStatus(), queue) {
+		t.Fatalf("failed to store replacement block")
+	}
+
+	if stored := db.GetBlock(_db, testChainID, 2); stored == nil || stored.Hash != branch[0].Hash().Hex() {
+		t.Fatalf("block 2 not replaced")
+	}
+
+	// Retractions of block 3 & 2, each with 1 tx & 1 event
+	retracted := make([]uint64, 0, 2)
+
+	for _, v := range receive(t, messages, 6) {
+
+		var payload struct {
+			Number  uint64 `json:"number"`
+			Removed bool   `json:"removed"`
+		}
+
+		if err := json.Unmarshal([]byte(v.Payload), &payload); err != nil {
+			t.Fatalf("bad payload on %s : %s", v.Channel, err.Error())
+		}
+
+		if !payload.Removed {
+			t.Fatalf("message on %s not marked removed", v.Channel)
+		}
+
+		if v.Channel == info.BlockPublishTopic {
+			retracted = append(retracted, payload.Number)
+		}
+
+	}
+
+	if !reflect.DeepEqual(retracted, []uint64{3, 2}) {
+		t.Fatalf("expected blocks 3 & 2 to be retracted, got %v", retracted)
+	}
+
+	if count := countRows(t, _db, &db.Contracts{}); count != 0 {
+		t.Fatalf("expected contract of orphaned block to be removed, %d left", count)
+	}
+
+}
@@ -0,0 +1,127 @@
+package block
+
+import (
+	"errors"
+	"log"
+	"sync"
+
+	cfg "github.com/denniswon/validationcloud/app/config"
+	d "github.com/denniswon/validationcloud/app/data"
+	"github.com/denniswon/validationcloud/app/db"
+	q "github.com/denniswon/validationcloud/app/queue"
+	"github.com/gookit/color"
+	"gorm.io/gorm"
+)
+
+// errConflictingBlock - Some other block is already present at same height, which
+// only per block path knows how to deal with
+var errConflictingBlock = errors.New("another block present at same height")
+
+// BulkStore - Collects blocks being backfilled by syncer & writes them together, once
+// configured number of them are ready, instead of one DB transaction per block
+//
+// Whoever hands over block, isn't supposed to let queue know whether it's processed or not,
+// because it's known only after write, when it's done on their behalf
+type BulkStore struct {
+	db      *gorm.DB
+	queue   *q.BlockProcessorQueue
+	status  *d.StatusHolder
+	size    int
+	lock    sync.Mutex
+	pending []*db.PackedBlock
+}
+
+// NewBulkStore - Bulk store, writing `BulkWriteSize` blocks at a time
+func NewBulkStore(_db *gorm.DB, queue *q.BlockProcessorQueue, status *d.StatusHolder) *BulkStore {
+
+	size := int(cfg.GetBulkWriteSize())
+
+	return &BulkStore{
+		db:      _db,
+		queue:   queue,
+		status:  status,
+		size:    size,
+		pending: make([]*db.PackedBlock, 0, size),
+	}
+
+}
+
+// Add - Puts block in current batch, which gets written by whoever fills it up
+func (b *BulkStore) Add(block *db.PackedBlock) {
+
+	b.lock.Lock()
+
+	b.pending = append(b.pending, block)
+	if len(b.pending) < b.size {
+		b.lock.Unlock()
+		return
+	}
+
+	batch := b.pending
+	b.pending = make([]*db.PackedBlock, 0, b.size)
+
+	b.lock.Unlock()
+
+	b.write(batch)
+
+}
+
+// Flush - Writes whatever is in current batch, to be invoked once syncer is done
+func (b *BulkStore) Flush() {
+
+	b.lock.Lock()
+
+	batch := b.pending
+	b.pending = make([]*db.PackedBlock, 0, b.size)
+
+	b.lock.Unlock()
+
+	b.write(batch)
+
+}
+
+// write - Writes batch of blocks & lets queue know which of them are processed, rest
+// are marked failed, so that they get retried using per block path
+func (b *BulkStore) write(batch []*db.PackedBlock) {
+
+	if len(batch) == 0 {
+		return
+	}
+
+	inserted, present, err := db.BulkWrite(b.db, batch)
+	if err != nil {
+
+		log.Print(color.Red.Sprintf("[!] Failed to write %d block(s) in bulk : %s", len(batch), err.Error()))
+
+		for _, v := range batch {
+
+			b.queue.Errored(v.Block.Number, err)
+			b.queue.UnconfirmedFailed(v.Block.Number)
+
+		}
+
+		return
+
+	}
+
+	for _, v := range batch {
+
+		if !present[v.Block.Hash] {
+
+			b.queue.Errored(v.Block.Number, errConflictingBlock)
+			b.queue.UnconfirmedFailed(v.Block.Number)
+			continue
+
+		}
+
+		if inserted[v.Block.Hash] {
+			b.status.IncrementBlocksInserted()
+			b.queue.Inserted(v.Block.Number)
+		}
+
+		b.status.IncrementBlocksProcessed()
+		b.queue.UnconfirmedDone(v.Block.Number)
+
+	}
+
+}
@@ -0,0 +1,225 @@
+package block
+
+import (
+	"testing"
+
+	"github.com/denniswon/validationcloud/app/chain"
+	"github.com/denniswon/validationcloud/app/db"
+	"github.com/spf13/viper"
+)
+
+func TestFoldContracts(t *testing.T) {
+
+	deploy := func(address string, number uint64) *db.Contracts {
+		return &db.Contracts{Address: address, BlockNumber: number}
+	}
+
+	upgrade := func(address string, implementation string, number uint64) *db.Contracts {
+		return &db.Contracts{Address: address, ProxyType: "eip1967", Implementation: implementation, UpgradedAt: number}
+	}
+
+	blocks := []*db.PackedBlock{
+		{Transactions: []*db.PackedTransaction{
+			{Contracts: []*db.Contracts{deploy("0xa", 1), deploy("0xb", 1)}},
+			{Upgrades: []*db.Contracts{upgrade("0xa", "0x1", 1), upgrade("0xc", "0x1", 1)}},
+		}},
+		{Transactions: []*db.PackedTransaction{
+			{Upgrades: []*db.Contracts{upgrade("0xa", "0x2", 2), upgrade("0xc", "0x2", 2), upgrade("0xd", "0x2", 2)}},
+			// Proxy, upgraded earlier, redeployed
+			{Contracts: []*db.Contracts{deploy("0xd", 2)}},
+		}},
+	}
+
+	deployed, upgraded := db.FoldContracts(blocks)
+
+	if len(deployed) != 3 {
+		t.Fatalf("expected 3 deployed contract(s), got %d", len(deployed))
+	}
+
+	// Deployed in batch, carries latest upgrade along with it
+	if deployed[0].Address != "0xa" || deployed[0].Implementation != "0x2" || deployed[0].UpgradedAt != 2 {
+		t.Fatalf("expected deployment to be folded with latest upgrade, got %+v", deployed[0])
+	}
+
+	if deployed[1].Address != "0xb" || deployed[1].Implementation != "" {
+		t.Fatalf("expected plain deployment, got %+v", deployed[1])
+	}
+
+	if deployed[2].Address != "0xd" || deployed[2].Implementation != "" {
+		t.Fatalf("expected redeployment to supersede upgrade, got %+v", deployed[2])
+	}
+
+	// Deployed before batch, only latest upgrade matters
+	if len(upgraded) != 1 || upgraded[0].Address != "0xc" || upgraded[0].Implementation != "0x2" {
+		t.Fatalf("expected only latest upgrade of 0xc, got %+v", upgraded)
+	}
+
+}
+
+func TestBulkStore(t *testing.T) {
+
+	t.Cleanup(viper.Reset)
+
+	viper.Set("BulkWriteSize", "2")
+
+	_db := newTestDB(t)
+	fake := chain.NewFakeChain()
+	queue := newTestQueue(t)
+	status := newTestStatus()
+	connection := newTestConnection(fake)
+	info := newTestRedis(t)
+
+	blocks := append(fake.Extend(3, 2), fake.Extend(2, 1)...)
+	bulk := NewBulkStore(_db, queue, status)
+
+	for _, v := range blocks {
+
+		queue.Put(v.NumberU64())
+
+		if !FetchBlockByNumberInBulk(connection, v.NumberU64(), _db, info, queue, status, bulk) {
+			t.Fatalf("failed to fetch block %d", v.NumberU64())
+		}
+
+	}
+
+	// Last one is yet to be written, as batch isn't full
+	if stat := queue.Stat(); stat.UnconfirmedProgress != 1 || stat.ConfirmedWaiting != 4 {
+		t.Fatalf("expected 4 blocks written & 1 pending, got %+v", stat)
+	}
+
+	bulk.Flush()
+
+	for _, v := range blocks {
+
+		stored := db.GetBlock(_db, testChainID, v.NumberU64())
+		if stored == nil || stored.Hash != v.Hash().Hex() {
+			t.Fatalf("block %d not persisted", v.NumberU64())
+		}
+
+	}
+
+	if count := countRows(t, _db, &db.Transactions{}); count != 8 {
+		t.Fatalf("expected 8 tx(s), got %d", count)
+	}
+
+	if count := countRows(t, _db, &db.Events{}); count != 8 {
+		t.Fatalf("expected 8 event(s), got %d", count)
+	}
+
+	if stat := queue.Stat(); stat.UnconfirmedProgress != 0 || stat.ConfirmedWaiting != 5 {
+		t.Fatalf("expected all blocks to be written, got %+v", stat)
+	}
+
+	if processed := status.BlockCountInDB(); processed != 5 {
+		t.Fatalf("expected 5 blocks to be counted, got %d", processed)
+	}
+
+}
+
+func TestBulkWrite(t *testing.T) {
+
+	_db := newTestDB(t)
+	fake := chain.NewFakeChain()
+
+	blocks := packBlocks(t, fake, 3, 2)
+	for _, v := range blocks {
+		v.OnChain(testChainID)
+	}
+
+	// Contract deployed in block 1 & upgraded in block 2, another one,
+	// deployed earlier, upgraded in block 2
+	blocks[0].Transactions[0].Contracts = []*db.Contracts{
+		{Chain: testChainID, Address: "0x4774fEd3f2838f504006BE53155cA9cbDDEe9f0c", BlockHash: blocks[0].Block.Hash, BlockNumber: 1},
+	}
+	blocks[1].Transactions[0].Upgrades = []*db.Contracts{
+		{Chain: testChainID, Address: "0x4774fEd3f2838f504006BE53155cA9cbDDEe9f0c", BlockHash: blocks[1].Block.Hash, BlockNumber: 2, ProxyType: "eip1967", Implementation: "0x1", UpgradedAt: 2},
+		{Chain: testChainID, Address: "0x53155cA9cbDDEe9f0c4774fEd3f2838f504006BE", BlockHash: blocks[1].Block.Hash, BlockNumber: 2, ProxyType: "eip1967", Implementation: "0x2", UpgradedAt: 2},
+	}
+
+	if err := db.UpsertContract(_db, &db.Contracts{Chain: testChainID, Address: "0x53155cA9cbDDEe9f0c4774fEd3f2838f504006BE", Creator: "0x9cbDDEe9f0c4774fEd3f2838f504006BE53155cA"}); err != nil {
+		t.Fatalf("failed to put contract : %s", err.Error())
+	}
+
+	// Block 2 already written, while some other block got written at height 3
+	if _, err := db.StoreBlock(_db, blocks[1], nil, nil); err != nil {
+		t.Fatalf("failed to store block : %s", err.Error())
+	}
+
+	branch, err := fake.Fork(3, 1, 1)
+	if err != nil {
+		t.Fatalf("failed to fork : %s", err.Error())
+	}
+
+	packedTxs, err := FetchTransactionsOfBlock(fake, branch[0])
+	if err != nil {
+		t.Fatalf("failed to fetch tx(s) : %s", err.Error())
+	}
+
+	conflicting := BuildPackedBlock(branch[0], packedTxs)
+	conflicting.OnChain(testChainID)
+
+	if _, err := db.StoreBlock(_db, conflicting, nil, nil); err != nil {
+		t.Fatalf("failed to store block : %s", err.Error())
+	}
+
+	for i := 0; i < 2; i++ {
+
+		inserted, present, err := db.BulkWrite(_db, blocks)
+		if err != nil {
+			t.Fatalf("failed to write in bulk, round %d : %s", i, err.Error())
+		}
+
+		// Block 1 gets inserted only first time, while block 3 is never written
+		if len(inserted) != 1-i || (i == 0 && !inserted[blocks[0].Block.Hash]) {
+			t.Fatalf("unexpected blocks inserted in round %d : %v", i, inserted)
+		}
+
+		if len(present) != 2 || !present[blocks[0].Block.Hash] || !present[blocks[1].Block.Hash] {
+			t.Fatalf("unexpected blocks present in round %d : %v", i, present)
+		}
+
+		// Staging tables are dropped along with DB transaction
+		var staging int64
+		if err := _db.Raw(`select count(*) from pg_class where relpersistence = 't' and relname like 'bulk\_%'`).Scan(&staging).Error; err != nil {
+			t.Fatalf("failed to look up staging tables : %s", err.Error())
+		}
+
+		if staging != 0 {
+			t.Fatalf("expected staging tables to be dropped, %d left", staging)
+		}
+
+	}
+
+	if stored := db.GetBlock(_db, testChainID, 3); stored == nil || stored.Hash != conflicting.Block.Hash {
+		t.Fatalf("expected conflicting block to be left untouched")
+	}
+
+	// 2 tx(s) of each of block 1 & 2, along with 1 of conflicting block
+	if count := countRows(t, _db, &db.Transactions{}); count != 5 {
+		t.Fatalf("expected 5 tx(s), got %d", count)
+	}
+
+	if count := countRows(t, _db, &db.Events{}); count != 5 {
+		t.Fatalf("expected 5 event(s), got %d", count)
+	}
+
+	var contracts []*db.Contracts
+	if err := _db.Where("chain_id = ?", testChainID).Order("address asc").Find(&contracts).Error; err != nil {
+		t.Fatalf("failed to read contracts : %s", err.Error())
+	}
+
+	if len(contracts) != 2 {
+		t.Fatalf("expected 2 contracts, got %d", len(contracts))
+	}
+
+	// Deployment is folded with its upgrade, while only implementation of
+	// contract deployed earlier gets updated
+	if v := contracts[0]; v.BlockNumber != 1 || v.Implementation != "0x1" || v.UpgradedAt != 2 {
+		t.Fatalf("unexpected deployed contract %+v", v)
+	}
+
+	if v := contracts[1]; v.Creator == "" || v.Implementation != "0x2" || v.UpgradedAt != 2 {
+		t.Fatalf("unexpected upgraded contract %+v", v)
+	}
+
+}
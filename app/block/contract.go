@@ -0,0 +1,334 @@
+package block
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/denniswon/validationcloud/app/chain"
+	"github.com/denniswon/validationcloud/app/db"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Kinds of proxies, which can be detected
+const (
+	ProxyEIP1967       = "eip1967"
+	ProxyEIP1967Beacon = "eip1967-beacon"
+	ProxyEIP1822       = "eip1822"
+	ProxyEIP897        = "eip897"
+)
+
+var (
+	// EIP-1967 storage slots, holding implementation & beacon address
+	implementationSlot = common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")
+	beaconSlot         = common.HexToHash("0xa3f0ad74e5423aebfd80d3ef4346578335a9a72aeaee59ff6cb3582b35133d50")
+
+	// EIP-1822 storage slot, holding implementation address i.e. keccak256("PROXIABLE")
+	proxiableSlot = crypto.Keccak256Hash([]byte("PROXIABLE"))
+
+	// `implementation()` of EIP-897 proxy & EIP-1967 beacon, `proxyType()` of EIP-897 proxy
+	implementationSelector = crypto.Keccak256([]byte("implementation()"))[:4]
+	proxyTypeSelector      = crypto.Keccak256([]byte("proxyType()"))[:4]
+
+	// Emitted by proxy when its implementation changes
+	upgradedTopic       = crypto.Keccak256Hash([]byte("Upgraded(address)"))
+	beaconUpgradedTopic = crypto.Keccak256Hash([]byte("BeaconUpgraded(address)"))
+)
+
+// addressIn - Address held in rightmost 20 bytes of 32 byte word, nil if
+// word isn't 32 bytes long or address is zero
+func addressIn(word []byte) *common.Address {
+
+	if len(word) != 32 {
+		return nil
+	}
+
+	address := common.BytesToAddress(word[12:])
+	if address == (common.Address{}) {
+		return nil
+	}
+
+	return &address
+
+}
+
+// callForAddress - Invokes method of contract, which takes no argument & returns address,
+// reverted calls are not considered as error
+func callForAddress(ctx context.Context, client chain.ChainSource, contract common.Address, selector []byte, number *big.Int) (*common.Address, error) {
+
+	output, err := client.CallContract(ctx, ethereum.CallMsg{To: &contract, Data: selector}, number)
+	if err != nil {
+
+		if chain.IsExecutionReverted(err) {
+			return nil, nil
+		}
+
+		return nil, err
+
+	}
+
+	return addressIn(output), nil
+
+}
+
+// DetectProxy - Checks whether contract is a proxy, as of given block, by looking into
+// EIP-1967 implementation & beacon slots, EIP-1822 slot & finally by invoking EIP-897
+// methods, returns kind of proxy & its implementation, empty if it's not a proxy
+func DetectProxy(ctx context.Context, client chain.ChainSource, contract common.Address, number *big.Int) (string, string, error) {
+
+	for _, v := range []struct {
+		kind string
+		slot common.Hash
+	}{
+		{ProxyEIP1967, implementationSlot},
+		{ProxyEIP1967Beacon, beaconSlot},
+		{ProxyEIP1822, proxiableSlot},
+	} {
+
+		value, err := client.StorageAt(ctx, contract, v.slot, number)
+		if err != nil {
+			return "", "", err
+		}
+
+		address := addressIn(common.LeftPadBytes(value, 32))
+		if address == nil {
+			continue
+		}
+
+		if v.kind != ProxyEIP1967Beacon {
+			return v.kind, address.Hex(), nil
+		}
+
+		// Beacon holds implementation address
+		implementation, err := callForAddress(ctx, client, *address, implementationSelector, number)
+		if err != nil {
+			return "", "", err
+		}
+
+		if implementation != nil {
+			return v.kind, implementation.Hex(), nil
+		}
+
+	}
+
+	// EIP-897 proxy needs to tell whether it's forwarding ( 1 ) or
+	// upgradeable ( 2 ) one, otherwise lots of contracts, having
+	// `implementation()`, would be considered proxy
+	output, err := client.CallContract(ctx, ethereum.CallMsg{To: &contract, Data: proxyTypeSelector}, number)
+	if err != nil {
+
+		if chain.IsExecutionReverted(err) {
+			return "", "", nil
+		}
+
+		return "", "", err
+
+	}
+
+	if len(output) != 32 {
+		return "", "", nil
+	}
+
+	if kind := new(big.Int).SetBytes(output); kind.Cmp(common.Big1) != 0 && kind.Cmp(common.Big2) != 0 {
+		return "", "", nil
+	}
+
+	implementation, err := callForAddress(ctx, client, contract, implementationSelector, number)
+	if err != nil {
+		return "", "", err
+	}
+
+	if implementation == nil {
+		return "", "", nil
+	}
+
+	return ProxyEIP897, implementation.Hex(), nil
+
+}
+
+// inspectContract - Fetches runtime bytecode of contract & checks whether it's proxy,
+// as of given block, if node doesn't keep state of that block anymore, latest
+// state is inspected
+func inspectContract(ctx context.Context, client chain.ChainSource, contract *db.Contracts, number *big.Int) error {
+
+	address := common.HexToAddress(contract.Address)
+
+	code, err := client.CodeAt(ctx, address, number)
+	if err != nil {
+
+		number = nil
+
+		code, err = client.CodeAt(ctx, address, number)
+		if err != nil {
+			return err
+		}
+
+	}
+
+	contract.CodeHash = crypto.Keccak256Hash(code).Hex()
+
+	// Nothing to forward calls to
+	if len(code) == 0 {
+		return nil
+	}
+
+	kind, implementation, err := DetectProxy(ctx, client, address, number)
+	if err != nil {
+		return err
+	}
+
+	contract.ProxyType = kind
+	contract.Implementation = implementation
+
+	return nil
+
+}
+
+// deployment - Contract along with its creator
+type deployment struct {
+	address string
+	creator string
+}
+
+// deployedBy - Contracts deployed by tx, either it's contract creation tx or contract(s)
+// got created during its execution, as found in call traces, in order of deployment
+//
+// Contract creations, reverted along with any of their ancestor calls, are skipped
+func deployedBy(packedTx *db.PackedTransaction) []deployment {
+
+	deployed := make([]deployment, 0)
+
+	// Failed tx doesn't leave anything behind
+	if packedTx.Tx.State != types.ReceiptStatusSuccessful {
+		return deployed
+	}
+
+	if strings.HasPrefix(packedTx.Tx.Contract, "0x") {
+		deployed = append(deployed, deployment{address: packedTx.Tx.Contract, creator: packedTx.Tx.From})
+	}
+
+	failed := make(map[string]bool)
+	for _, v := range packedTx.Traces {
+		if v.Error != "" {
+			failed[fmt.Sprint([]int64(v.TraceAddress))] = true
+		}
+	}
+
+	for _, v := range packedTx.Traces {
+
+		// Top level creation is already taken care of
+		if !(v.Type == "CREATE" || v.Type == "CREATE2") || len(v.TraceAddress) == 0 || !strings.HasPrefix(v.To, "0x") {
+			continue
+		}
+
+		reverted := false
+		for i := 0; i <= len(v.TraceAddress); i++ {
+			if failed[fmt.Sprint([]int64(v.TraceAddress[:i]))] {
+				reverted = true
+				break
+			}
+		}
+
+		if !reverted {
+			deployed = append(deployed, deployment{address: v.To, creator: v.From})
+		}
+
+	}
+
+	return deployed
+
+}
+
+// FetchContractsOfBlock - Finds out all contracts deployed in block, along with their
+// bytecode hash & implementation, if they're proxies, while proxies which got upgraded
+// in this block, get their implementation refreshed
+//
+// Contracts deployed by other contracts are found only when call tracing is enabled
+func FetchContractsOfBlock(client chain.ChainSource, block *types.Block, packedTxs []*db.PackedTransaction) error {
+
+	ctx := context.Background()
+
+	for _, packedTx := range packedTxs {
+
+		packedTx.Contracts = nil
+		packedTx.Upgrades = nil
+
+		deployed := make(map[string]bool)
+
+		for _, v := range deployedBy(packedTx) {
+
+			deployed[v.address] = true
+
+			contract := &db.Contracts{
+				Address:         v.address,
+				Creator:         v.creator,
+				TransactionHash: packedTx.Tx.Hash,
+				BlockHash:       block.Hash().Hex(),
+				BlockNumber:     block.NumberU64(),
+			}
+
+			if err := inspectContract(ctx, client, contract, block.Number()); err != nil {
+				return fmt.Errorf("failed to inspect contract %s : %s", v.address, err.Error())
+			}
+
+			if contract.ProxyType != "" {
+				contract.UpgradedAt = block.NumberU64()
+			}
+
+			packedTx.Contracts = append(packedTx.Contracts, contract)
+
+		}
+
+		// Same proxy may get upgraded multiple times in a tx, only
+		// last state matters, so walking events backwards
+		upgraded := make(map[string]bool)
+
+		for i := len(packedTx.Events) - 1; i >= 0; i-- {
+
+			v := packedTx.Events[i]
+			if len(v.Topics) == 0 || !(v.Topics[0] == upgradedTopic.Hex() || v.Topics[0] == beaconUpgradedTopic.Hex()) {
+				continue
+			}
+
+			// Implementation of just deployed proxy is already known
+			if deployed[v.Origin] || upgraded[v.Origin] {
+				continue
+			}
+
+			upgraded[v.Origin] = true
+
+			contract := &db.Contracts{
+				Address:     v.Origin,
+				BlockHash:   block.Hash().Hex(),
+				BlockNumber: block.NumberU64(),
+				UpgradedAt:  block.NumberU64(),
+			}
+
+			if err := inspectContract(ctx, client, contract, block.Number()); err != nil {
+				return fmt.Errorf("failed to inspect proxy %s : %s", v.Origin, err.Error())
+			}
+
+			// Not following any of known proxy patterns, but it's telling
+			// where it's forwarding calls to
+			if contract.ProxyType == "" && v.Topics[0] == upgradedTopic.Hex() && len(v.Topics) == 2 {
+
+				if implementation := addressIn(common.HexToHash(v.Topics[1]).Bytes()); implementation != nil {
+					contract.ProxyType = ProxyEIP1967
+					contract.Implementation = implementation.Hex()
+				}
+
+			}
+
+			packedTx.Upgrades = append(packedTx.Upgrades, contract)
+
+		}
+
+	}
+
+	return nil
+
+}
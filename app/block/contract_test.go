@@ -0,0 +1,208 @@
+package block
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/denniswon/validationcloud/app/chain"
+	"github.com/denniswon/validationcloud/app/db"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestDetectProxy(t *testing.T) {
+
+	fake := chain.NewFakeChain()
+
+	implementation := common.HexToAddress("0x1000")
+	beacon := common.HexToAddress("0x2000")
+
+	eip1967, eip1967Beacon, eip1822, eip897, plain, lookalike := common.HexToAddress("0x1"), common.HexToAddress("0x2"), common.HexToAddress("0x3"), common.HexToAddress("0x4"), common.HexToAddress("0x5"), common.HexToAddress("0x6")
+
+	fake.SetStorageAt(eip1967, implementationSlot, common.BytesToHash(implementation.Bytes()))
+
+	fake.SetStorageAt(eip1967Beacon, beaconSlot, common.BytesToHash(beacon.Bytes()))
+	fake.SetCallResult(beacon, implementationSelector, common.BytesToHash(implementation.Bytes()).Bytes())
+
+	fake.SetStorageAt(eip1822, proxiableSlot, common.BytesToHash(implementation.Bytes()))
+
+	fake.SetCallResult(eip897, proxyTypeSelector, common.BigToHash(big.NewInt(2)).Bytes())
+	fake.SetCallResult(eip897, implementationSelector, common.BytesToHash(implementation.Bytes()).Bytes())
+
+	// Has `implementation()`, but doesn't tell what kind of proxy it is
+	fake.SetCallResult(lookalike, implementationSelector, common.BytesToHash(implementation.Bytes()).Bytes())
+
+	for _, v := range []struct {
+		name     string
+		contract common.Address
+		kind     string
+	}{
+		{"eip1967", eip1967, ProxyEIP1967},
+		{"eip1967-beacon", eip1967Beacon, ProxyEIP1967Beacon},
+		{"eip1822", eip1822, ProxyEIP1822},
+		{"eip897", eip897, ProxyEIP897},
+		{"plain", plain, ""},
+		{"lookalike", lookalike, ""},
+	} {
+
+		t.Run(v.name, func(t *testing.T) {
+
+			kind, impl, err := DetectProxy(context.Background(), fake, v.contract, nil)
+			if err != nil {
+				t.Fatalf("failed to detect proxy : %s", err.Error())
+			}
+
+			if kind != v.kind {
+				t.Fatalf("expected proxy type %q, got %q", v.kind, kind)
+			}
+
+			if v.kind == "" && impl != "" {
+				t.Fatalf("expected no implementation, got %s", impl)
+			}
+
+			if v.kind != "" && impl != implementation.Hex() {
+				t.Fatalf("expected implementation %s, got %s", implementation.Hex(), impl)
+			}
+
+		})
+
+	}
+
+	fake.FailWith(chain.MethodStorageAt, errors.New("injected"))
+
+	if _, _, err := DetectProxy(context.Background(), fake, eip1967, nil); err == nil {
+		t.Fatal("expected storage lookup failure to be propagated")
+	}
+
+}
+
+func TestDeployedBy(t *testing.T) {
+
+	packedTx := &db.PackedTransaction{
+		Tx: &db.Transactions{
+			From:     common.HexToAddress("0xa").Hex(),
+			Contract: common.HexToAddress("0x1").Hex(),
+			State:    types.ReceiptStatusSuccessful,
+		},
+		Traces: []*db.Traces{
+			{TraceAddress: []int64{}, Type: "CREATE", From: common.HexToAddress("0xa").Hex(), To: common.HexToAddress("0x1").Hex()},
+			{TraceAddress: []int64{0}, Type: "CREATE2", From: common.HexToAddress("0x1").Hex(), To: common.HexToAddress("0x2").Hex()},
+			{TraceAddress: []int64{1}, Type: "CALL", From: common.HexToAddress("0x1").Hex(), To: common.HexToAddress("0x9").Hex(), Error: "execution reverted"},
+			// Reverted along with its parent call
+			{TraceAddress: []int64{1, 0}, Type: "CREATE", From: common.HexToAddress("0x9").Hex(), To: common.HexToAddress("0x3").Hex()},
+			{TraceAddress: []int64{2}, Type: "CREATE", From: common.HexToAddress("0x1").Hex(), To: common.HexToAddress("0x4").Hex()},
+		},
+	}
+
+	deployed := deployedBy(packedTx)
+
+	expected := []deployment{
+		{address: common.HexToAddress("0x1").Hex(), creator: common.HexToAddress("0xa").Hex()},
+		{address: common.HexToAddress("0x2").Hex(), creator: common.HexToAddress("0x1").Hex()},
+		{address: common.HexToAddress("0x4").Hex(), creator: common.HexToAddress("0x1").Hex()},
+	}
+
+	if len(deployed) != len(expected) {
+		t.Fatalf("expected %d deployments, got %d", len(expected), len(deployed))
+	}
+
+	for k, v := range deployed {
+		if v != expected[k] {
+			t.Fatalf("expected deployment %v at %d, got %v", expected[k], k, v)
+		}
+	}
+
+	packedTx.Tx.State = types.ReceiptStatusFailed
+
+	if deployed := deployedBy(packedTx); len(deployed) != 0 {
+		t.Fatalf("expected failed tx to deploy nothing, got %d", len(deployed))
+	}
+
+}
+
+func TestFetchContractsOfBlock(t *testing.T) {
+
+	fake := chain.NewFakeChain()
+	block := fake.Extend(1, 0)[0]
+
+	creator := common.HexToAddress("0xa")
+	proxy, upgraded := common.HexToAddress("0x1"), common.HexToAddress("0x2")
+	implementation := common.HexToAddress("0x1000")
+
+	code := []byte{0x60, 0x80, 0x60, 0x40}
+
+	fake.SetCode(proxy, code)
+	fake.SetStorageAt(proxy, implementationSlot, common.BytesToHash(implementation.Bytes()))
+
+	// Doesn't follow any known proxy pattern, implementation is
+	// only found in `Upgraded` event
+	fake.SetCode(upgraded, code)
+
+	packedTxs := []*db.PackedTransaction{
+		{
+			Tx: &db.Transactions{
+				Hash:     common.Hash{1}.Hex(),
+				From:     creator.Hex(),
+				Contract: proxy.Hex(),
+				State:    types.ReceiptStatusSuccessful,
+			},
+			Events: []*db.Events{
+				// Emitted by just deployed proxy, already inspected
+				{Origin: proxy.Hex(), Topics: []string{upgradedTopic.Hex(), topicOf(implementation)}},
+			},
+		},
+		{
+			Tx: &db.Transactions{
+				Hash:  common.Hash{2}.Hex(),
+				From:  creator.Hex(),
+				To:    upgraded.Hex(),
+				State: types.ReceiptStatusSuccessful,
+			},
+			Events: []*db.Events{
+				{Origin: upgraded.Hex(), Topics: []string{upgradedTopic.Hex(), topicOf(common.HexToAddress("0x999"))}},
+				{Origin: upgraded.Hex(), Topics: []string{upgradedTopic.Hex(), topicOf(implementation)}},
+			},
+		},
+	}
+
+	if err := FetchContractsOfBlock(fake, block, packedTxs); err != nil {
+		t.Fatalf("failed to fetch contracts : %s", err.Error())
+	}
+
+	if len(packedTxs[0].Contracts) != 1 || len(packedTxs[0].Upgrades) != 0 {
+		t.Fatalf("expected 1 deployment & no upgrade, got %d & %d", len(packedTxs[0].Contracts), len(packedTxs[0].Upgrades))
+	}
+
+	deployed := packedTxs[0].Contracts[0]
+
+	if deployed.Address != proxy.Hex() || deployed.Creator != creator.Hex() || deployed.TransactionHash != packedTxs[0].Tx.Hash {
+		t.Fatalf("unexpected deployment %+v", deployed)
+	}
+
+	if deployed.CodeHash != crypto.Keccak256Hash(code).Hex() {
+		t.Fatalf("expected code hash %s, got %s", crypto.Keccak256Hash(code).Hex(), deployed.CodeHash)
+	}
+
+	if deployed.ProxyType != ProxyEIP1967 || deployed.Implementation != implementation.Hex() || deployed.UpgradedAt != block.NumberU64() {
+		t.Fatalf("unexpected proxy details %+v", deployed)
+	}
+
+	if len(packedTxs[1].Contracts) != 0 || len(packedTxs[1].Upgrades) != 1 {
+		t.Fatalf("expected no deployment & 1 upgrade, got %d & %d", len(packedTxs[1].Contracts), len(packedTxs[1].Upgrades))
+	}
+
+	// Last upgrade in tx wins, implementation taken from its topic
+	if refreshed := packedTxs[1].Upgrades[0]; refreshed.Address != upgraded.Hex() || refreshed.Implementation != implementation.Hex() || refreshed.ProxyType != ProxyEIP1967 {
+		t.Fatalf("unexpected upgrade %+v", refreshed)
+	}
+
+	fake.FailWith(chain.MethodCodeAt, errors.New("injected"))
+
+	if err := FetchContractsOfBlock(fake, block, packedTxs); err == nil {
+		t.Fatal("expected bytecode lookup failure to be propagated")
+	}
+
+}
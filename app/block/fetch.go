@@ -2,10 +2,14 @@ package block
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"math/big"
+	"runtime"
 	"time"
 
+	"github.com/denniswon/validationcloud/app/chain"
+	cfg "github.com/denniswon/validationcloud/app/config"
 	d "github.com/denniswon/validationcloud/app/data"
 	"github.com/denniswon/validationcloud/app/db"
 	q "github.com/denniswon/validationcloud/app/queue"
@@ -13,30 +17,32 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/gammazero/workerpool"
 	"gorm.io/gorm"
 )
 
 // FetchBlockByHash - Fetching block content using blockHash
-func FetchBlockByHash(client *ethclient.Client, hash common.Hash, number string, _db *gorm.DB, redis *d.RedisInfo, queue *q.BlockProcessorQueue, _status *d.StatusHolder) bool {
+func FetchBlockByHash(connection *d.BlockChainNodeConnection, hash common.Hash, number uint64, _db *gorm.DB, redis *d.RedisInfo, queue *q.BlockProcessorQueue, _status *d.StatusHolder) bool {
 
 	// Starting block processing at
 	startingAt := time.Now().UTC()
 
-	block, err := client.BlockByHash(context.Background(), hash)
+	block, err := connection.RPC.BlockByHash(context.Background(), hash)
 	if err != nil {
 
-		log.Printf("Failed to fetch block %s : %s\n", number, err.Error())
+		log.Printf("Failed to fetch block %d : %s\n", number, err.Error())
+
+		queue.Errored(number, err)
 		return false
 
 	}
 
-	return ProcessBlockContent(client, block, _db, redis, true, queue, _status, startingAt)
+	return ProcessBlockContent(connection, block, _db, redis, true, queue, _status, startingAt)
 
 }
 
 // FetchBlockByNumber - Fetching block content using block number
-func FetchBlockByNumber(client *ethclient.Client, number uint64, _db *gorm.DB, redis *d.RedisInfo, publishable bool, queue *q.BlockProcessorQueue, _status *d.StatusHolder) bool {
+func FetchBlockByNumber(connection *d.BlockChainNodeConnection, number uint64, _db *gorm.DB, redis *d.RedisInfo, publishable bool, queue *q.BlockProcessorQueue, _status *d.StatusHolder) bool {
 
 	// Starting block processing at
 	startingAt := time.Now().UTC()
@@ -44,27 +50,60 @@ func FetchBlockByNumber(client *ethclient.Client, number uint64, _db *gorm.DB, r
 	_num := big.NewInt(0)
 	_num.SetUint64(number)
 
-	block, err := client.BlockByNumber(context.Background(), _num)
+	block, err := connection.RPC.BlockByNumber(context.Background(), _num)
 	if err != nil {
 
 		log.Printf("Failed to fetch block %d : %s\n", number, err)
+
+		queue.Errored(number, err)
 		return false
 
 	}
 
-	return ProcessBlockContent(client, block, _db, redis, publishable, queue, _status, startingAt)
+	return ProcessBlockContent(connection, block, _db, redis, publishable, queue, _status, startingAt)
+
+}
+
+// FetchBlockByNumberInBulk - Fetching block content using block number, which is handed
+// over to bulk store, to be persisted along with other blocks being backfilled
+func FetchBlockByNumberInBulk(connection *d.BlockChainNodeConnection, number uint64, _db *gorm.DB, redis *d.RedisInfo, queue *q.BlockProcessorQueue, _status *d.StatusHolder, bulk *BulkStore) bool {
+
+	_num := big.NewInt(0)
+	_num.SetUint64(number)
+
+	block, err := connection.RPC.BlockByNumber(context.Background(), _num)
+	if err != nil {
+
+		log.Printf("Failed to fetch block %d : %s\n", number, err)
+
+		queue.Errored(number, err)
+		return false
+
+	}
+
+	packedBlock, ok := PackBlockContent(connection, block, _db, redis, false, queue, _status)
+	if !ok {
+		return false
+	}
+
+	bulk.Add(packedBlock)
+	return true
 
 }
 
 // FetchTransactionByHash - Fetching specific transaction related data, tries to publish data if required
 // & lets listener go routine know about all tx, event data it collected while processing this tx,
 // which will be attempted to be stored in database
-func FetchTransactionByHash(client *ethclient.Client, block *types.Block, tx *types.Transaction, _db *gorm.DB, redis *d.RedisInfo, _status *d.StatusHolder, returnValChan chan *db.PackedTransaction) {
+//
+// Reason of failure, if any, gets recorded against block in queue
+func FetchTransactionByHash(client chain.ChainSource, block *types.Block, tx *types.Transaction, _db *gorm.DB, redis *d.RedisInfo, _status *d.StatusHolder, queue *q.BlockProcessorQueue, returnValChan chan *db.PackedTransaction) {
 
 	receipt, err := client.TransactionReceipt(context.Background(), tx.Hash())
 	if err != nil {
 		// log.Printf("Failed to fetch tx receipt for %s [ block : %d ] : %s\n", tx.Hash().Hex(), block.NumberU64(), err.Error())
 
+		queue.Errored(block.NumberU64(), fmt.Errorf("failed to fetch receipt of tx %s : %s", tx.Hash().Hex(), err.Error()))
+
 		// Passing nil, to denote, failed to fetch all tx data
 		// from blockchain node
 		returnValChan <- nil
@@ -84,6 +123,8 @@ func FetchTransactionByHash(client *ethclient.Client, block *types.Block, tx *ty
 		if err != nil {
 			log.Printf("Failed to fetch tx sender [ block : %d ] : %s\n", block.NumberU64(), err.Error())
 
+			queue.Errored(block.NumberU64(), fmt.Errorf("failed to fetch sender of tx %s : %s", tx.Hash().Hex(), err.Error()))
+
 			// Passing nil, to denote, failed to fetch all tx data
 			// from blockchain node
 			returnValChan <- nil
@@ -94,6 +135,126 @@ func FetchTransactionByHash(client *ethclient.Client, block *types.Block, tx *ty
 	// Passing all tx related data to listener go routine
 	// so that it can attempt to store whole block data
 	// into database
-	returnValChan <- BuildPackedTx(tx, sender, receipt)
+	returnValChan <- BuildPackedTx(block, tx, sender, receipt)
+}
+
+// FetchTransactionsOneByOne - Concurrently fetches receipt & sender of each tx in block, one call
+// per tx, returns packed tx(s) in order of their completion
+//
+// If any of them fails, whole block is considered to be failed
+func FetchTransactionsOneByOne(client chain.ChainSource, block *types.Block, _db *gorm.DB, redis *d.RedisInfo, status *d.StatusHolder, queue *q.BlockProcessorQueue) ([]*db.PackedTransaction, bool) {
+
+	// Communication channel to be shared between multiple executing go routines
+	// which are trying to fetch all tx(s) present in block, concurrently
+	returnValChan := make(chan *db.PackedTransaction, runtime.NumCPU() * int(cfg.GetConcurrencyFactor()))
+
+	// -- Tx processing starting
+	// Creating job processor queue which will process all tx(s), concurrently
+	wp := workerpool.New(runtime.NumCPU() * int(cfg.GetConcurrencyFactor()))
+
+	// Concurrently trying to process all tx(s) for this block, in hope of better performance
+	for _, v := range block.Transactions() {
+
+		// Concurrently trying to fetch multiple tx(s) present in block
+		// and expecting their return value to be published on shared channel
+		//
+		// Which is being read 👇
+		func(tx *types.Transaction) {
+			wp.Submit(func() {
+
+				FetchTransactionByHash(client,
+					block,
+					tx,
+					_db,
+					redis,
+					status,
+					queue,
+					returnValChan)
+
+			})
+		}(v)
+
+	}
+
+	// Keeping track of how many of these tx fetchers succeded & how many of them failed
+	result := d.ResultStatus{}
+	// Data received from tx fetchers, to be stored here
+	packedTxs := make([]*db.PackedTransaction, block.Transactions().Len())
+
+	for v := range returnValChan {
+		if v != nil {
+			result.Success++
+		} else {
+			result.Failure++
+		}
+
+		// #-of tx fetchers completed their job till now
+		//
+		// Either successfully or failed some how
+		total := int(result.Total())
+		// Storing tx data received from just completed go routine
+		packedTxs[total-1] = v
+
+		// All go routines have completed their job
+		if total == block.Transactions().Len() {
+			break
+		}
+	}
+
+	// Stopping job processor forcefully
+	// because by this time all jobs have been completed
+	//
+	// Otherwise control flow will not be able to come here
+	// it'll keep looping in 👆 loop, reading from channel
+	wp.Stop()
+	// -- Tx processing ending
+
+	if !(result.Failure == 0) {
+		return nil, false
+	}
+
+	return packedTxs, true
+
 }
 
+// FetchTransactionsOfBlock - Fetches receipts of all tx(s) in block together, using
+// configured strategy & derives senders locally, returns packed tx(s) in block order
+func FetchTransactionsOfBlock(client chain.ChainSource, block *types.Block) ([]*db.PackedTransaction, error) {
+
+	receipts, err := client.BlockReceipts(context.Background(), block)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := chain.CheckReceipts(block, receipts); err != nil {
+		return nil, err
+	}
+
+	packedTxs := make([]*db.PackedTransaction, block.Transactions().Len())
+
+	for k, tx := range block.Transactions() {
+
+		sender, err := TransactionSenderOf(block, tx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to derive sender of tx %s : %s", tx.Hash().Hex(), err.Error())
+		}
+
+		packedTxs[k] = BuildPackedTx(block, tx, sender, receipts[k])
+
+	}
+
+	return packedTxs, nil
+
+}
+
+// TransactionSenderOf - Derives tx sender locally from signature, without asking node
+func TransactionSenderOf(block *types.Block, tx *types.Transaction) (common.Address, error) {
+
+	sender, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err == nil {
+		return sender, nil
+	}
+
+	return u.TransactionSender(block, tx)
+
+}
@@ -0,0 +1,183 @@
+package block
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/denniswon/validationcloud/app/chain"
+	"github.com/spf13/viper"
+)
+
+func TestFetchTransactionsOfBlock(t *testing.T) {
+
+	fake := chain.NewFakeChain()
+	block := fake.Extend(1, 5)[0]
+
+	packedTxs, err := FetchTransactionsOfBlock(fake, block)
+	if err != nil {
+		t.Fatalf("failed to fetch tx(s) : %s", err.Error())
+	}
+
+	if len(packedTxs) != 5 {
+		t.Fatalf("expected 5 tx(s), got %d", len(packedTxs))
+	}
+
+	for k, v := range packedTxs {
+
+		tx := block.Transactions()[k]
+
+		sender, err := fake.TransactionSender(context.Background(), tx, block.Hash(), uint(k))
+		if err != nil {
+			t.Fatalf("failed to recover sender : %s", err.Error())
+		}
+
+		if v.Tx.Hash != tx.Hash().Hex() || v.Tx.From != sender.Hex() || v.Tx.BlockHash != block.Hash().Hex() {
+			t.Fatalf("tx %d packed with wrong data", k)
+		}
+
+		if len(v.Events) != 1 || v.Events[0].TransactionHash != tx.Hash().Hex() {
+			t.Fatalf("tx %d packed with wrong events", k)
+		}
+
+	}
+
+}
+
+func TestFetchTransactionsOfBlockMissingReceipt(t *testing.T) {
+
+	fake := chain.NewFakeChain()
+	block := fake.Extend(1, 3)[0]
+
+	fake.WithholdReceipt(block.Transactions()[2].Hash(), true)
+
+	if _, err := FetchTransactionsOfBlock(fake, block); err == nil {
+		t.Fatalf("expected missing receipt to fail block")
+	}
+
+}
+
+func TestFetchTransactionsOfBlockOrphaned(t *testing.T) {
+
+	fake := chain.NewFakeChain()
+	fake.Extend(2, 0)
+	block := fake.Extend(1, 2)[0]
+
+	if _, err := fake.Fork(2, 2, 2); err != nil {
+		t.Fatalf("failed to fork : %s", err.Error())
+	}
+
+	// Receipts of orphaned block can still be fetched by its hash, which is fine
+	if _, err := FetchTransactionsOfBlock(fake, block); err != nil {
+		t.Fatalf("failed to fetch tx(s) of orphaned block : %s", err.Error())
+	}
+
+	fake.FailWith(chain.MethodBlockReceipts, errors.New("injected"))
+
+	if _, err := FetchTransactionsOfBlock(fake, block); err == nil {
+		t.Fatalf("expected injected error to fail block")
+	}
+
+}
+
+func TestFetchTransactionsOneByOne(t *testing.T) {
+
+	fake := chain.NewFakeChain()
+	block := fake.Extend(1, 8)[0]
+
+	queue := newTestQueue(t)
+
+	packedTxs, ok := FetchTransactionsOneByOne(fake, block, nil, nil, newTestStatus(), queue)
+	if !ok {
+		t.Fatalf("failed to fetch tx(s)")
+	}
+
+	if len(packedTxs) != 8 {
+		t.Fatalf("expected 8 tx(s), got %d", len(packedTxs))
+	}
+
+	// Tx(s) are collected in order of completion
+	seen := make(map[string]bool)
+	for _, v := range packedTxs {
+		seen[v.Tx.Hash] = true
+	}
+
+	for _, v := range block.Transactions() {
+		if !seen[v.Hash().Hex()] {
+			t.Fatalf("tx %s missing", v.Hash().Hex())
+		}
+	}
+
+	fake.WithholdReceipt(block.Transactions()[4].Hash(), true)
+	queue.Put(block.NumberU64())
+
+	if _, ok := FetchTransactionsOneByOne(fake, block, nil, nil, newTestStatus(), queue); ok {
+		t.Fatalf("expected missing receipt to fail block")
+	}
+
+	// Giving up on block, right after first failure, reason of failure is retained
+	viper.Set("MaxAttempts", "1")
+	t.Cleanup(viper.Reset)
+
+	queue.UnconfirmedFailed(block.NumberU64())
+
+	letters := queue.DeadLetters()
+	if len(letters) != 1 || letters[0].Number != block.NumberU64() || !strings.Contains(letters[0].LastError, block.Transactions()[4].Hash().Hex()) {
+		t.Fatalf("expected block to be dead-lettered with missing receipt, got %+v", letters)
+	}
+
+}
+
+func TestTransactionSenderOf(t *testing.T) {
+
+	fake := chain.NewFakeChain()
+	block := fake.Extend(1, 2)[0]
+
+	for k, v := range block.Transactions() {
+
+		expected, err := fake.TransactionSender(context.Background(), v, block.Hash(), uint(k))
+		if err != nil {
+			t.Fatalf("failed to recover sender : %s", err.Error())
+		}
+
+		sender, err := TransactionSenderOf(block, v)
+		if err != nil {
+			t.Fatalf("failed to derive sender : %s", err.Error())
+		}
+
+		if sender != expected {
+			t.Fatalf("expected sender %s, got %s", expected.Hex(), sender.Hex())
+		}
+
+	}
+
+}
+
+func TestFetchBlockByHashFailed(t *testing.T) {
+
+	t.Cleanup(viper.Reset)
+
+	viper.Set("MaxAttempts", "1")
+
+	fake := chain.NewFakeChain()
+	block := fake.Extend(1, 0)[0]
+	queue := newTestQueue(t)
+
+	fake.FailWith(chain.MethodBlockByHash, errors.New("header not found"))
+
+	queue.Put(1)
+
+	if FetchBlockByHash(newTestConnection(fake), block.Hash(), 1, nil, nil, queue, newTestStatus()) {
+		t.Fatalf("expected block fetch to fail")
+	}
+
+	queue.UnconfirmedFailed(1)
+
+	// Reason is recorded, so that it's shown once block is dead-lettered
+	letters := queue.DeadLetters()
+	if len(letters) != 1 || !strings.Contains(letters[0].LastError, "header not found") {
+		t.Fatalf("expected fetch error to be recorded, got %+v", letters)
+	}
+
+}
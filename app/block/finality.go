@@ -0,0 +1,74 @@
+package block
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/big"
+	"time"
+
+	"github.com/denniswon/validationcloud/app/chain"
+	cfg "github.com/denniswon/validationcloud/app/config"
+	d "github.com/denniswon/validationcloud/app/data"
+	q "github.com/denniswon/validationcloud/app/queue"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gookit/color"
+)
+
+// FetchFinality - Heights of `safe` & `finalized` heads, as per node's block tags
+func FetchFinality(ctx context.Context, client chain.ChainSource) (uint64, uint64, error) {
+
+	safe, err := client.HeaderByNumber(ctx, big.NewInt(int64(rpc.SafeBlockNumber)))
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to fetch safe head : %s", err.Error())
+	}
+
+	finalized, err := client.HeaderByNumber(ctx, big.NewInt(int64(rpc.FinalizedBlockNumber)))
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to fetch finalized head : %s", err.Error())
+	}
+
+	return safe.Number.Uint64(), finalized.Number.Uint64(), nil
+
+}
+
+// UpdateFinality - Fetches `safe` & `finalized` heads from node & lets both status
+// holder & queue know, so that blocks at or below finalized head can be confirmed
+func UpdateFinality(ctx context.Context, connection *d.BlockChainNodeConnection, status *d.StatusHolder, queue *q.BlockProcessorQueue) error {
+
+	safe, finalized, err := FetchFinality(ctx, connection.RPC)
+	if err != nil {
+		return err
+	}
+
+	status.SetFinality(safe, finalized)
+	queue.Finalized(finalized)
+
+	return nil
+
+}
+
+// TrackFinality - Periodically polls node's `safe` & `finalized` block tags,
+// until context gets cancelled
+func TrackFinality(ctx context.Context, connection *d.BlockChainNodeConnection, status *d.StatusHolder, queue *q.BlockProcessorQueue) {
+
+	interval := time.Duration(cfg.GetFinalityPollInterval()) * time.Second
+
+	for {
+
+		if err := UpdateFinality(ctx, connection, status, queue); err != nil {
+			log.Print(color.Red.Sprintf("[!] Failed to update finality : %s", err.Error()))
+		}
+
+		select {
+
+		case <-ctx.Done():
+			return
+
+		case <-time.After(interval):
+
+		}
+
+	}
+
+}
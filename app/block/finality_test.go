@@ -0,0 +1,74 @@
+package block
+
+import (
+	"context"
+	"testing"
+
+	"github.com/denniswon/validationcloud/app/chain"
+	d "github.com/denniswon/validationcloud/app/data"
+	q "github.com/denniswon/validationcloud/app/queue"
+)
+
+func TestUpdateFinality(t *testing.T) {
+
+	fake := chain.NewFakeChain()
+	fake.Extend(10, 0)
+
+	connection := newTestConnection(fake)
+	status := newTestStatus()
+
+	// Finality decided by node's block tags
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	queue := q.New(0, true)
+	go queue.Start(ctx)
+
+	// Node doesn't support block tags yet
+	if err := UpdateFinality(context.Background(), connection, status, queue); err == nil {
+		t.Fatal("expected failure when block tags are not supported")
+	}
+
+	if queue.CanBeConfirmed(0) {
+		t.Fatal("expected nothing to be confirmed before finalized head is known")
+	}
+
+	fake.SetFinality(8, 5)
+
+	if err := UpdateFinality(context.Background(), connection, status, queue); err != nil {
+		t.Fatalf("failed to update finality : %s", err.Error())
+	}
+
+	for _, v := range []struct {
+		number   uint64
+		finality string
+	}{
+		{4, d.FinalityFinalized},
+		{5, d.FinalityFinalized},
+		{6, d.FinalitySafe},
+		{8, d.FinalitySafe},
+		{9, d.FinalityLatest},
+	} {
+
+		if finality := status.FinalityOf(v.number); finality != v.finality {
+			t.Fatalf("expected block %d to be %s, got %s", v.number, v.finality, finality)
+		}
+
+	}
+
+	if !queue.CanBeConfirmed(5) || queue.CanBeConfirmed(6) {
+		t.Fatal("expected blocks only at or below finalized head to be confirmed")
+	}
+
+	// Node lagging behind, after failover, doesn't move heads backward
+	fake.SetFinality(6, 3)
+
+	if err := UpdateFinality(context.Background(), connection, status, queue); err != nil {
+		t.Fatalf("failed to update finality : %s", err.Error())
+	}
+
+	if status.SafeBlockNumber() != 8 || status.FinalizedBlockNumber() != 5 || !queue.CanBeConfirmed(5) {
+		t.Fatal("expected safe & finalized heads to not move backward")
+	}
+
+}
@@ -0,0 +1,90 @@
+package block
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/denniswon/validationcloud/app/chain"
+	d "github.com/denniswon/validationcloud/app/data"
+	"github.com/denniswon/validationcloud/app/db"
+	q "github.com/denniswon/validationcloud/app/queue"
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+)
+
+// testChainID - Chain ID of fake chain, data indexed in tests belongs to
+const testChainID = 1337
+
+// newTestDB - Connects to database pointed to by `TEST_DATABASE_URL`, with
+// clean tables, test gets skipped if it's not set
+func newTestDB(t *testing.T) *gorm.DB {
+
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL not set, skipping database backed test")
+	}
+
+	_db, err := db.Open(dsn)
+	if err != nil {
+		t.Fatalf("failed to connect to test database : %s", err.Error())
+	}
+
+	if err := _db.Exec("truncate table blocks, transactions, events, traces, withdrawals, uncles, token_transfers, contracts, reorgs, retractions, abis, watchlist, queued_blocks").Error; err != nil {
+		t.Fatalf("failed to clean test database : %s", err.Error())
+	}
+
+	return _db
+
+}
+
+// newTestRedis - In-memory redis server, along with topic info
+func newTestRedis(t *testing.T) *d.RedisInfo {
+
+	server, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("failed to start redis : %s", err.Error())
+	}
+
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+
+	t.Cleanup(func() {
+		client.Close()
+		server.Close()
+	})
+
+	return d.NewRedisInfo(client, testChainID)
+
+}
+
+// newTestQueue - Running block processor queue, stopped when test completes
+func newTestQueue(t *testing.T) *q.BlockProcessorQueue {
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	queue := q.New(0, false)
+	go queue.Start(ctx)
+
+	return queue
+
+}
+
+// newTestStatus - Fresh sync state holder
+func newTestStatus() *d.StatusHolder {
+	return &d.StatusHolder{
+		State: &d.SyncState{},
+		Mutex: &sync.RWMutex{},
+	}
+}
+
+// newTestConnection - Connection to fake chain, used for both HTTP & Websocket
+func newTestConnection(fake *chain.FakeChain) *d.BlockChainNodeConnection {
+	return &d.BlockChainNodeConnection{
+		ChainID:   testChainID,
+		RPC:       fake,
+		Websocket: fake,
+	}
+}
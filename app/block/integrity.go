@@ -0,0 +1,469 @@
+package block
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	cfg "github.com/denniswon/validationcloud/app/config"
+	"github.com/denniswon/validationcloud/app/db"
+	q "github.com/denniswon/validationcloud/app/queue"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/gammazero/workerpool"
+	"github.com/holiman/uint256"
+	"gorm.io/gorm"
+)
+
+// errPartiallyIndexed - Only tx(s) & event(s) touching watched addresses are
+// stored, so tries of block can't be rebuilt
+var errPartiallyIndexed = errors.New("tx(s) & event(s) are partially indexed in watchlist mode, integrity can't be checked")
+
+// ErrUnverifiable - Stored tx can't be rebuilt, not because it's inconsistent, but
+// because it was stored before signatures were kept or it's of type, which can't
+// be rebuilt, so integrity of block can't be told either way
+var ErrUnverifiable = errors.New("can't be verified")
+
+// RebuildTransaction - Tx, as it was signed by sender, rebuilt from what's stored, so
+// that it can be put in tx trie of block
+func RebuildTransaction(tx *db.Transactions) (*types.Transaction, error) {
+
+	if !isSigned(tx) {
+		return nil, fmt.Errorf("stored without signature, %w", ErrUnverifiable)
+	}
+
+	var to *common.Address
+	if tx.To != "" {
+		_to := common.HexToAddress(tx.To)
+		to = &_to
+	}
+
+	var accessList types.AccessList
+	if len(tx.AccessList) != 0 {
+		if err := json.Unmarshal(tx.AccessList, &accessList); err != nil {
+			return nil, fmt.Errorf("bad access list : %s", err.Error())
+		}
+	}
+
+	// Decimal numbers are parsed one after another, first failure is remembered
+	var failure error
+	parse := func(name string, value string) *big.Int {
+
+		num, ok := new(big.Int).SetString(value, 10)
+		if !ok && failure == nil {
+			failure = fmt.Errorf("bad %s %q", name, value)
+		}
+
+		return num
+
+	}
+
+	u256 := func(num *big.Int) *uint256.Int {
+
+		if num == nil {
+			return nil
+		}
+
+		_num, overflow := uint256.FromBig(num)
+		if overflow && failure == nil {
+			failure = fmt.Errorf("%s overflows 256 bits", num.String())
+		}
+
+		return _num
+
+	}
+
+	value := parse("value", tx.Value)
+	v, r, s := parse("v", tx.V), parse("r", tx.R), parse("s", tx.S)
+
+	var data types.TxData
+
+	switch tx.Type {
+
+	case types.LegacyTxType:
+		data = &types.LegacyTx{
+			Nonce:    tx.Nonce,
+			GasPrice: parse("gas price", tx.GasPrice),
+			Gas:      tx.Gas,
+			To:       to,
+			Value:    value,
+			Data:     tx.Data,
+			V:        v,
+			R:        r,
+			S:        s,
+		}
+
+	case types.AccessListTxType:
+		data = &types.AccessListTx{
+			ChainID:    parse("chain ID", tx.ChainID),
+			Nonce:      tx.Nonce,
+			GasPrice:   parse("gas price", tx.GasPrice),
+			Gas:        tx.Gas,
+			To:         to,
+			Value:      value,
+			Data:       tx.Data,
+			AccessList: accessList,
+			V:          v,
+			R:          r,
+			S:          s,
+		}
+
+	case types.DynamicFeeTxType:
+		data = &types.DynamicFeeTx{
+			ChainID:    parse("chain ID", tx.ChainID),
+			Nonce:      tx.Nonce,
+			GasTipCap:  parse("max priority fee per gas", tx.MaxPriorityFeePerGas),
+			GasFeeCap:  parse("max fee per gas", tx.MaxFeePerGas),
+			Gas:        tx.Gas,
+			To:         to,
+			Value:      value,
+			Data:       tx.Data,
+			AccessList: accessList,
+			V:          v,
+			R:          r,
+			S:          s,
+		}
+
+	case types.BlobTxType:
+
+		if to == nil {
+			return nil, errors.New("blob tx without recipient")
+		}
+
+		hashes := make([]common.Hash, 0, len(tx.BlobHashes))
+		for _, h := range tx.BlobHashes {
+			hashes = append(hashes, common.HexToHash(h))
+		}
+
+		data = &types.BlobTx{
+			ChainID:    u256(parse("chain ID", tx.ChainID)),
+			Nonce:      tx.Nonce,
+			GasTipCap:  u256(parse("max priority fee per gas", tx.MaxPriorityFeePerGas)),
+			GasFeeCap:  u256(parse("max fee per gas", tx.MaxFeePerGas)),
+			Gas:        tx.Gas,
+			To:         *to,
+			Value:      u256(value),
+			Data:       tx.Data,
+			AccessList: accessList,
+			BlobFeeCap: u256(parse("max fee per blob gas", tx.MaxFeePerBlobGas)),
+			BlobHashes: hashes,
+			V:          u256(v),
+			R:          u256(r),
+			S:          u256(s),
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported tx type %d, %w", tx.Type, ErrUnverifiable)
+
+	}
+
+	if failure != nil {
+		return nil, failure
+	}
+
+	return types.NewTx(data), nil
+
+}
+
+// isSigned - Whether tx is stored along with its signature, which
+// is not the case for ones stored before signatures were kept
+func isSigned(tx *db.Transactions) bool {
+	return tx.V != "" && tx.R != "" && tx.S != ""
+}
+
+// RebuildReceipt - Consensus fields of receipt of tx, rebuilt from what's stored,
+// so that it can be put in receipt trie of block
+func RebuildReceipt(tx *db.Transactions, events []*db.Events) *types.Receipt {
+
+	logs := make([]*types.Log, 0, len(events))
+
+	for _, v := range events {
+
+		topics := make([]common.Hash, 0, len(v.Topics))
+		for _, t := range v.Topics {
+			topics = append(topics, common.HexToHash(t))
+		}
+
+		logs = append(logs, &types.Log{
+			Address: common.HexToAddress(v.Origin),
+			Topics:  topics,
+			Data:    v.Data,
+		})
+
+	}
+
+	return &types.Receipt{
+		Type:              tx.Type,
+		PostState:         tx.PostState,
+		Status:            tx.State,
+		CumulativeGasUsed: tx.CumulativeGasUsed,
+		Bloom:             types.BytesToBloom(tx.LogsBloom),
+		Logs:              logs,
+	}
+
+}
+
+// CheckBlockIntegrity - Rebuilds tx & receipt tries of block, from stored tx(s) & events,
+// & compares their roots with ones stored in block header, returns reason why they
+// don't match, empty if they do, along with whether block could be verified at all
+//
+// When it couldn't be, reason tells why, but block is not known to be inconsistent
+func CheckBlockIntegrity(_db *gorm.DB, block *db.Blocks) (string, bool, error) {
+
+	txs, events, err := db.GetStoredBlockContent(_db, block.Chain, block.Hash)
+	if err != nil {
+		return "", false, err
+	}
+
+	// Position of tx(s) stored before signatures were kept, is not known
+	// either, so those are looked for, before looking for gaps
+	for _, v := range txs {
+		if !isSigned(v) {
+			return fmt.Sprintf("tx %s stored without signature", v.Hash), false, nil
+		}
+	}
+
+	logs := make(map[string][]*db.Events)
+	for _, v := range events {
+		logs[v.TransactionHash] = append(logs[v.TransactionHash], v)
+	}
+
+	rebuiltTxs := make(types.Transactions, 0, len(txs))
+	receipts := make(types.Receipts, 0, len(txs))
+
+	for i, v := range txs {
+
+		if v.Index != uint(i) {
+			return fmt.Sprintf("tx at index %d missing", i), true, nil
+		}
+
+		rebuilt, err := RebuildTransaction(v)
+		if err != nil {
+			return fmt.Sprintf("tx %s can't be rebuilt : %s", v.Hash, err.Error()), !errors.Is(err, ErrUnverifiable), nil
+		}
+
+		if rebuilt.Hash().Hex() != v.Hash {
+			return fmt.Sprintf("tx %s rebuilt with hash %s", v.Hash, rebuilt.Hash().Hex()), true, nil
+		}
+
+		rebuiltTxs = append(rebuiltTxs, rebuilt)
+		receipts = append(receipts, RebuildReceipt(v, logs[v.Hash]))
+
+		delete(logs, v.Hash)
+
+	}
+
+	if len(logs) != 0 {
+		return fmt.Sprintf("event(s) of %d unknown tx(s)", len(logs)), true, nil
+	}
+
+	if root := types.DeriveSha(rebuiltTxs, trie.NewStackTrie(nil)); root.Hex() != block.TransactionRootHash {
+		return fmt.Sprintf("tx root %s, expected %s", root.Hex(), block.TransactionRootHash), true, nil
+	}
+
+	if root := types.DeriveSha(receipts, trie.NewStackTrie(nil)); root.Hex() != block.ReceiptRootHash {
+		return fmt.Sprintf("receipt root %s, expected %s", root.Hex(), block.ReceiptRootHash), true, nil
+	}
+
+	return "", true, nil
+
+}
+
+// CheckIntegrity - Checks integrity of all blocks stored in range(fromBlock, toBlock),
+// both inclusive, along with whether consecutive ones are linked by parent hash,
+// returns inconsistent ones, along with ones which couldn't be verified, both in
+// ascending order of block number
+//
+// When parent hash link is broken, both blocks are reported, as it's not
+// known which one of them is stale, blocks missing in range are reported too
+//
+// Once any block fails to be checked, no more blocks are looked at
+func CheckIntegrity(_db *gorm.DB, chainID uint64, fromBlock uint64, toBlock uint64) ([]*Mismatch, []*Mismatch, error) {
+
+	if !(fromBlock <= toBlock) {
+		return nil, nil, fmt.Errorf("bad block range [%d, %d]", fromBlock, toBlock)
+	}
+
+	if cfg.IsWatchlistModeEnabled() {
+		return nil, nil, errPartiallyIndexed
+	}
+
+	wp := workerpool.New(runtime.NumCPU() * int(cfg.GetConcurrencyFactor()))
+
+	var lock sync.Mutex
+	var failure error
+	reasons := make(map[uint64][]string)
+	unverifiable := make(map[uint64][]string)
+
+	report := func(number uint64, reason string) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		reasons[number] = append(reasons[number], reason)
+	}
+
+	failed := func() bool {
+		lock.Lock()
+		defer lock.Unlock()
+
+		return failure != nil
+	}
+
+	// Range is inclusive, so that it can end at highest possible number
+	missing := func(from uint64, to uint64) {
+		for n := from; n <= to; n++ {
+
+			report(n, "missing in DB")
+
+			if n == to {
+				break
+			}
+
+		}
+	}
+
+	// Blocks are looked at X at a time
+	var step uint64 = 1000
+	var prev *db.Blocks
+
+	for i := fromBlock; i <= toBlock; i += step {
+
+		to := i + step - 1
+		if to > toBlock || to < i {
+			to = toBlock
+		}
+
+		blocks, err := db.GetStoredBlocksInRange(_db, chainID, i, to)
+		if err != nil {
+			wp.StopWait()
+			return nil, nil, err
+		}
+
+		for _, v := range blocks {
+
+			if failed() {
+				break
+			}
+
+			next := fromBlock
+			if prev != nil {
+				next = prev.Number + 1
+			}
+
+			if v.Number > next {
+				missing(next, v.Number-1)
+			}
+
+			if prev != nil && prev.Number+1 == v.Number && prev.Hash != v.ParentHash {
+				report(prev.Number, fmt.Sprintf("not parent of block %d", v.Number))
+				report(v.Number, fmt.Sprintf("parent hash %s, expected %s", v.ParentHash, prev.Hash))
+			}
+
+			prev = v
+
+			func(block *db.Blocks) {
+
+				wp.Submit(func() {
+
+					reason, verified, err := CheckBlockIntegrity(_db, block)
+					if err != nil {
+
+						lock.Lock()
+						failure = err
+						lock.Unlock()
+						return
+
+					}
+
+					if !verified {
+
+						lock.Lock()
+						unverifiable[block.Number] = append(unverifiable[block.Number], reason)
+						lock.Unlock()
+						return
+
+					}
+
+					if reason != "" {
+						report(block.Number, reason)
+					}
+
+				})
+
+			}(v)
+
+		}
+
+		// Avoiding overflow, when range ends at highest possible number
+		if to == toBlock || failed() {
+			break
+		}
+
+	}
+
+	wp.StopWait()
+
+	if failure != nil {
+		return nil, nil, failure
+	}
+
+	// Blocks missing at the end of range
+	if prev == nil {
+		missing(fromBlock, toBlock)
+	} else if prev.Number < toBlock {
+		missing(prev.Number+1, toBlock)
+	}
+
+	return toMismatches(reasons), toMismatches(unverifiable), nil
+
+}
+
+// toMismatches - Reasons reported against blocks, joined together, in
+// ascending order of block number
+func toMismatches(reasons map[uint64][]string) []*Mismatch {
+
+	mismatches := make([]*Mismatch, 0, len(reasons))
+	for k, v := range reasons {
+		mismatches = append(mismatches, &Mismatch{Number: k, Reason: strings.Join(v, ", ")})
+	}
+
+	sort.Slice(mismatches, func(i, j int) bool {
+		return mismatches[i].Number < mismatches[j].Number
+	})
+
+	return mismatches
+
+}
+
+// RequeueInconsistentBlocks - Deletes inconsistent blocks, along with all data belonging
+// to them, & puts them into queue, so that they get processed afresh
+//
+// Only ones known to be inconsistent are to be given, never ones which
+// couldn't be verified
+//
+// Returns how many of them got requeued
+func RequeueInconsistentBlocks(_db *gorm.DB, chainID uint64, queue *q.BlockProcessorQueue, mismatches []*Mismatch) (uint64, error) {
+
+	var requeued uint64
+
+	for _, v := range mismatches {
+
+		if err := db.RemoveBlock(_db, chainID, v.Number); err != nil {
+			return requeued, err
+		}
+
+		// Already in queue, it's going to be processed anyway
+		queue.Enqueue(v.Number)
+		requeued++
+
+	}
+
+	return requeued, nil
+
+}
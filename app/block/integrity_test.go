@@ -0,0 +1,186 @@
+package block
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/denniswon/validationcloud/app/chain"
+	"github.com/denniswon/validationcloud/app/db"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/holiman/uint256"
+)
+
+func TestRebuildTransaction(t *testing.T) {
+
+	to := common.HexToAddress("0x1")
+	block := types.NewBlock(&types.Header{Number: big.NewInt(1), BaseFee: big.NewInt(10)}, nil, nil, nil, trie.NewStackTrie(nil))
+
+	accessList := types.AccessList{{Address: to, StorageKeys: []common.Hash{{}}}}
+
+	for _, tx := range []*types.Transaction{
+		signedTx(t, &types.LegacyTx{To: &to, Gas: 50000, GasPrice: big.NewInt(30), Value: big.NewInt(5), Data: []byte{1, 2}}),
+		// Contract creation
+		signedTx(t, &types.LegacyTx{Gas: 50000, GasPrice: big.NewInt(30), Data: []byte{0x60, 0x80}}),
+		signedTx(t, &types.AccessListTx{ChainID: big.NewInt(1), To: &to, Gas: 50000, GasPrice: big.NewInt(30), AccessList: accessList}),
+		signedTx(t, &types.DynamicFeeTx{ChainID: big.NewInt(1), To: &to, Gas: 50000, GasFeeCap: big.NewInt(100), GasTipCap: big.NewInt(2), AccessList: accessList}),
+		signedTx(t, &types.BlobTx{ChainID: uint256.NewInt(1), To: to, Gas: 50000, GasFeeCap: uint256.NewInt(100), GasTipCap: uint256.NewInt(2),
+			BlobFeeCap: uint256.NewInt(7), BlobHashes: []common.Hash{{1}}}),
+	} {
+
+		packed := BuildPackedTx(block, tx, common.Address{}, &types.Receipt{GasUsed: 21000}).Tx
+
+		rebuilt, err := RebuildTransaction(packed)
+		if err != nil {
+			t.Fatalf("type %d : failed to rebuild tx : %s", tx.Type(), err.Error())
+		}
+
+		if rebuilt.Hash() != tx.Hash() {
+			t.Errorf("type %d : expected tx %s, rebuilt %s", tx.Type(), tx.Hash().Hex(), rebuilt.Hash().Hex())
+		}
+
+	}
+
+	// Stored before signatures were kept
+	if _, err := RebuildTransaction(&db.Transactions{Value: "0", GasPrice: "1"}); !errors.Is(err, ErrUnverifiable) {
+		t.Fatalf("expected unsigned tx to be unverifiable, got %v", err)
+	}
+
+	// Deposit tx of L2 chain
+	if _, err := RebuildTransaction(&db.Transactions{Type: 0x7e, Value: "0", V: "0", R: "0", S: "0"}); !errors.Is(err, ErrUnverifiable) {
+		t.Fatalf("expected tx of unsupported type to be unverifiable, got %v", err)
+	}
+
+	// Corrupted value isn't just unverifiable
+	if _, err := RebuildTransaction(&db.Transactions{Value: "x", GasPrice: "1", V: "27", R: "1", S: "1"}); err == nil || errors.Is(err, ErrUnverifiable) {
+		t.Fatalf("expected corrupted tx to fail rebuilding, got %v", err)
+	}
+
+}
+
+func TestRebuildTries(t *testing.T) {
+
+	fake := chain.NewFakeChain()
+	block := fake.Extend(1, 3)[0]
+
+	receipts, err := fake.BlockReceipts(context.Background(), block)
+	if err != nil {
+		t.Fatalf("failed to fetch receipts : %s", err.Error())
+	}
+
+	txs := make(types.Transactions, 0, len(receipts))
+	rebuiltReceipts := make(types.Receipts, 0, len(receipts))
+
+	for k, v := range block.Transactions() {
+
+		packed := BuildPackedTx(block, v, common.Address{}, receipts[k])
+
+		rebuilt, err := RebuildTransaction(packed.Tx)
+		if err != nil {
+			t.Fatalf("failed to rebuild tx : %s", err.Error())
+		}
+
+		txs = append(txs, rebuilt)
+		rebuiltReceipts = append(rebuiltReceipts, RebuildReceipt(packed.Tx, packed.Events))
+
+	}
+
+	if root := types.DeriveSha(txs, trie.NewStackTrie(nil)); root != block.TxHash() {
+		t.Fatalf("expected tx root %s, rebuilt %s", block.TxHash().Hex(), root.Hex())
+	}
+
+	if root := types.DeriveSha(rebuiltReceipts, trie.NewStackTrie(nil)); root != block.ReceiptHash() {
+		t.Fatalf("expected receipt root %s, rebuilt %s", block.ReceiptHash().Hex(), root.Hex())
+	}
+
+}
+
+func TestCheckIntegrity(t *testing.T) {
+
+	_db := newTestDB(t)
+	fake := chain.NewFakeChain()
+	queue := newTestQueue(t)
+
+	blocks := fake.Extend(5, 2)
+	processAll(t, fake, _db, false, queue, blocks)
+
+	if mismatches, unverifiable, err := CheckIntegrity(_db, testChainID, 1, 5); err != nil || len(mismatches) != 0 || len(unverifiable) != 0 {
+		t.Fatalf("expected all blocks to be consistent, got %v, %v, %v", mismatches, unverifiable, err)
+	}
+
+	// Event of block 2 gets corrupted & parent hash link between 4 & 5 broken
+	if err := _db.Exec("update events set data = ? where blockhash = ?", []byte{0xff}, blocks[1].Hash().Hex()).Error; err != nil {
+		t.Fatalf("failed to corrupt event : %s", err.Error())
+	}
+
+	if err := _db.Exec("update blocks set parenthash = ? where hash = ?", common.Hash{1}.Hex(), blocks[4].Hash().Hex()).Error; err != nil {
+		t.Fatalf("failed to corrupt block : %s", err.Error())
+	}
+
+	// Block 3 as if stored before signatures & positions of tx(s) were kept
+	if err := _db.Exec(`update transactions set v = '', r = '', s = '', "index" = 0 where blockhash = ?`, blocks[2].Hash().Hex()).Error; err != nil {
+		t.Fatalf("failed to strip signatures : %s", err.Error())
+	}
+
+	mismatches, unverifiable, err := CheckIntegrity(_db, testChainID, 1, 5)
+	if err != nil {
+		t.Fatalf("failed to check integrity : %s", err.Error())
+	}
+
+	if len(unverifiable) != 1 || unverifiable[0].Number != 3 {
+		t.Fatalf("expected only block 3 to be unverifiable, got %v", unverifiable)
+	}
+
+	found := make([]uint64, 0, len(mismatches))
+	for _, v := range mismatches {
+		found = append(found, v.Number)
+	}
+
+	if !reflect.DeepEqual(found, []uint64{2, 4, 5}) {
+		t.Fatalf("expected blocks 2, 4 & 5 to be inconsistent, got %v", found)
+	}
+
+	// Blocks processed earlier are still waiting for confirmation in that queue
+	fresh := newTestQueue(t)
+
+	requeued, err := RequeueInconsistentBlocks(_db, testChainID, fresh, mismatches)
+	if err != nil || requeued != 3 {
+		t.Fatalf("expected 3 blocks to be requeued, got %d, %v", requeued, err)
+	}
+
+	for _, v := range found {
+		if db.GetBlock(_db, testChainID, v) != nil {
+			t.Fatalf("expected block %d to be deleted", v)
+		}
+	}
+
+	if stat := fresh.Stat(); stat.UnconfirmedWaiting != 3 {
+		t.Fatalf("expected 3 blocks waiting to be processed, got %+v", stat)
+	}
+
+	// Deleted blocks, along with ones never stored, are missing
+	mismatches, _, err = CheckIntegrity(_db, testChainID, 1, 7)
+	if err != nil {
+		t.Fatalf("failed to check integrity : %s", err.Error())
+	}
+
+	found = found[:0]
+	for _, v := range mismatches {
+
+		if v.Reason != "missing in DB" {
+			t.Fatalf("expected block %d to be missing, got %s", v.Number, v.Reason)
+		}
+
+		found = append(found, v.Number)
+
+	}
+
+	if !reflect.DeepEqual(found, []uint64{2, 4, 5, 6, 7}) {
+		t.Fatalf("expected blocks 2, 4, 5, 6 & 7 to be missing, got %v", found)
+	}
+
+}
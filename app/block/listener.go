@@ -2,30 +2,35 @@ package block
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"math/big"
 	"runtime"
+	"time"
 
 	cfg "github.com/denniswon/validationcloud/app/config"
 	d "github.com/denniswon/validationcloud/app/data"
 	q "github.com/denniswon/validationcloud/app/queue"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/gammazero/workerpool"
+	"github.com/gookit/color"
 	"gorm.io/gorm"
 )
 
+const (
+	// Backoff between consecutive attempts to subscribe to new heads,
+	// doubled after each failed attempt
+	minResubscribeBackoff = time.Second
+	maxResubscribeBackoff = time.Minute
+)
+
 // SubscribeToNewBlocks - Listen for new block header available, then fetch block content
 // including all transactions in different worker
+//
+// If subscription drops, it's attempted again with exponential backoff, while
+// in mean time, latest block number is polled over HTTP
 func SubscribeToNewBlocks(connection *d.BlockChainNodeConnection, _db *gorm.DB, status *d.StatusHolder, redis *d.RedisInfo, queue *q.BlockProcessorQueue) {
-	headerChan := make(chan *types.Header)
-
-	subs, err := connection.Websocket.SubscribeNewHead(context.Background(), headerChan)
-	if err != nil {
-		log.Fatalf("Failed to subscribe to block headers : %s\n", err.Error())
-	}
-	// Scheduling unsubscribe, to be executed when end of this execution scope is reached
-	defer subs.Unsubscribe()
 
 	// if first time block header being received, start syncer to fetch all block in range (last block processed, latest block)
 	first := true
@@ -36,136 +41,300 @@ func SubscribeToNewBlocks(connection *d.BlockChainNodeConnection, _db *gorm.DB,
 	wp := workerpool.New(runtime.NumCPU() * int(cfg.GetConcurrencyFactor()))
 	defer wp.Stop()
 
-	for {
-		select {
-		case err := <-subs.Err():
+	// Hash of last head processed, so that polling doesn't process same head again
+	var last common.Hash
 
-			log.Fatalf("Listener stopped : %s\n", err.Error())
+	process := func(header *types.Header) {
 
-		case header := <-headerChan:
+		last = header.Hash()
 
-			// At very beginning iteration, newly mined block number
-			// should be greater than max block number obtained from DB
-			if first && !(header.Number.Uint64() > status.MaxBlockNumberAtStartUp()) {
+		// At any iteration other than first one, if received block number > latest block number + 1,
+		// some heads were missed, may be while subscription was down or node skipped them,
+		// those are put into queue, so that retry manager picks them up
+		if !first && header.Number.Uint64() > status.GetLatestBlockNumber()+1 {
 
-				log.Fatalf("Bad block received : expected > `%d`\n", status.MaxBlockNumberAtStartUp())
+			log.Print(color.Yellow.Sprintf("[!] Skipped block(s) [%d, %d], enqueueing", status.GetLatestBlockNumber()+1, header.Number.Uint64()-1))
 
+			for num := status.GetLatestBlockNumber() + 1; num < header.Number.Uint64(); num++ {
+				queue.Enqueue(num)
 			}
 
-			// At any iteration other than first one, if received block number > latest block number + 1,
-			// something is wrong, mayne the rpc endpoint itself. crash the program for now
-			if !first && header.Number.Uint64() > status.GetLatestBlockNumber() + 1 {
+		} else if !first && !(header.Number.Uint64() == status.GetLatestBlockNumber()+1) {
+
+			// At any iteration other than first one, if received block number not exactly current latest block number + 1,
+			// then it likely be chain reorganization, which is to be confirmed by following `ParentHash`
+			log.Printf("Received block %d again, expected %d\n", header.Number.Uint64(), status.GetLatestBlockNumber()+1)
+
+		} else {
+
+			log.Printf("Received block %d\n", header.Number.Uint64())
 
-				log.Fatalf("Bad block received %d, expected %d\n", header.Number.Uint64(), status.GetLatestBlockNumber())
+		}
+
+		FollowHead(status, queue, header.Number.Uint64())
+
+		// Checking whether new head builds on top of canonical chain we've in DB,
+		// if not, orphaned blocks are rolled back & new branch gets indexed, before
+		// head itself is processed
+		HandleChainReorg(connection, _db, redis, queue, status, header)
+
+		if first {
+
+			// Starting now, to be used for calculating system performance, uptime etc.
+			status.SetStartedAt()
+
+			// Starting go routine for fetching blocks failed to process in previous attempt
+			//
+			// Uses Redis backed queue for fetching pending block hash & retries
+			go RetryQueueManager(connection, _db, redis, queue, status)
 
+			// sync to latest state of block chain
+
+			// Starting syncer in another thread, where it'll keep fetching
+			// blocks from highest block number it fetched last time to current network block number
+			// i.e. trying to fill up gap, which was caused when the service was offline
+
+			// Upper limit of syncing, in terms of block number
+			from := header.Number.Uint64() - 1
+			// Lower limit of syncing, in terms of block number
+			//
+			// Subtracting confirmation required block number count, due to
+			// the fact it might be case those block contents might have changed due to
+			// some reorg, in the time duration, when the service was offline
+			var to uint64
+			if status.MaxBlockNumberAtStartUp() < cfg.GetBlockConfirmations() {
+				to = 0
+			} else {
+				to = status.MaxBlockNumberAtStartUp() - cfg.GetBlockConfirmations()
 			}
 
-			// At any iteration other than first one, if received block number not exactly current latest block number + 1,
-			// then it likely be chain reorganization, we'll attempt to process this new block
-			if !first && !(header.Number.Uint64() == status.GetLatestBlockNumber() + 1) {
+			// When finality is decided by node's block tags, any block above
+			// finalized head might have changed while the service was offline
+			if finalized := status.FinalizedBlockNumber(); queue.FinalityTags && finalized != 0 && finalized+1 < to {
+				to = finalized + 1
+			}
 
-				log.Printf("Received block %d again, expected %d\n", header.Number.Uint64(), status.GetLatestBlockNumber() + 1)
+			// Blocks older than configured `StartBlock` or not among most
+			// recent `HistoryDepth` blocks or already pruned ones, are not to be synced
+			if lowest := cfg.GetLowestBlockToSync(header.Number.Uint64()); lowest > to {
+				to = lowest
+			}
 
+			if prunedBelow := status.PrunedBelow(); prunedBelow > to {
+				to = prunedBelow
+			}
+
+			if to <= from {
+				go SyncBlocksByRange(connection, _db, redis, queue, from, to, status)
 			} else {
+				go SyncMissingBlocksInDB(connection, _db, redis, queue, status)
+			}
+
+			// Making sure that when next latest block header is received, it'll not
+			// start another syncer
+			first = false
+
+		}
+
+		// As soon as new block is mined, try to fetch it and that job will be submitted in job queue
+		//
+		// Putting it in a different function scope so that job submitter gets its own copy of block number & block hash,
+		// otherwise it might get wrong info, if new block gets mined very soon & this job is not yet submitted
+		func(blockHash common.Hash, blockNumber uint64, _queue *q.BlockProcessorQueue) {
+
+			// Next block which can be attempted to be checked
+			// while finally considering it confirmed & put into DB
+			if nxt, ok := _queue.ConfirmedNext(); ok {
+
+				log.Printf("🔅 Processing finalised block %d [ Latest Block : %d ]\n", nxt, status.GetLatestBlockNumber())
+
+				// Note, we are taking `next` variable's copy in local scope of closure, so that during
+				// iteration over queue elements, none of them get missed, becuase in a concurrent system,
+				// previous `next` can be overwritten by new `next` & we can end up missing a block
+				func(_oldestBlock uint64, _queue *q.BlockProcessorQueue) {
+
+					wp.Submit(func() {
+
+						if !FetchBlockByNumber(connection, _oldestBlock, _db, redis, false, queue, status) {
+
+							_queue.ConfirmedFailed(_oldestBlock)
+							return
+
+						}
 
-				log.Printf("Received block %d\n", header.Number.Uint64())
+						_queue.ConfirmedDone(_oldestBlock)
+
+					})
+
+				}(nxt, _queue)
 
 			}
 
-			status.SetLatestBlockNumber(header.Number.Uint64())
-			queue.Latest(header.Number.Uint64())
-
-			if first {
-
-				// Starting now, to be used for calculating system performance, uptime etc.
-				status.SetStartedAt()
-
-				// Starting go routine for fetching blocks failed to process in previous attempt
-				//
-				// Uses Redis backed queue for fetching pending block hash & retries
-				go RetryQueueManager(connection.RPC, _db, redis, queue, status)
-
-				// sync to latest state of block chain
-
-				// Starting syncer in another thread, where it'll keep fetching
-				// blocks from highest block number it fetched last time to current network block number
-				// i.e. trying to fill up gap, which was caused when the service was offline
-
-				// Upper limit of syncing, in terms of block number
-				from := header.Number.Uint64() - 1
-				// Lower limit of syncing, in terms of block number
-				//
-				// Subtracting confirmation required block number count, due to
-				// the fact it might be case those block contents might have changed due to
-				// some reorg, in the time duration, when the service was offline
-				var to uint64
-				if status.MaxBlockNumberAtStartUp() < cfg.GetBlockConfirmations() {
-					to = 0
-				} else {
-					to = status.MaxBlockNumberAtStartUp() - cfg.GetBlockConfirmations()
+			wp.Submit(func() {
+
+				if !_queue.Put(blockNumber) {
+					return
+				}
+
+				if !FetchBlockByHash(connection, blockHash, blockNumber, _db, redis, queue, status) {
+
+					_queue.UnconfirmedFailed(blockNumber)
+					return
+
 				}
 
-				go SyncBlocksByRange(connection.RPC, _db, redis, queue, from, to, status)
+				_queue.UnconfirmedDone(blockNumber)
+
+			})
+
+		}(header.Hash(), header.Number.Uint64(), queue)
+
+	}
+
+	backoff := minResubscribeBackoff
+
+	for {
+
+		headerChan := make(chan *types.Header)
+
+		subs, err := connection.Websocket.SubscribeNewHead(context.Background(), headerChan)
+		if err != nil {
+
+			log.Print(color.Red.Sprintf("[!] Failed to subscribe to block headers, polling for %s : %s", backoff, err.Error()))
 
-				// Making sure that when next latest block header is received, it'll not
-				// start another syncer
-				first = false
+			status.SetMode(d.ModePolling)
+			PollNewBlocks(connection, status, backoff, &last, process)
 
+			if backoff *= 2; backoff > maxResubscribeBackoff {
+				backoff = maxResubscribeBackoff
 			}
 
-			// As soon as new block is mined, try to fetch it and that job will be submitted in job queue
-			//
-			// Putting it in a different function scope so that job submitter gets its own copy of block number & block hash,
-			// otherwise it might get wrong info, if new block gets mined very soon & this job is not yet submitted
-			func(blockHash common.Hash, blockNumber uint64, _queue *q.BlockProcessorQueue) {
+			continue
+
+		}
+
+		log.Print(color.Green.Sprintf("[+] Subscribed to block headers"))
+
+		status.SetMode(d.ModeSubscribed)
+		backoff = minResubscribeBackoff
+
+		ListenForNewBlocks(subs, headerChan, process)
+
+	}
+
+}
 
-				// Next block which can be attempted to be checked
-				// while finally considering it confirmed & put into DB
-				if nxt, ok := _queue.ConfirmedNext(); ok {
+// ListenForNewBlocks - Hands over each header received over subscription for processing,
+// returns as soon as subscription drops, after unsubscribing
+func ListenForNewBlocks(subs ethereum.Subscription, headerChan <-chan *types.Header, process func(*types.Header)) {
 
-					log.Printf("🔅 Processing finalised block %d [ Latest Block : %d ]\n", nxt, status.GetLatestBlockNumber())
+	// Scheduling unsubscribe, to be executed when end of this execution scope is reached
+	defer subs.Unsubscribe()
 
-					// Note, we are taking `next` variable's copy in local scope of closure, so that during
-					// iteration over queue elements, none of them get missed, becuase in a concurrent system,
-					// previous `next` can be overwritten by new `next` & we can end up missing a block
-					func(_oldestBlock uint64, _queue *q.BlockProcessorQueue) {
+	for {
+		select {
+		case err := <-subs.Err():
+
+			msg := "unsubscribed"
+			if err != nil {
+				msg = err.Error()
+			}
 
-						wp.Submit(func() {
+			log.Print(color.Red.Sprintf("[!] Block header subscription dropped : %s", msg))
+			return
 
-							if !FetchBlockByNumber(connection.RPC, _oldestBlock, _db, redis, false, queue, status) {
+		case header := <-headerChan:
 
-								_queue.ConfirmedFailed(_oldestBlock)
-								return
+			process(header)
 
-							}
+		}
+	}
 
-							_queue.ConfirmedDone(_oldestBlock)
+}
 
-						})
+// PollNewBlocks - Polls latest block number over HTTP, for given duration, & hands over
+// latest head for processing, when it's not same as last processed one
+//
+// Heads skipped in between polls are taken care of by processor
+func PollNewBlocks(connection *d.BlockChainNodeConnection, status *d.StatusHolder, duration time.Duration, last *common.Hash, process func(*types.Header)) {
 
-					}(nxt, _queue)
+	interval := time.Duration(cfg.GetHeadPollInterval()) * time.Second
+	deadline := time.Now().Add(duration)
 
-				}
+	for {
 
-				wp.Submit(func() {
+		number, err := connection.RPC.BlockNumber(context.Background())
+		if err != nil {
 
-					if !_queue.Put(blockNumber) {
-						return
-					}
+			log.Print(color.Red.Sprintf("[!] Failed to poll latest block number : %s", err.Error()))
 
-					if !FetchBlockByHash(connection.RPC, blockHash, fmt.Sprintf("%d", blockNumber), _db, redis, queue, status) {
+		} else if number >= status.GetLatestBlockNumber() {
 
-						_queue.UnconfirmedFailed(blockNumber)
-						return
+			header, err := connection.RPC.HeaderByNumber(context.Background(), new(big.Int).SetUint64(number))
+			if err != nil {
 
-					}
+				log.Print(color.Red.Sprintf("[!] Failed to fetch header of block %d : %s", number, err.Error()))
 
-					_queue.UnconfirmedDone(blockNumber)
+			} else if header.Hash() != *last {
 
-				})
+				process(header)
 
-			}(header.Hash(), header.Number.Uint64(), queue)
+			}
 
 		}
+
+		if time.Now().Add(interval).After(deadline) {
+			time.Sleep(time.Until(deadline))
+			return
+		}
+
+		time.Sleep(interval)
+
+	}
+
+}
+
+// FollowHead - Lets both status holder & queue know about latest block, along with
+// updating finality, when it's decided by number of confirmations
+func FollowHead(status *d.StatusHolder, queue *q.BlockProcessorQueue, number uint64) {
+
+	status.SetLatestBlockNumber(number)
+	queue.Latest(number)
+
+	// Unless node's block tags are used, blocks having configured number of
+	// confirmations on top of them are considered finalized
+	if !queue.FinalityTags && number >= cfg.GetBlockConfirmations() {
+		confirmed := number - cfg.GetBlockConfirmations()
+		status.SetFinality(confirmed, confirmed)
 	}
+
+}
+
+// TrackHead - Periodically polls latest block number, only to keep sync state up to
+// date, when blocks are not being processed by this instance, until context
+// gets cancelled
+func TrackHead(ctx context.Context, connection *d.BlockChainNodeConnection, status *d.StatusHolder, queue *q.BlockProcessorQueue) {
+
+	interval := time.Duration(cfg.GetHeadPollInterval()) * time.Second
+
+	for {
+
+		number, err := connection.RPC.BlockNumber(ctx)
+		if err != nil {
+			log.Print(color.Red.Sprintf("[!] Failed to poll latest block number : %s", err.Error()))
+		} else if number > status.GetLatestBlockNumber() {
+			FollowHead(status, queue, number)
+		}
+
+		select {
+
+		case <-ctx.Done():
+			return
+
+		case <-time.After(interval):
+
+		}
+
+	}
+
 }
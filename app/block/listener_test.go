@@ -0,0 +1,90 @@
+package block
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/denniswon/validationcloud/app/chain"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestListenForNewBlocks(t *testing.T) {
+
+	fake := chain.NewFakeChain()
+	headerChan := make(chan *types.Header)
+
+	subs, err := fake.SubscribeNewHead(context.Background(), headerChan)
+	if err != nil {
+		t.Fatalf("failed to subscribe : %s", err.Error())
+	}
+
+	received := make(chan *types.Header, 1)
+	done := make(chan struct{})
+
+	go func() {
+		ListenForNewBlocks(subs, headerChan, func(header *types.Header) {
+			received <- header
+		})
+		close(done)
+	}()
+
+	block := fake.Extend(1, 0)[0]
+
+	select {
+	case header := <-received:
+		if header.Hash() != block.Hash() {
+			t.Fatalf("received wrong head")
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("timed out waiting for head")
+	}
+
+	// Dropped subscription must let listener resubscribe, rather than exiting process
+	subs.Unsubscribe()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("listener didn't return after subscription dropped")
+	}
+
+}
+
+func TestPollNewBlocks(t *testing.T) {
+
+	fake := chain.NewFakeChain()
+	fake.Extend(3, 0)
+
+	status := newTestStatus()
+	var last common.Hash
+	var processed []uint64
+
+	process := func(header *types.Header) {
+		last = header.Hash()
+		processed = append(processed, header.Number.Uint64())
+		status.SetLatestBlockNumber(header.Number.Uint64())
+	}
+
+	PollNewBlocks(newTestConnection(fake), status, 0, &last, process)
+
+	if len(processed) != 1 || processed[0] != 3 {
+		t.Fatalf("expected latest head 3 to be processed, got %v", processed)
+	}
+
+	// Same head is not processed again
+	PollNewBlocks(newTestConnection(fake), status, 0, &last, process)
+
+	if len(processed) != 1 {
+		t.Fatalf("expected same head not to be processed again, got %v", processed)
+	}
+
+	fake.Extend(2, 0)
+	PollNewBlocks(newTestConnection(fake), status, 0, &last, process)
+
+	if len(processed) != 2 || processed[1] != 5 {
+		t.Fatalf("expected latest head 5 to be processed, got %v", processed)
+	}
+
+}
@@ -7,7 +7,7 @@ import (
 )
 
 // BuildPackedBlock - Builds struct holding whole block data i.e.
-// block header, block body i.e. tx(s), event log(s)
+// block header, block body i.e. tx(s), event log(s), withdrawal(s) & uncle header(s)
 func BuildPackedBlock(block *types.Block, txs []*db.PackedTransaction) *db.PackedBlock {
 
 	packedBlock := &db.PackedBlock{}
@@ -29,6 +29,59 @@ func BuildPackedBlock(block *types.Block, txs []*db.PackedTransaction) *db.Packe
 		ReceiptRootHash:     block.ReceiptHash().Hex(),
 		ExtraData:           block.Extra(),
 	}
+
+	if block.BaseFee() != nil {
+		packedBlock.Block.BaseFee = block.BaseFee().String()
+	}
+
+	if block.BlobGasUsed() != nil {
+		packedBlock.Block.BlobGasUsed = *block.BlobGasUsed()
+	}
+
+	if block.ExcessBlobGas() != nil {
+		packedBlock.Block.ExcessBlobGas = *block.ExcessBlobGas()
+	}
+
+	// Only post-Shanghai blocks carry withdrawals
+	if hash := block.Header().WithdrawalsHash; hash != nil {
+		packedBlock.Block.WithdrawalsRootHash = hash.Hex()
+	}
+
+	packedBlock.Withdrawals = make([]*db.Withdrawals, len(block.Withdrawals()))
+
+	for k, v := range block.Withdrawals() {
+
+		packedBlock.Withdrawals[k] = &db.Withdrawals{
+			BlockHash:      block.Hash().Hex(),
+			Index:          v.Index,
+			ValidatorIndex: v.Validator,
+			Address:        v.Address.Hex(),
+			Amount:         v.Amount,
+		}
+
+	}
+
+	packedBlock.Uncles = make([]*db.Uncles, len(block.Uncles()))
+
+	for k, v := range block.Uncles() {
+
+		packedBlock.Uncles[k] = &db.Uncles{
+			BlockHash:  block.Hash().Hex(),
+			Position:   uint64(k),
+			Hash:       v.Hash().Hex(),
+			Number:     v.Number.Uint64(),
+			Time:       v.Time,
+			ParentHash: v.ParentHash.Hex(),
+			Difficulty: v.Difficulty.String(),
+			GasUsed:    v.GasUsed,
+			GasLimit:   v.GasLimit,
+			Nonce:      hexutil.EncodeUint64(v.Nonce.Uint64()),
+			Miner:      v.Coinbase.Hex(),
+			ExtraData:  v.Extra,
+		}
+
+	}
+
 	packedBlock.Transactions = txs
 
 	return packedBlock
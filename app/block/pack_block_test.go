@@ -0,0 +1,91 @@
+package block
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+func TestBuildPackedBlock(t *testing.T) {
+
+	blobGasUsed, excessBlobGas := uint64(131072), uint64(262144)
+
+	block := types.NewBlock(&types.Header{Number: big.NewInt(1), BaseFee: big.NewInt(10), BlobGasUsed: &blobGasUsed, ExcessBlobGas: &excessBlobGas}, nil, nil, nil, trie.NewStackTrie(nil))
+
+	packed := BuildPackedBlock(block, nil).Block
+	if packed.BaseFee != "10" || packed.BlobGasUsed != blobGasUsed || packed.ExcessBlobGas != excessBlobGas {
+		t.Fatalf("bad fee market fields %+v", packed)
+	}
+
+	if packed.WithdrawalsRootHash != "" {
+		t.Fatalf("withdrawals root set for pre-Shanghai block")
+	}
+
+	// Pre-London block doesn't have any of them
+	packed = BuildPackedBlock(types.NewBlock(&types.Header{Number: big.NewInt(1)}, nil, nil, nil, trie.NewStackTrie(nil)), nil).Block
+	if packed.BaseFee != "" || packed.BlobGasUsed != 0 || packed.ExcessBlobGas != 0 {
+		t.Fatalf("fee market fields set for pre-London block %+v", packed)
+	}
+
+}
+
+func TestBuildPackedBlockWithWithdrawals(t *testing.T) {
+
+	withdrawals := types.Withdrawals{
+		{Index: 7, Validator: 100, Address: common.HexToAddress("0x1"), Amount: 32_000_000_000},
+		{Index: 8, Validator: 101, Address: common.HexToAddress("0x2"), Amount: 1_500},
+	}
+
+	block := types.NewBlockWithWithdrawals(&types.Header{Number: big.NewInt(1), BaseFee: big.NewInt(10)}, nil, nil, nil, withdrawals, trie.NewStackTrie(nil))
+
+	packed := BuildPackedBlock(block, nil)
+
+	if packed.Block.WithdrawalsRootHash != block.Header().WithdrawalsHash.Hex() {
+		t.Fatalf("expected withdrawals root %s, got %s", block.Header().WithdrawalsHash.Hex(), packed.Block.WithdrawalsRootHash)
+	}
+
+	if len(packed.Withdrawals) != len(withdrawals) {
+		t.Fatalf("expected %d withdrawals, got %d", len(withdrawals), len(packed.Withdrawals))
+	}
+
+	for k, v := range packed.Withdrawals {
+
+		if v.Index != withdrawals[k].Index || v.ValidatorIndex != withdrawals[k].Validator || v.Address != withdrawals[k].Address.Hex() || v.Amount != withdrawals[k].Amount || v.BlockHash != block.Hash().Hex() {
+			t.Fatalf("bad withdrawal %d : %+v", k, v)
+		}
+
+	}
+
+}
+
+func TestBuildPackedBlockWithUncles(t *testing.T) {
+
+	uncles := []*types.Header{
+		{Number: big.NewInt(9), Difficulty: big.NewInt(2), Coinbase: common.HexToAddress("0x1"), GasLimit: 30_000_000, Time: 90, Extra: []byte{1}},
+		{Number: big.NewInt(8), Difficulty: big.NewInt(3), Coinbase: common.HexToAddress("0x2"), GasLimit: 30_000_000, Time: 80},
+	}
+
+	block := types.NewBlock(&types.Header{Number: big.NewInt(10), Difficulty: big.NewInt(2)}, nil, uncles, nil, trie.NewStackTrie(nil))
+
+	packed := BuildPackedBlock(block, nil)
+
+	if len(packed.Uncles) != len(uncles) {
+		t.Fatalf("expected %d uncles, got %d", len(uncles), len(packed.Uncles))
+	}
+
+	for k, v := range packed.Uncles {
+
+		if v.Position != uint64(k) || v.Hash != uncles[k].Hash().Hex() || v.Number != uncles[k].Number.Uint64() || v.Miner != uncles[k].Coinbase.Hex() || v.BlockHash != block.Hash().Hex() {
+			t.Fatalf("bad uncle %d : %+v", k, v)
+		}
+
+	}
+
+	if packed.Uncles[1].Difficulty != "3" || packed.Uncles[0].Nonce != "0x0" {
+		t.Fatalf("bad uncle header fields")
+	}
+
+}
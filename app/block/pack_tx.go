@@ -1,6 +1,9 @@
 package block
 
 import (
+	"encoding/json"
+	"math/big"
+
 	c "github.com/denniswon/validationcloud/app/common"
 	"github.com/denniswon/validationcloud/app/db"
 	"github.com/ethereum/go-ethereum/common"
@@ -9,40 +12,69 @@ import (
 
 // BuildPackedTx - Putting all information, the service will keep for one tx
 // into a single structure, so that it becomes easier to pass to & from functions
-func BuildPackedTx(tx *types.Transaction, sender common.Address, receipt *types.Receipt) *db.PackedTransaction {
+func BuildPackedTx(block *types.Block, tx *types.Transaction, sender common.Address, receipt *types.Receipt) *db.PackedTransaction {
 
 	packedTx := &db.PackedTransaction{}
 
-	if tx.To() == nil {
+	effectiveGasPrice := EffectiveGasPrice(block, tx, receipt)
 
-		packedTx.Tx = &db.Transactions{
-			Hash:      tx.Hash().Hex(),
-			From:      sender.Hex(),
-			Contract:  receipt.ContractAddress.Hex(),
-			Value:     tx.Value().String(),
-			Data:      tx.Data(),
-			Gas:       tx.Gas(),
-			GasPrice:  tx.GasPrice().String(),
-			Cost:      tx.Cost().String(),
-			Nonce:     tx.Nonce(),
-			State:     receipt.Status,
-			BlockHash: receipt.BlockHash.Hex(),
-		}
+	packedTx.Tx = &db.Transactions{
+		Hash:              tx.Hash().Hex(),
+		From:              sender.Hex(),
+		Value:             tx.Value().String(),
+		Data:              tx.Data(),
+		Gas:               tx.Gas(),
+		GasPrice:          tx.GasPrice().String(),
+		Cost:              TransactionCost(tx, receipt, effectiveGasPrice).String(),
+		Nonce:             tx.Nonce(),
+		State:             receipt.Status,
+		BlockHash:         receipt.BlockHash.Hex(),
+		Type:              tx.Type(),
+		EffectiveGasPrice: effectiveGasPrice.String(),
+		GasUsed:           receipt.GasUsed,
+		CumulativeGasUsed: receipt.CumulativeGasUsed,
+		LogsBloom:         receipt.Bloom.Bytes(),
+		PostState:         receipt.PostState,
+		Index:             receipt.TransactionIndex,
+	}
+
+	v, r, s := tx.RawSignatureValues()
+	packedTx.Tx.V = v.String()
+	packedTx.Tx.R = r.String()
+	packedTx.Tx.S = s.String()
 
+	if tx.To() == nil {
+		packedTx.Tx.Contract = receipt.ContractAddress.Hex()
 	} else {
+		packedTx.Tx.To = tx.To().Hex()
+	}
+
+	// Legacy tx(s) signed before EIP-155 aren't bound to any chain
+	if tx.Type() != types.LegacyTxType || tx.Protected() {
+		packedTx.Tx.ChainID = tx.ChainId().String()
+	}
 
-		packedTx.Tx = &db.Transactions{
-			Hash:      tx.Hash().Hex(),
-			From:      sender.Hex(),
-			To:        tx.To().Hex(),
-			Value:     tx.Value().String(),
-			Data:      tx.Data(),
-			Gas:       tx.Gas(),
-			GasPrice:  tx.GasPrice().String(),
-			Cost:      tx.Cost().String(),
-			Nonce:     tx.Nonce(),
-			State:     receipt.Status,
-			BlockHash: receipt.BlockHash.Hex(),
+	if tx.Type() != types.LegacyTxType {
+
+		// Access list can't fail to be encoded, it's plain addresses & hashes
+		accessList, _ := json.Marshal(tx.AccessList())
+		packedTx.Tx.AccessList = accessList
+
+	}
+
+	if tx.Type() == types.DynamicFeeTxType || tx.Type() == types.BlobTxType {
+		packedTx.Tx.MaxFeePerGas = tx.GasFeeCap().String()
+		packedTx.Tx.MaxPriorityFeePerGas = tx.GasTipCap().String()
+	}
+
+	if tx.Type() == types.BlobTxType {
+
+		packedTx.Tx.MaxFeePerBlobGas = tx.BlobGasFeeCap().String()
+		packedTx.Tx.BlobGasUsed = receipt.BlobGasUsed
+		packedTx.Tx.BlobHashes = c.StringifyEventTopics(tx.BlobHashes())
+
+		if receipt.BlobGasPrice != nil {
+			packedTx.Tx.BlobGasPrice = receipt.BlobGasPrice.String()
 		}
 
 	}
@@ -62,6 +94,46 @@ func BuildPackedTx(tx *types.Transaction, sender common.Address, receipt *types.
 
 	}
 
+	packedTx.TokenTransfers = DecodeTokenTransfers(packedTx.Events)
+
 	return packedTx
 
 }
+
+// EffectiveGasPrice - Price paid by sender per unit of gas, as reported in receipt
+//
+// Nodes not reporting it, it's derived from block's base fee, which is how
+// it's charged since London
+func EffectiveGasPrice(block *types.Block, tx *types.Transaction, receipt *types.Receipt) *big.Int {
+
+	if receipt.EffectiveGasPrice != nil {
+		return new(big.Int).Set(receipt.EffectiveGasPrice)
+	}
+
+	if block.BaseFee() == nil {
+		return tx.GasPrice()
+	}
+
+	tip, err := tx.EffectiveGasTip(block.BaseFee())
+	if err != nil {
+		return tx.GasPrice()
+	}
+
+	return tip.Add(tip, block.BaseFee())
+
+}
+
+// TransactionCost - What sender actually paid i.e. transferred value, gas used
+// at effective gas price & blob gas used at blob gas price
+func TransactionCost(tx *types.Transaction, receipt *types.Receipt, effectiveGasPrice *big.Int) *big.Int {
+
+	cost := new(big.Int).Mul(new(big.Int).SetUint64(receipt.GasUsed), effectiveGasPrice)
+	cost.Add(cost, tx.Value())
+
+	if receipt.BlobGasPrice != nil {
+		cost.Add(cost, new(big.Int).Mul(new(big.Int).SetUint64(receipt.BlobGasUsed), receipt.BlobGasPrice))
+	}
+
+	return cost
+
+}
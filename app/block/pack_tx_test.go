@@ -0,0 +1,100 @@
+package block
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/holiman/uint256"
+)
+
+// signedTx - Signs tx with freshly generated key, for given chain
+func signedTx(t *testing.T, data types.TxData) *types.Transaction {
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed to generate key : %s", err.Error())
+	}
+
+	tx, err := types.SignNewTx(key, types.NewCancunSigner(big.NewInt(1)), data)
+	if err != nil {
+		t.Fatalf("failed to sign tx : %s", err.Error())
+	}
+
+	return tx
+
+}
+
+func TestBuildPackedTx(t *testing.T) {
+
+	to := common.HexToAddress("0x1")
+	block := types.NewBlock(&types.Header{Number: big.NewInt(1), BaseFee: big.NewInt(10)}, nil, nil, nil, trie.NewStackTrie(nil))
+
+	accessList := types.AccessList{{Address: to, StorageKeys: []common.Hash{{}}}}
+
+	cases := []struct {
+		name      string
+		tx        *types.Transaction
+		receipt   *types.Receipt
+		effective string
+		cost      string
+	}{
+		{
+			name:      "legacy",
+			tx:        signedTx(t, &types.LegacyTx{To: &to, Gas: 50000, GasPrice: big.NewInt(30), Value: big.NewInt(5)}),
+			receipt:   &types.Receipt{GasUsed: 21000},
+			effective: "30",
+			cost:      "630005",
+		},
+		{
+			// Node didn't report effective gas price, so it's derived from base fee
+			name:      "dynamic fee",
+			tx:        signedTx(t, &types.DynamicFeeTx{ChainID: big.NewInt(1), To: &to, Gas: 50000, GasFeeCap: big.NewInt(100), GasTipCap: big.NewInt(2), AccessList: accessList}),
+			receipt:   &types.Receipt{GasUsed: 21000},
+			effective: "12",
+			cost:      "252000",
+		},
+		{
+			name: "blob",
+			tx: signedTx(t, &types.BlobTx{ChainID: uint256.NewInt(1), To: to, Gas: 50000, GasFeeCap: uint256.NewInt(100), GasTipCap: uint256.NewInt(2),
+				BlobFeeCap: uint256.NewInt(7), BlobHashes: []common.Hash{{1}}}),
+			receipt:   &types.Receipt{GasUsed: 21000, EffectiveGasPrice: big.NewInt(11), BlobGasUsed: 131072, BlobGasPrice: big.NewInt(3)},
+			effective: "11",
+			cost:      "624216",
+		},
+	}
+
+	for _, v := range cases {
+
+		packed := BuildPackedTx(block, v.tx, common.Address{}, v.receipt).Tx
+
+		if packed.Type != v.tx.Type() || packed.ChainID != "1" || packed.GasUsed != v.receipt.GasUsed {
+			t.Errorf("%s : bad tx fields %+v", v.name, packed)
+		}
+
+		if packed.EffectiveGasPrice != v.effective {
+			t.Errorf("%s : expected effective gas price %s, got %s", v.name, v.effective, packed.EffectiveGasPrice)
+		}
+
+		if packed.Cost != v.cost {
+			t.Errorf("%s : expected cost %s, got %s", v.name, v.cost, packed.Cost)
+		}
+
+		if (packed.MaxFeePerGas == "") != (v.tx.Type() == types.LegacyTxType) {
+			t.Errorf("%s : fee caps stored for wrong tx type", v.name)
+		}
+
+		if (packed.AccessList == nil) != (v.tx.Type() == types.LegacyTxType) {
+			t.Errorf("%s : access list stored for wrong tx type", v.name)
+		}
+
+		if (len(packed.BlobHashes) == 1) != (v.tx.Type() == types.BlobTxType) || (packed.BlobGasPrice == "3") != (v.tx.Type() == types.BlobTxType) {
+			t.Errorf("%s : blob fields stored for wrong tx type", v.name)
+		}
+
+	}
+
+}
@@ -0,0 +1,108 @@
+package block
+
+import (
+	"context"
+	"log"
+	"time"
+
+	cfg "github.com/denniswon/validationcloud/app/config"
+	d "github.com/denniswon/validationcloud/app/data"
+	"github.com/denniswon/validationcloud/app/db"
+	"github.com/gookit/color"
+	"gorm.io/gorm"
+)
+
+// PruneTarget - Lowest block number to be retained in database, as per configured
+// `PruneDepth` & `PruneAge`, whichever is higher, 0 if nothing to be pruned
+func PruneTarget(_db *gorm.DB, chainID uint64, status *d.StatusHolder, now time.Time) uint64 {
+
+	var below uint64
+
+	if depth := cfg.GetPruneDepth(); depth != 0 {
+
+		if latest := status.GetLatestBlockNumber(); latest+1 > depth {
+			below = latest + 1 - depth
+		}
+
+	}
+
+	if age := cfg.GetPruneAge(); age != 0 && uint64(now.Unix()) > age {
+
+		// When no block is younger than given age, service is likely lagging
+		// behind, so not pruning by age until it catches up
+		if number, ok := db.GetOldestBlockNumberSince(_db, chainID, uint64(now.Unix())-age); ok && number > below {
+			below = number
+		}
+
+	}
+
+	return below
+
+}
+
+// Prune - Deletes all blocks older than prune target, in batches of configured size,
+// so that none of DB transactions runs for too long
+//
+// Prune target is recorded before deleting, so that missing block finder
+// doesn't attempt to fetch pruned blocks again
+func Prune(_db *gorm.DB, chainID uint64, status *d.StatusHolder, now time.Time) (uint64, error) {
+
+	below := PruneTarget(_db, chainID, status, now)
+	if below == 0 {
+		return 0, nil
+	}
+
+	status.SetPrunedBelow(below)
+
+	var pruned uint64
+	batchSize := cfg.GetPruneBatchSize()
+
+	for {
+
+		count, err := db.PruneBlocksBelow(_db, chainID, below, batchSize)
+		if err != nil {
+			return pruned, err
+		}
+
+		status.AddBlocksPruned(count)
+		pruned += count
+
+		if count < batchSize {
+			break
+		}
+
+	}
+
+	return pruned, nil
+
+}
+
+// Pruner - Periodically prunes old blocks, along with all data belonging to them,
+// until context gets cancelled
+func Pruner(ctx context.Context, _db *gorm.DB, chainID uint64, status *d.StatusHolder) {
+
+	interval := time.Duration(cfg.GetPruneInterval()) * time.Second
+
+	for {
+
+		pruned, err := Prune(_db, chainID, status, time.Now().UTC())
+		if err != nil {
+			log.Print(color.Red.Sprintf("[!] Failed to prune blocks : %s", err.Error()))
+		}
+
+		if pruned != 0 {
+			log.Print(color.Green.Sprintf("[+] Pruned %d block(s) below %d", pruned, status.PrunedBelow()))
+		}
+
+		select {
+
+		case <-ctx.Done():
+			return
+
+		case <-time.After(interval):
+
+		}
+
+	}
+
+}
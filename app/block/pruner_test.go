@@ -0,0 +1,87 @@
+package block
+
+import (
+	"testing"
+	"time"
+
+	"github.com/denniswon/validationcloud/app/chain"
+	"github.com/denniswon/validationcloud/app/db"
+	"github.com/spf13/viper"
+)
+
+func TestPrune(t *testing.T) {
+
+	_db := newTestDB(t)
+	fake := chain.NewFakeChain()
+	queue := newTestQueue(t)
+
+	// Each block is mined 12 seconds after its parent, genesis at 0
+	blocks := fake.Extend(20, 1)
+	processAll(t, fake, _db, false, queue, blocks)
+
+	// Contract deployed in block to be pruned, another one upgraded there
+	for _, v := range []*db.Contracts{
+		{Chain: testChainID, Address: "0x4774fEd3f2838f504006BE53155cA9cbDDEe9f0c", Creator: "0x9cbDDEe9f0c4774fEd3f2838f504006BE53155cA", BlockHash: blocks[0].Hash().Hex(), BlockNumber: 1, CodeHash: blocks[0].Hash().Hex()},
+		{Chain: testChainID, Address: "0x53155cA9cbDDEe9f0c4774fEd3f2838f504006BE", BlockHash: blocks[1].Hash().Hex(), BlockNumber: 2, ProxyType: "eip1967", UpgradedAt: 2},
+	} {
+
+		if err := db.UpsertContract(_db, v); err != nil {
+			t.Fatalf("failed to put contract : %s", err.Error())
+		}
+
+	}
+
+	t.Cleanup(viper.Reset)
+
+	viper.Set("PruneDepth", "10")
+	viper.Set("PruneBatchSize", "3")
+
+	status := newTestStatus()
+	status.SetLatestBlockNumber(20)
+
+	now := time.Unix(240, 0)
+
+	pruned, err := Prune(_db, testChainID, status, now)
+	if err != nil {
+		t.Fatalf("failed to prune : %s", err.Error())
+	}
+
+	if pruned != 10 || status.PrunedBelow() != 11 || status.GetBlocksPruned() != 10 {
+		t.Fatalf("expected 10 blocks pruned below 11, got %d below %d", pruned, status.PrunedBelow())
+	}
+
+	if oldest := db.GetCurrentOldestBlockNumber(_db, testChainID); oldest != 11 {
+		t.Fatalf("expected oldest block 11, got %d", oldest)
+	}
+
+	// Tx(s) of pruned blocks go away along with them
+	if count := countRows(t, _db, &db.Transactions{}); count != 10 {
+		t.Fatalf("expected 10 tx(s) left, got %d", count)
+	}
+
+	// Contracts outlive blocks they were deployed in
+	if count := countRows(t, _db, &db.Contracts{}); count != 2 {
+		t.Fatalf("expected 2 contracts to survive pruning, got %d", count)
+	}
+
+	// Blocks mined more than a minute ago
+	viper.Set("PruneAge", "60")
+
+	if pruned, err = Prune(_db, testChainID, status, now); err != nil {
+		t.Fatalf("failed to prune : %s", err.Error())
+	}
+
+	if pruned != 4 || status.PrunedBelow() != 15 {
+		t.Fatalf("expected 4 blocks pruned below 15, got %d below %d", pruned, status.PrunedBelow())
+	}
+
+	// Nothing is young enough, so not pruning by age
+	if pruned, err = Prune(_db, testChainID, status, time.Unix(3600, 0)); err != nil || pruned != 0 {
+		t.Fatalf("expected nothing to be pruned, got %d", pruned)
+	}
+
+	if status.PrunedBelow() != 15 {
+		t.Fatalf("expected blocks to be pruned below 15, got %d", status.PrunedBelow())
+	}
+
+}
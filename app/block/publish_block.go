@@ -9,7 +9,12 @@ import (
 )
 
 // PublishBlock - Attempts to publish block data to Redis pubsub channel
-func PublishBlock(block *db.PackedBlock, redis *d.RedisInfo) bool {
+//
+// If `removed` is set, block along with all of its tx(s) & event(s) are
+// published as retracted, because they got orphaned due to chain reorganization
+//
+// Finality status is published along with block, unless it's empty
+func PublishBlock(block *db.PackedBlock, finality string, removed bool, redis *d.RedisInfo) bool {
 
 	if block == nil {
 		return false
@@ -31,6 +36,12 @@ func PublishBlock(block *db.PackedBlock, redis *d.RedisInfo) bool {
 		TransactionRootHash: block.Block.TransactionRootHash,
 		ReceiptRootHash:     block.Block.ReceiptRootHash,
 		ExtraData:           block.Block.ExtraData,
+		BaseFee:             block.Block.BaseFee,
+		BlobGasUsed:         block.Block.BlobGasUsed,
+		ExcessBlobGas:       block.Block.ExcessBlobGas,
+		WithdrawalsRootHash: block.Block.WithdrawalsRootHash,
+		Finality:            finality,
+		Removed:             removed,
 	}
 
 	if err := redis.Client.Publish(context.Background(), redis.BlockPublishTopic, _block).Err(); err != nil {
@@ -40,8 +51,21 @@ func PublishBlock(block *db.PackedBlock, redis *d.RedisInfo) bool {
 
 	}
 
-	log.Printf("📎 Published block %d\n", block.Block.Number)
+	if removed {
+		log.Printf("📎 Published retraction of block %d\n", block.Block.Number)
+	} else {
+		log.Printf("📎 Published block %d\n", block.Block.Number)
+	}
+
+	if !PublishWithdrawals(block.Block.Number, block.Withdrawals, removed, redis) {
+		return false
+	}
+
+	// Block doesn't contain any tx, nothing more to publish
+	if len(block.Transactions) == 0 {
+		return true
+	}
 
-	return PublishTxs(block.Block.Number, block.Transactions, redis)
+	return PublishTxs(block.Block.Number, block.Transactions, removed, redis)
 
 }
@@ -10,17 +10,17 @@ import (
 
 // PublishEvents - Iterate over all events & try to publish them on
 // redis pubsub channel
-func PublishEvents(blockNumber uint64, events []*db.Events, redis *d.RedisInfo) bool {
+func PublishEvents(blockNumber uint64, events []*db.Events, removed bool, redis *d.RedisInfo) bool {
 
 	if events == nil {
 		return false
 	}
 
-	var status bool
+	status := true
 
 	for _, e := range events {
 
-		status = PublishEvent(blockNumber, e, redis)
+		status = PublishEvent(blockNumber, e, removed, redis)
 		if !status {
 			break
 		}
@@ -34,7 +34,9 @@ func PublishEvents(blockNumber uint64, events []*db.Events, redis *d.RedisInfo)
 // PublishEvent - Publishing event/ log entry to redis pub-sub topic, to be captured by subscribers
 // and sent to client application, who are interested in this piece of data
 // after applying filter
-func PublishEvent(blockNumber uint64, event *db.Events, redis *d.RedisInfo) bool {
+//
+// If `removed` is set, event is published as retracted
+func PublishEvent(blockNumber uint64, event *db.Events, removed bool, redis *d.RedisInfo) bool {
 
 	if event == nil {
 		return false
@@ -47,6 +49,7 @@ func PublishEvent(blockNumber uint64, event *db.Events, redis *d.RedisInfo) bool
 		Data:            event.Data,
 		TransactionHash: event.TransactionHash,
 		BlockHash:       event.BlockHash,
+		Removed:         removed,
 	}
 
 	if err := redis.Client.Publish(context.Background(), redis.EventPublishTopic, data).Err(); err != nil {
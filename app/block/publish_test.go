@@ -0,0 +1,292 @@
+package block
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/denniswon/validationcloud/app/chain"
+	d "github.com/denniswon/validationcloud/app/data"
+	"github.com/denniswon/validationcloud/app/db"
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+)
+
+// subscribeAll - Subscribes to all topics, where block data gets published
+func subscribeAll(t *testing.T, info *d.RedisInfo) <-chan *redis.Message {
+
+	sub := info.Client.Subscribe(context.Background(), info.BlockPublishTopic, info.TxPublishTopic, info.EventPublishTopic, info.WithdrawalPublishTopic)
+	t.Cleanup(func() {
+		sub.Close()
+	})
+
+	// Waiting for confirmation of all subscriptions
+	for i := 0; i < 4; i++ {
+		if _, err := sub.Receive(context.Background()); err != nil {
+			t.Fatalf("failed to subscribe : %s", err.Error())
+		}
+	}
+
+	return sub.Channel()
+
+}
+
+// receive - Waits for `count` messages to be published
+func receive(t *testing.T, messages <-chan *redis.Message, count int) []*redis.Message {
+
+	received := make([]*redis.Message, 0, count)
+
+	for len(received) < count {
+
+		select {
+
+		case msg := <-messages:
+			received = append(received, msg)
+
+		case <-time.After(time.Second):
+			t.Fatalf("timed out after receiving %d of %d messages", len(received), count)
+
+		}
+
+	}
+
+	return received
+
+}
+
+// packBlocks - Builds packed blocks from fake chain, as pipeline does
+func packBlocks(t *testing.T, fake *chain.FakeChain, count int, txCount int) []*db.PackedBlock {
+
+	blocks := fake.Extend(count, txCount)
+	packed := make([]*db.PackedBlock, len(blocks))
+
+	for k, v := range blocks {
+
+		packedTxs, err := FetchTransactionsOfBlock(fake, v)
+		if err != nil {
+			t.Fatalf("failed to fetch tx(s) : %s", err.Error())
+		}
+
+		packed[k] = BuildPackedBlock(v, packedTxs)
+
+	}
+
+	return packed
+
+}
+
+func TestPublishBlock(t *testing.T) {
+
+	info := newTestRedis(t)
+	messages := subscribeAll(t, info)
+
+	block := packBlocks(t, chain.NewFakeChain(), 1, 2)[0]
+
+	if !PublishBlock(block, d.FinalityLatest, false, info) {
+		t.Fatalf("failed to publish block")
+	}
+
+	// 1 block, 2 tx(s), 2 event(s)
+	for _, v := range receive(t, messages, 5) {
+
+		var payload map[string]interface{}
+		if err := json.Unmarshal([]byte(v.Payload), &payload); err != nil {
+			t.Fatalf("bad payload on %s : %s", v.Channel, err.Error())
+		}
+
+		if _, ok := payload["removed"]; ok {
+			t.Fatalf("regular notification on %s carries `removed` field", v.Channel)
+		}
+
+	}
+
+}
+
+func TestPublishBlockWithoutTxs(t *testing.T) {
+
+	info := newTestRedis(t)
+	messages := subscribeAll(t, info)
+
+	block := packBlocks(t, chain.NewFakeChain(), 1, 0)[0]
+
+	if !PublishBlock(block, d.FinalityLatest, false, info) {
+		t.Fatalf("failed to publish block without tx(s)")
+	}
+
+	msg := receive(t, messages, 1)[0]
+	if msg.Channel != info.BlockPublishTopic {
+		t.Fatalf("expected block on %s, got on %s", info.BlockPublishTopic, msg.Channel)
+	}
+
+	var published struct {
+		Finality string `json:"finality"`
+	}
+
+	if err := json.Unmarshal([]byte(msg.Payload), &published); err != nil {
+		t.Fatalf("bad block payload : %s", err.Error())
+	}
+
+	if published.Finality != d.FinalityLatest {
+		t.Fatalf("expected finality %q, got %q", d.FinalityLatest, published.Finality)
+	}
+
+}
+
+// putRetractions - Holds blocks to be retracted, as if they got rolled back
+func putRetractions(t *testing.T, _db *gorm.DB, blocks []*db.PackedBlock) {
+
+	for _, v := range blocks {
+		v.OnChain(testChainID)
+	}
+
+	if err := db.PutRetractions(_db, blocks); err != nil {
+		t.Fatalf("failed to hold retractions : %s", err.Error())
+	}
+
+}
+
+func TestRetractBlocks(t *testing.T) {
+
+	_db := newTestDB(t)
+	info := newTestRedis(t)
+	messages := subscribeAll(t, info)
+
+	blocks := packBlocks(t, chain.NewFakeChain(), 2, 1)
+
+	// Block 1 orphaned first, then again along with block 2, by deeper reorg
+	putRetractions(t, _db, blocks[:1])
+	putRetractions(t, _db, blocks)
+
+	if !RetractBlocks(_db, testChainID, info) {
+		t.Fatalf("failed to retract blocks")
+	}
+
+	// 2 blocks, each with 1 tx & 1 event, each retracted only once
+	received := receive(t, messages, 6)
+
+	select {
+	case msg := <-messages:
+		t.Fatalf("unexpected message on %s", msg.Channel)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	var order []uint64
+
+	for _, v := range received {
+
+		var payload struct {
+			Number   uint64  `json:"number"`
+			Removed  bool    `json:"removed"`
+			Finality *string `json:"finality"`
+		}
+
+		if err := json.Unmarshal([]byte(v.Payload), &payload); err != nil {
+			t.Fatalf("bad payload on %s : %s", v.Channel, err.Error())
+		}
+
+		if !payload.Removed {
+			t.Fatalf("retraction on %s not marked removed", v.Channel)
+		}
+
+		if payload.Finality != nil {
+			t.Fatalf("retraction on %s carries finality %q", v.Channel, *payload.Finality)
+		}
+
+		if v.Channel == info.BlockPublishTopic {
+			order = append(order, payload.Number)
+		}
+
+	}
+
+	if len(order) != 2 || order[0] != 2 || order[1] != 1 {
+		t.Fatalf("expected retractions of block 2 & 1 in order, got %v", order)
+	}
+
+	if count := countRows(t, _db, &db.Retractions{}); count != 0 {
+		t.Fatalf("expected retracted blocks to be forgotten, %d left", count)
+	}
+
+}
+
+func TestPublishBlockWithWithdrawals(t *testing.T) {
+
+	info := newTestRedis(t)
+	messages := subscribeAll(t, info)
+
+	block := packBlocks(t, chain.NewFakeChain(), 1, 0)[0]
+	block.Withdrawals = []*db.Withdrawals{
+		{BlockHash: block.Block.Hash, Index: 1, ValidatorIndex: 2, Address: "0x4774fEd3f2838f504006BE53155cA9cbDDEe9f0c", Amount: 3},
+	}
+
+	if !PublishBlock(block, "", true, info) {
+		t.Fatalf("failed to publish block with withdrawals")
+	}
+
+	msg := receive(t, messages, 2)[1]
+	if msg.Channel != info.WithdrawalPublishTopic {
+		t.Fatalf("expected withdrawal on %s, got on %s", info.WithdrawalPublishTopic, msg.Channel)
+	}
+
+	var withdrawal d.Withdrawal
+	if err := json.Unmarshal([]byte(msg.Payload), &withdrawal); err != nil {
+		t.Fatalf("bad withdrawal payload : %s", err.Error())
+	}
+
+	if withdrawal.Index != 1 || withdrawal.Amount != 3 || !withdrawal.Removed {
+		t.Fatalf("bad withdrawal published %+v", withdrawal)
+	}
+
+}
+
+func TestRetractBlocksRetried(t *testing.T) {
+
+	_db := newTestDB(t)
+	info := newTestRedis(t)
+	messages := subscribeAll(t, info)
+
+	blocks := packBlocks(t, chain.NewFakeChain(), 2, 0)
+	putRetractions(t, _db, blocks)
+
+	// Connection to pubsub lost, while retracting
+	broken := *info
+	broken.Client = redis.NewClient(&redis.Options{Addr: info.Client.Options().Addr})
+	broken.Client.Close()
+
+	if RetractBlocks(_db, testChainID, &broken) {
+		t.Fatalf("expected retraction to fail")
+	}
+
+	if count := countRows(t, _db, &db.Retractions{}); count != 2 {
+		t.Fatalf("expected 2 blocks to be still held, got %d", count)
+	}
+
+	// Pending ones are retracted, before anything else gets published
+	if !RetractPending(_db, testChainID, info) {
+		t.Fatalf("failed to retract pending blocks")
+	}
+
+	received := receive(t, messages, 2)
+
+	for k, v := range received {
+
+		var payload struct {
+			Number  uint64 `json:"number"`
+			Removed bool   `json:"removed"`
+		}
+
+		if err := json.Unmarshal([]byte(v.Payload), &payload); err != nil {
+			t.Fatalf("bad payload on %s : %s", v.Channel, err.Error())
+		}
+
+		if !payload.Removed || payload.Number != blocks[1-k].Block.Number {
+			t.Fatalf("expected retraction of block %d, got %s", blocks[1-k].Block.Number, v.Payload)
+		}
+
+	}
+
+	if count := countRows(t, _db, &db.Retractions{}); count != 0 {
+		t.Fatalf("expected retracted blocks to be forgotten, %d left", count)
+	}
+
+}
@@ -10,18 +10,18 @@ import (
 
 // PublishTxs - Publishes all transactions in a block to redis pubsub
 // channel
-func PublishTxs(blockNumber uint64, txs []*db.PackedTransaction, redis *d.RedisInfo) bool {
+func PublishTxs(blockNumber uint64, txs []*db.PackedTransaction, removed bool, redis *d.RedisInfo) bool {
 
 	if txs == nil {
 		return false
 	}
 
 	var eventCount uint64
-	var status bool
+	status := true
 
 	for _, t := range txs {
 
-		status = PublishTx(blockNumber, t, redis)
+		status = PublishTx(blockNumber, t, removed, redis)
 		if !status {
 			break
 		}
@@ -44,44 +44,41 @@ func PublishTxs(blockNumber uint64, txs []*db.PackedTransaction, redis *d.RedisI
 
 // PublishTx - Publishes tx & events in tx, related data to respective
 // Redis pubsub channel
-func PublishTx(blockNumber uint64, tx *db.PackedTransaction, redis *d.RedisInfo) bool {
+func PublishTx(blockNumber uint64, tx *db.PackedTransaction, removed bool, redis *d.RedisInfo) bool {
 
 	if tx == nil {
 		return false
 	}
 
-	var pTx *d.Transaction
-
-	if tx.Tx.To == "" {
-		// This is a contract creation tx
-		pTx = &d.Transaction{
-			Hash:      tx.Tx.Hash,
-			From:      tx.Tx.From,
-			Contract:  tx.Tx.Contract,
-			Value:     tx.Tx.Value,
-			Data:      tx.Tx.Data,
-			Gas:       tx.Tx.Gas,
-			GasPrice:  tx.Tx.GasPrice,
-			Cost:      tx.Tx.Cost,
-			Nonce:     tx.Tx.Nonce,
-			State:     tx.Tx.State,
-			BlockHash: tx.Tx.BlockHash,
-		}
-	} else {
-		// This is a normal tx, so we keep contract field empty
-		pTx = &d.Transaction{
-			Hash:      tx.Tx.Hash,
-			From:      tx.Tx.From,
-			To:        tx.Tx.To,
-			Value:     tx.Tx.Value,
-			Data:      tx.Tx.Data,
-			Gas:       tx.Tx.Gas,
-			GasPrice:  tx.Tx.GasPrice,
-			Cost:      tx.Tx.Cost,
-			Nonce:     tx.Tx.Nonce,
-			State:     tx.Tx.State,
-			BlockHash: tx.Tx.BlockHash,
-		}
+	// Only one of `to` & `contract` is set, depending on whether
+	// it's a contract creation tx or not
+	pTx := &d.Transaction{
+		Hash:                 tx.Tx.Hash,
+		From:                 tx.Tx.From,
+		To:                   tx.Tx.To,
+		Contract:             tx.Tx.Contract,
+		Value:                tx.Tx.Value,
+		Data:                 tx.Tx.Data,
+		Gas:                  tx.Tx.Gas,
+		GasPrice:             tx.Tx.GasPrice,
+		Cost:                 tx.Tx.Cost,
+		Nonce:                tx.Tx.Nonce,
+		State:                tx.Tx.State,
+		BlockHash:            tx.Tx.BlockHash,
+		Type:                 tx.Tx.Type,
+		ChainID:              tx.Tx.ChainID,
+		MaxFeePerGas:         tx.Tx.MaxFeePerGas,
+		MaxPriorityFeePerGas: tx.Tx.MaxPriorityFeePerGas,
+		EffectiveGasPrice:    tx.Tx.EffectiveGasPrice,
+		GasUsed:              tx.Tx.GasUsed,
+		CumulativeGasUsed:    tx.Tx.CumulativeGasUsed,
+		AccessList:           tx.Tx.AccessList,
+		MaxFeePerBlobGas:     tx.Tx.MaxFeePerBlobGas,
+		BlobGasUsed:          tx.Tx.BlobGasUsed,
+		BlobGasPrice:         tx.Tx.BlobGasPrice,
+		BlobHashes:           tx.Tx.BlobHashes,
+		LogsBloom:            tx.Tx.LogsBloom,
+		Removed:              removed,
 	}
 
 	if err := redis.Client.Publish(context.Background(), redis.TxPublishTopic, pTx).Err(); err != nil {
@@ -91,6 +88,6 @@ func PublishTx(blockNumber uint64, tx *db.PackedTransaction, redis *d.RedisInfo)
 
 	}
 
-	return PublishEvents(blockNumber, tx.Events, redis)
+	return PublishEvents(blockNumber, tx.Events, removed, redis)
 
 }
@@ -0,0 +1,63 @@
+package block
+
+import (
+	"context"
+	"log"
+
+	d "github.com/denniswon/validationcloud/app/data"
+	"github.com/denniswon/validationcloud/app/db"
+)
+
+// PublishWithdrawals - Iterate over all withdrawals processed in block & try
+// to publish them on redis pubsub channel
+func PublishWithdrawals(blockNumber uint64, withdrawals []*db.Withdrawals, removed bool, redis *d.RedisInfo) bool {
+
+	status := true
+
+	for _, w := range withdrawals {
+
+		status = PublishWithdrawal(blockNumber, w, removed, redis)
+		if !status {
+			break
+		}
+
+	}
+
+	if status && len(withdrawals) != 0 {
+		log.Printf("📎 Published %d withdrawals of block %d\n", len(withdrawals), blockNumber)
+	}
+
+	return status
+
+}
+
+// PublishWithdrawal - Publishing withdrawal to redis pub-sub topic, to be captured by subscribers
+// and sent to client application, who are interested in this piece of data
+// after applying filter
+//
+// If `removed` is set, withdrawal is published as retracted
+func PublishWithdrawal(blockNumber uint64, withdrawal *db.Withdrawals, removed bool, redis *d.RedisInfo) bool {
+
+	if withdrawal == nil {
+		return false
+	}
+
+	data := &d.Withdrawal{
+		Index:          withdrawal.Index,
+		ValidatorIndex: withdrawal.ValidatorIndex,
+		Address:        withdrawal.Address,
+		Amount:         withdrawal.Amount,
+		BlockHash:      withdrawal.BlockHash,
+		Removed:        removed,
+	}
+
+	if err := redis.Client.Publish(context.Background(), redis.WithdrawalPublishTopic, data).Err(); err != nil {
+
+		log.Printf("Failed to publish withdrawal from block %d : %s\n", blockNumber, err.Error())
+		return false
+
+	}
+
+	return true
+
+}
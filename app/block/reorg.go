@@ -0,0 +1,256 @@
+package block
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/denniswon/validationcloud/app/chain"
+	cfg "github.com/denniswon/validationcloud/app/config"
+	d "github.com/denniswon/validationcloud/app/data"
+	"github.com/denniswon/validationcloud/app/db"
+	q "github.com/denniswon/validationcloud/app/queue"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/gookit/color"
+	"gorm.io/gorm"
+)
+
+// FindForkPoint - Given newly received chain head, walks back following `ParentHash`
+// until it meets block which is already present in DB i.e. part of canonical chain,
+// as known to the service
+//
+// Returns number & hash of common ancestor block, along with blocks of new branch
+// ( in ascending order ), which were fetched while walking back, excluding head itself
+//
+// If nothing is known at some height, while walking back, it's considered to be
+// common ancestor, because there's nothing to compare against
+func FindForkPoint(client chain.ChainSource, _db *gorm.DB, chainID uint64, header *types.Header) (uint64, common.Hash, []*types.Block, error) {
+
+	if header.Number.Uint64() == 0 {
+		return 0, header.Hash(), nil, nil
+	}
+
+	parent := header.ParentHash
+	branch := make([]*types.Block, 0)
+
+	for num := header.Number.Uint64() - 1; ; num-- {
+
+		stored := db.GetBlock(_db, chainID, num)
+		if stored == nil || stored.Hash == parent.Hex() {
+
+			// Putting blocks of new branch in ascending order
+			for i, j := 0, len(branch)-1; i < j; i, j = i+1, j-1 {
+				branch[i], branch[j] = branch[j], branch[i]
+			}
+
+			return num, parent, branch, nil
+
+		}
+
+		if num == 0 {
+			return 0, parent, nil, errors.New("genesis block mismatch")
+		}
+
+		if !(uint64(len(branch)) < cfg.GetMaxReorgDepth()) {
+			return 0, parent, nil, fmt.Errorf("reorg deeper than %d blocks", cfg.GetMaxReorgDepth())
+		}
+
+		block, err := client.BlockByHash(context.Background(), parent)
+		if err != nil {
+			return 0, parent, nil, err
+		}
+
+		branch = append(branch, block)
+		parent = block.ParentHash()
+
+	}
+
+}
+
+// HandleChainReorg - Checks whether newly received chain head builds on top of
+// canonical chain stored in DB, if not, all orphaned blocks are rolled back
+// & blocks of new branch get indexed, before head itself gets processed
+//
+// Returns true, if chain reorganization was detected & handled
+func HandleChainReorg(connection *d.BlockChainNodeConnection, _db *gorm.DB, redis *d.RedisInfo, queue *q.BlockProcessorQueue, status *d.StatusHolder, header *types.Header) bool {
+
+	ancestor, ancestorHash, branch, err := FindForkPoint(connection.RPC, _db, connection.ChainID, header)
+	if err != nil {
+
+		log.Print(color.Red.Sprintf("[!] Failed to find fork point for block %d : %s", header.Number.Uint64(), err.Error()))
+		return false
+
+	}
+
+	reorg, orphaned, err := db.Rollback(_db, connection.ChainID, ancestor, ancestorHash.Hex(), header.Hash().Hex())
+	if err != nil {
+
+		log.Print(color.Red.Sprintf("[!] Failed to rollback orphaned blocks above %d : %s", ancestor, err.Error()))
+		return false
+
+	}
+
+	// New head builds on top of what we already have
+	if reorg == nil {
+		return false
+	}
+
+	log.Print(color.Yellow.Sprintf("[!] Chain reorganization detected at block %d, orphaned %d block(s) [ Common ancestor : %d ]", reorg.Number, reorg.Depth, ancestor))
+
+	status.AddBlocksRemoved(reorg.Depth)
+
+	// Letting subscribers know, data they've already received is no more
+	// part of canonical chain, before new branch gets published
+	//
+	// On failure, new branch doesn't get published until retraction does
+	if !RetractBlocks(_db, connection.ChainID, redis) {
+		log.Print(color.Red.Sprintf("[!] Failed to retract %d orphaned block(s) above %d, to be retried", len(orphaned), ancestor))
+	}
+
+	// Forgetting processing history of orphaned blocks, so that
+	// their replacements can be put into queue again
+	for _, v := range orphaned {
+		queue.Reorged(v.Block.Number)
+	}
+	queue.Reorged(header.Number.Uint64())
+
+	for _, v := range branch {
+
+		queue.Reorged(v.NumberU64())
+
+		if !queue.Put(v.NumberU64()) {
+			continue
+		}
+
+		if !ProcessBlockContent(connection, v, _db, redis, true, queue, status, time.Now().UTC()) {
+
+			queue.UnconfirmedFailed(v.NumberU64())
+			continue
+
+		}
+
+		queue.UnconfirmedDone(v.NumberU64())
+
+	}
+
+	return true
+
+}
+
+// RollbackReplacedBlock - Checks whether some other block is already present in DB
+// at height of this block, if yes, it's been replaced due to chain reorganization,
+// so persisted block along with all of its descendants are rolled back & retracted
+// from pubsub topics
+//
+// Returns true, if rollback happened
+func RollbackReplacedBlock(block *types.Block, _db *gorm.DB, chainID uint64, redis *d.RedisInfo, queue *q.BlockProcessorQueue, status *d.StatusHolder) (bool, error) {
+
+	if block.NumberU64() == 0 {
+		return false, nil
+	}
+
+	stored := db.GetBlock(_db, chainID, block.NumberU64())
+	if stored == nil || stored.Hash == block.Hash().Hex() {
+		return false, nil
+	}
+
+	reorg, orphaned, err := db.Rollback(_db, chainID, block.NumberU64()-1, block.ParentHash().Hex(), block.Hash().Hex())
+	if err != nil {
+		return false, err
+	}
+
+	// Some other worker has already rolled it back
+	if reorg == nil {
+		return false, nil
+	}
+
+	log.Print(color.Yellow.Sprintf("[!] Block %d replaced due to chain reorganization, orphaned %d block(s)", block.NumberU64(), reorg.Depth))
+
+	status.AddBlocksRemoved(reorg.Depth)
+
+	if !RetractBlocks(_db, chainID, redis) {
+		log.Print(color.Red.Sprintf("[!] Failed to retract %d orphaned block(s) from %d, to be retried", len(orphaned), block.NumberU64()))
+	}
+
+	// Descendants need to be processed again, as they're now gone from DB
+	for _, v := range orphaned {
+		if v.Block.Number > block.NumberU64() {
+			queue.Reorged(v.Block.Number)
+		}
+	}
+
+	return true, nil
+
+}
+
+// retractions - Chains known to have no retraction pending, so that database isn't
+// looked up every time block is about to be published, while it also makes sure
+// retractions of chain are published one after another
+var retractions = struct {
+	sync.Mutex
+	clear map[uint64]bool
+}{clear: make(map[uint64]bool)}
+
+// RetractBlocks - Publishes orphaned blocks of chain, held in DB since they got rolled back, along
+// with their tx(s) & event(s), on respective pubsub topics with `removed` flag set, so that
+// subscribers can undo whatever they did with those
+//
+// Retractions are published in descending order of block number i.e. latest first, each
+// one is forgotten once published, while rest are attempted again on next invocation
+func RetractBlocks(_db *gorm.DB, chainID uint64, redis *d.RedisInfo) bool {
+
+	retractions.Lock()
+	defer retractions.Unlock()
+
+	retractions.clear[chainID] = false
+
+	orphaned, err := db.GetRetractions(_db, chainID)
+	if err != nil {
+
+		log.Print(color.Red.Sprintf("[!] Failed to read orphaned blocks to be retracted : %s", err.Error()))
+		return false
+
+	}
+
+	for _, v := range orphaned {
+
+		if !PublishBlock(v, "", true, redis) {
+
+			log.Print(color.Red.Sprintf("[!] Failed to retract orphaned block %d", v.Block.Number))
+			return false
+
+		}
+
+		if err := db.RemoveRetraction(_db, chainID, v.Block.Hash); err != nil {
+
+			log.Print(color.Red.Sprintf("[!] Failed to forget retracted block %d : %s", v.Block.Number, err.Error()))
+			return false
+
+		}
+
+	}
+
+	retractions.clear[chainID] = true
+	return true
+
+}
+
+// RetractPending - Publishes retractions of chain, which failed earlier or were left
+// when service went down, if any, to be invoked before publishing block
+func RetractPending(_db *gorm.DB, chainID uint64, redis *d.RedisInfo) bool {
+
+	retractions.Lock()
+	clear := retractions.clear[chainID]
+	retractions.Unlock()
+
+	if clear {
+		return true
+	}
+
+	return RetractBlocks(_db, chainID, redis)
+
+}
@@ -8,7 +8,6 @@ import (
 	cfg "github.com/denniswon/validationcloud/app/config"
 	d "github.com/denniswon/validationcloud/app/data"
 	q "github.com/denniswon/validationcloud/app/queue"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/gammazero/workerpool"
 	"gorm.io/gorm"
 )
@@ -17,7 +16,7 @@ import (
 // and try to fetch it in different go routine
 //
 // Sleeps for 500 milliseconds then repeat
-func RetryQueueManager(client *ethclient.Client, _db *gorm.DB, redis *d.RedisInfo, queue *q.BlockProcessorQueue, status *d.StatusHolder) {
+func RetryQueueManager(connection *d.BlockChainNodeConnection, _db *gorm.DB, redis *d.RedisInfo, queue *q.BlockProcessorQueue, status *d.StatusHolder) {
 	sleep := func() {
 		time.Sleep(time.Duration(512) * time.Millisecond)
 	}
@@ -41,21 +40,55 @@ func RetryQueueManager(client *ethclient.Client, _db *gorm.DB, redis *d.RedisInf
 		// which will be picked up & processed
 		//
 		// This will stop us from blindly creating too many go routines
-		func(_blockNumber uint64, queue *q.BlockProcessorQueue) {
+		retry(wp, connection, block, _db, redis, true, queue, status)
+	}
+}
 
-			wp.Submit(func() {
+// RetryUntilDone - Retries blocks failed to be processed, same as retry queue manager does,
+// but returns as soon as none of them is either waiting or in progress, dead-lettered
+// ones aside
+//
+// Used for one-off backfills, retried blocks are not published
+func RetryUntilDone(connection *d.BlockChainNodeConnection, _db *gorm.DB, redis *d.RedisInfo, queue *q.BlockProcessorQueue, status *d.StatusHolder) {
 
-				if !FetchBlockByNumber(client, _blockNumber, _db, redis, true, queue, status) {
+	wp := workerpool.New(runtime.NumCPU() * int(cfg.GetConcurrencyFactor()))
+	defer wp.StopWait()
 
-					queue.UnconfirmedFailed(_blockNumber)
-					return
+	for {
 
-				}
+		if stat := queue.Stat(); stat.UnconfirmedProgress+stat.UnconfirmedWaiting == 0 {
+			return
+		}
+
+		block, ok := queue.UnconfirmedNext()
+		if !ok {
+			time.Sleep(time.Duration(512) * time.Millisecond)
+			continue
+		}
 
-				queue.UnconfirmedDone(_blockNumber)
+		log.Printf("ℹ️ Retrying block : %d\n", block)
 
-			})
+		retry(wp, connection, block, _db, redis, false, queue, status)
 
-		}(block, queue)
 	}
+
+}
+
+// retry - Submits job for processing block again, into worker pool, letting
+// queue know how it went
+func retry(wp *workerpool.WorkerPool, connection *d.BlockChainNodeConnection, number uint64, _db *gorm.DB, redis *d.RedisInfo, publishable bool, queue *q.BlockProcessorQueue, status *d.StatusHolder) {
+
+	wp.Submit(func() {
+
+		if !FetchBlockByNumber(connection, number, _db, redis, publishable, queue, status) {
+
+			queue.UnconfirmedFailed(number)
+			return
+
+		}
+
+		queue.UnconfirmedDone(number)
+
+	})
+
 }
@@ -10,7 +10,6 @@ import (
 	d "github.com/denniswon/validationcloud/app/data"
 	"github.com/denniswon/validationcloud/app/db"
 	q "github.com/denniswon/validationcloud/app/queue"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/gammazero/workerpool"
 	"github.com/gookit/color"
 	"gorm.io/gorm"
@@ -41,7 +40,7 @@ func FindMissingBlocksInRange(found []uint64, from uint64, to uint64) []uint64 {
 // while running n workers concurrently, where n = number of cores this machine has
 //
 // Waits for all of them to complete
-func Syncer(client *ethclient.Client, _db *gorm.DB, redis *d.RedisInfo, queue *q.BlockProcessorQueue, fromBlock uint64, toBlock uint64, status *d.StatusHolder, jd func(*workerpool.WorkerPool, *d.Job, *q.BlockProcessorQueue)) {
+func Syncer(connection *d.BlockChainNodeConnection, _db *gorm.DB, redis *d.RedisInfo, queue *q.BlockProcessorQueue, fromBlock uint64, toBlock uint64, status *d.StatusHolder, jd func(*workerpool.WorkerPool, *d.Job, *q.BlockProcessorQueue)) {
 	if !(fromBlock <= toBlock) {
 		log.Print(color.Red.Sprintf("[!] Bad block range for syncer"))
 		return
@@ -53,11 +52,11 @@ func Syncer(client *ethclient.Client, _db *gorm.DB, redis *d.RedisInfo, queue *q
 	// just mentioning which block needs to be fetched
 	job := func(num uint64) {
 		jd(wp, &d.Job{
-			Client: client,
-			DB:     _db,
-			Redis:  redis,
-			Block:  num,
-			Status: status,
+			Connection: connection,
+			DB:         _db,
+			Redis:      redis,
+			Block:      num,
+			Status:     status,
 		}, queue)
 	}
 
@@ -71,7 +70,7 @@ func Syncer(client *ethclient.Client, _db *gorm.DB, redis *d.RedisInfo, queue *q
 			to = toBlock
 		}
 
-		blocks := db.GetAllBlockNumbersInRange(_db, i, to)
+		blocks := db.GetAllBlockNumbersInRange(_db, connection.ChainID, i, to)
 
 		// No blocks present in DB, in queried range
 		if len(blocks) == 0 {
@@ -104,7 +103,35 @@ func Syncer(client *ethclient.Client, _db *gorm.DB, redis *d.RedisInfo, queue *q
 //
 // Range can be either ascending or descending, depending upon that proper arguments to be
 // passed to `Syncer` function during invokation
-func SyncBlocksByRange(client *ethclient.Client, _db *gorm.DB, redis *d.RedisInfo, queue *q.BlockProcessorQueue, fromBlock uint64, toBlock uint64, status *d.StatusHolder) {
+func SyncBlocksByRange(connection *d.BlockChainNodeConnection, _db *gorm.DB, redis *d.RedisInfo, queue *q.BlockProcessorQueue, fromBlock uint64, toBlock uint64, status *d.StatusHolder) {
+
+	log.Printf("Starting block syncer\n")
+
+	SyncRange(connection, _db, redis, queue, fromBlock, toBlock, status)
+
+	log.Printf("Stopping block syncer\n")
+
+	// Once completed first iteration of processing blocks upto last time where it left
+	// off, we're going to start worker to look at DB & decide which blocks are missing
+	// i.e. need to be fetched again
+	//
+	// And this will itself run as a infinite job, completes one iteration &
+	// takes break for 1 min, then repeats
+	go SyncMissingBlocksInDB(connection, _db, redis, queue, status)
+
+}
+
+// SyncRange - Fetches & persists blocks in range(fromBlock, toBlock), both inclusive, which
+// are missing in DB, returns once all of them are attempted
+//
+// Blocks failed to be processed are left in queue, waiting to be retried
+func SyncRange(connection *d.BlockChainNodeConnection, _db *gorm.DB, redis *d.RedisInfo, queue *q.BlockProcessorQueue, fromBlock uint64, toBlock uint64, status *d.StatusHolder) {
+
+	// Backfilled blocks are written in bulk, unless disabled
+	var bulk *BulkStore
+	if cfg.IsBulkWriteEnabled() {
+		bulk = NewBulkStore(_db, queue, status)
+	}
 
 	// Job to be submitted and executed by each worker
 	//
@@ -117,91 +144,137 @@ func SyncBlocksByRange(client *ethclient.Client, _db *gorm.DB, redis *d.RedisInf
 				return
 			}
 
-			if !FetchBlockByNumber(j.Client, j.Block, j.DB, j.Redis, false, queue, j.Status) {
-				queue.UnconfirmedFailed(j.Block)
-				return
-			}
-
-			queue.UnconfirmedDone(j.Block)
+			backfill(j, queue, bulk)
 
 		})
 	}
 
-	log.Printf("Starting block syncer\n")
-
 	if fromBlock < toBlock {
-		Syncer(client, _db, redis, queue, fromBlock, toBlock, status, job)
+		Syncer(connection, _db, redis, queue, fromBlock, toBlock, status, job)
 	} else {
-		Syncer(client, _db, redis, queue, toBlock, fromBlock, status, job)
+		Syncer(connection, _db, redis, queue, toBlock, fromBlock, status, job)
 	}
 
-	log.Printf("Stopping block syncer\n")
-
-	// Once completed first iteration of processing blocks upto last time where it left
-	// off, we're going to start worker to look at DB & decide which blocks are missing
-	// i.e. need to be fetched again
-	//
-	// And this will itself run as a infinite job, completes one iteration &
-	// takes break for 1 min, then repeats
-	go SyncMissingBlocksInDB(client, _db, redis, queue, status)
+	if bulk != nil {
+		bulk.Flush()
+	}
 
 }
 
 // SyncMissingBlocksInDB - Checks with database for what blocks are present & what are not, fetches missing
 // blocks & related data iteratively
-func SyncMissingBlocksInDB(client *ethclient.Client, _db *gorm.DB, redis *d.RedisInfo, queue *q.BlockProcessorQueue, status *d.StatusHolder) {
+func SyncMissingBlocksInDB(connection *d.BlockChainNodeConnection, _db *gorm.DB, redis *d.RedisInfo, queue *q.BlockProcessorQueue, status *d.StatusHolder) {
 
 	for {
 
 		log.Printf("Starting missing block finder\n")
 
-		currentBlockNumber := db.GetCurrentBlockNumber(_db)
+		currentBlockNumber := db.GetCurrentBlockNumber(_db, connection.ChainID)
 
-		// Safely reading shared variable
+		// Blocks below it are not supposed to be indexed, as per
+		// configured `StartBlock` & `HistoryDepth`
+		lowestBlockNumber := cfg.GetLowestBlockToSync(status.GetLatestBlockNumber())
+
+		// Pruned blocks are not missing ones
+		if prunedBelow := status.PrunedBelow(); prunedBelow > lowestBlockNumber {
+			lowestBlockNumber = prunedBelow
+		}
+
+		// Nothing indexed yet, within window of blocks to be kept in sync
+		if lowestBlockNumber > currentBlockNumber {
+			log.Printf("No missing blocks found\n")
+
+			<-time.After(time.Duration(1) * time.Minute)
+			continue
+		}
+
+		// Safely reading shared variable, when whole chain to be
+		// kept in sync, otherwise counting only blocks within window
 		blockCount := status.BlockCountInDB()
+		if lowestBlockNumber != 0 {
+			blockCount = db.GetBlockCountInRange(_db, connection.ChainID, lowestBlockNumber, currentBlockNumber)
+		}
 
-		// If all blocks present in between 0 to latest block in network
+		// If all blocks present in between lowest block to be synced & latest block in network
 		// the service sleeps for 1 minute & again get to work
-		if currentBlockNumber+1 == blockCount {
+		if currentBlockNumber+1 == lowestBlockNumber+blockCount {
 			log.Printf("No missing blocks found\n")
 
 			<-time.After(time.Duration(1) * time.Minute)
 			continue
 		}
 
-		// Job to be submitted and executed by each worker
-		//
-		// Job specification is provided in `Job` struct
-		job := func(wp *workerpool.WorkerPool, j *d.Job, queue *q.BlockProcessorQueue) {
+		// Backfilled blocks are written in bulk, unless disabled
+		var bulk *BulkStore
+		if cfg.IsBulkWriteEnabled() {
+			bulk = NewBulkStore(_db, queue, status)
+		}
 
-			wp.Submit(func() {
+		Syncer(connection, _db, redis, queue, lowestBlockNumber, currentBlockNumber, status, missingBlockJob(bulk))
 
-				// Worker fetches block by number from local storage
-				block := db.GetBlock(j.DB, j.Block)
-				if !(block == nil) {
-					return
-				}
+		if bulk != nil {
+			bulk.Flush()
+		}
 
-				if !queue.Put(j.Block) {
-					return
-				}
+		log.Printf("Stopping missing block finder\n")
+		<-time.After(time.Duration(1) * time.Minute)
 
-				if !FetchBlockByNumber(j.Client, j.Block, j.DB, j.Redis, false, queue, j.Status) {
-					queue.UnconfirmedFailed(j.Block)
-					return
-				}
+	}
 
-				queue.UnconfirmedDone(j.Block)
+}
 
-			})
+// missingBlockJob - Job to be submitted and executed by each worker of missing
+// block finder, block is fetched only if it's still missing in DB & not already
+// living in queue, which also keeps discarded blocks from being fetched again
+//
+// Job specification is provided in `Job` struct
+func missingBlockJob(bulk *BulkStore) func(*workerpool.WorkerPool, *d.Job, *q.BlockProcessorQueue) {
+
+	return func(wp *workerpool.WorkerPool, j *d.Job, queue *q.BlockProcessorQueue) {
 
+		wp.Submit(func() {
+
+			// Worker fetches block by number from local storage
+			block := db.GetBlock(j.DB, j.Connection.ChainID, j.Block)
+			if !(block == nil) {
+				return
+			}
+
+			if !queue.Put(j.Block) {
+				return
+			}
+
+			backfill(j, queue, bulk)
+
+		})
+
+	}
+
+}
+
+// backfill - Fetches & persists block, put into queue by syncer, either on its own or
+// along with other blocks, when bulk store is given, which then lets queue know
+// whether it's processed or not
+//
+// Only finalized blocks are written in bulk, ones near head of chain may still get
+// replaced, which only per block path knows how to deal with
+func backfill(j *d.Job, queue *q.BlockProcessorQueue, bulk *BulkStore) {
+
+	if bulk != nil && j.Status.FinalityOf(j.Block) == d.FinalityFinalized {
+
+		if !FetchBlockByNumberInBulk(j.Connection, j.Block, j.DB, j.Redis, queue, j.Status, bulk) {
+			queue.UnconfirmedFailed(j.Block)
 		}
 
-		Syncer(client, _db, redis, queue, 0, currentBlockNumber, status, job)
+		return
 
-		log.Printf("Stopping missing block finder\n")
-		<-time.After(time.Duration(1) * time.Minute)
+	}
 
+	if !FetchBlockByNumber(j.Connection, j.Block, j.DB, j.Redis, false, queue, j.Status) {
+		queue.UnconfirmedFailed(j.Block)
+		return
 	}
 
+	queue.UnconfirmedDone(j.Block)
+
 }
@@ -0,0 +1,172 @@
+package block
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/denniswon/validationcloud/app/chain"
+	d "github.com/denniswon/validationcloud/app/data"
+	"github.com/denniswon/validationcloud/app/db"
+	q "github.com/denniswon/validationcloud/app/queue"
+	"github.com/gammazero/workerpool"
+	"github.com/spf13/viper"
+)
+
+func TestFindMissingBlocksInRange(t *testing.T) {
+
+	cases := []struct {
+		found    []uint64
+		from, to uint64
+		missing  []uint64
+	}{
+		{nil, 1, 3, []uint64{1, 2, 3}},
+		{[]uint64{1, 2, 3}, 1, 3, []uint64{}},
+		{[]uint64{2, 4}, 1, 5, []uint64{1, 3, 5}},
+		{[]uint64{5}, 5, 5, []uint64{}},
+	}
+
+	for _, v := range cases {
+
+		missing := FindMissingBlocksInRange(v.found, v.from, v.to)
+		if !reflect.DeepEqual(missing, v.missing) {
+			t.Errorf("found %v in [%d, %d] : expected missing %v, got %v", v.found, v.from, v.to, v.missing, missing)
+		}
+
+	}
+
+}
+
+func TestSyncer(t *testing.T) {
+
+	_db := newTestDB(t)
+	fake := chain.NewFakeChain()
+	queue := newTestQueue(t)
+
+	blocks := fake.Extend(20, 1)
+
+	// Some blocks are already present in DB
+	processAll(t, fake, _db, false, queue, blocks[4:10])
+
+	var lock sync.Mutex
+	submitted := make(map[uint64]bool)
+
+	job := func(wp *workerpool.WorkerPool, j *d.Job, queue *q.BlockProcessorQueue) {
+
+		lock.Lock()
+		submitted[j.Block] = true
+		lock.Unlock()
+
+		wp.Submit(func() {
+
+			if !queue.Put(j.Block) {
+				return
+			}
+
+			if !FetchBlockByNumber(j.Connection, j.Block, j.DB, j.Redis, false, queue, j.Status) {
+				queue.UnconfirmedFailed(j.Block)
+				return
+			}
+
+			queue.UnconfirmedDone(j.Block)
+
+		})
+
+	}
+
+	Syncer(newTestConnection(fake), _db, nil, queue, 1, 20, newTestStatus(), job)
+
+	for _, v := range blocks {
+
+		if submitted[v.NumberU64()] == (v.NumberU64() >= 5 && v.NumberU64() <= 10) {
+			t.Fatalf("block %d submitted : %v", v.NumberU64(), submitted[v.NumberU64()])
+		}
+
+		stored := db.GetBlock(_db, testChainID, v.NumberU64())
+		if stored == nil || stored.Hash != v.Hash().Hex() {
+			t.Fatalf("block %d not synced", v.NumberU64())
+		}
+
+	}
+
+}
+
+func TestMissingBlockJobSkipsDiscarded(t *testing.T) {
+
+	t.Cleanup(viper.Reset)
+
+	viper.Set("MaxAttempts", "1")
+
+	_db := newTestDB(t)
+	fake := chain.NewFakeChain()
+	queue := newTestQueue(t)
+
+	blocks := fake.Extend(5, 1)
+
+	// Operator gave up on block 3
+	queue.Put(3)
+	queue.UnconfirmedFailed(3)
+
+	if !queue.Discard(3) {
+		t.Fatal("expected dead-lettered block to be discarded")
+	}
+
+	Syncer(newTestConnection(fake), _db, nil, queue, 1, 5, newTestStatus(), missingBlockJob(nil))
+
+	for _, v := range blocks {
+
+		stored := db.GetBlock(_db, testChainID, v.NumberU64())
+		if (stored != nil) == (v.NumberU64() == 3) {
+			t.Fatalf("block %d synced : %v", v.NumberU64(), stored != nil)
+		}
+
+	}
+
+}
+
+func TestBackfillNearHead(t *testing.T) {
+
+	t.Cleanup(viper.Reset)
+
+	viper.Set("BulkWriteSize", "10")
+
+	_db := newTestDB(t)
+	fake := chain.NewFakeChain()
+	queue := newTestQueue(t)
+	status := newTestStatus()
+	connection := newTestConnection(fake)
+
+	blocks := fake.Extend(4, 1)
+	bulk := NewBulkStore(_db, queue, status)
+
+	// Only blocks 1 & 2 have got enough confirmations
+	status.SetFinality(2, 2)
+
+	for _, v := range blocks {
+
+		queue.Put(v.NumberU64())
+		backfill(&d.Job{Connection: connection, DB: _db, Block: v.NumberU64(), Status: status}, queue, bulk)
+
+	}
+
+	// Finalized blocks wait for batch to fill up, rest are written right away
+	for _, v := range blocks {
+
+		stored := db.GetBlock(_db, testChainID, v.NumberU64())
+		if (stored != nil) != (v.NumberU64() > 2) {
+			t.Fatalf("block %d written : %v", v.NumberU64(), stored != nil)
+		}
+
+	}
+
+	bulk.Flush()
+
+	for _, v := range blocks[:2] {
+
+		if db.GetBlock(_db, testChainID, v.NumberU64()) == nil {
+			t.Fatalf("block %d not written in bulk", v.NumberU64())
+		}
+
+	}
+
+}
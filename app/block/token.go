@@ -0,0 +1,124 @@
+package block
+
+import (
+	"math/big"
+
+	"github.com/denniswon/validationcloud/app/db"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Token standards, transfers can be decoded for
+const (
+	ERC20   = "erc20"
+	ERC721  = "erc721"
+	ERC1155 = "erc1155"
+)
+
+var (
+	// `Transfer(address,address,uint256)`, emitted by both ERC-20 & ERC-721 tokens,
+	// distinguished by whether value is indexed or not
+	transferTopic = common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+	// `TransferSingle(address,address,address,uint256,uint256)`
+	transferSingleTopic = common.HexToHash("0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62")
+	// `TransferBatch(address,address,address,uint256[],uint256[])`
+	transferBatchTopic = common.HexToHash("0x4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb")
+)
+
+// Non-indexed `ids` & `values` of `TransferBatch`
+var transferBatchArguments = func() abi.Arguments {
+
+	uint256Array, err := abi.NewType("uint256[]", "", nil)
+	if err != nil {
+		panic(err)
+	}
+
+	return abi.Arguments{{Type: uint256Array}, {Type: uint256Array}}
+
+}()
+
+// DecodeTokenTransfers - Recognizes standard token transfer events among event(s) emitted
+// by tx & decodes them into normalized transfers, in order of their emission
+//
+// Events not conforming to standard layout i.e. topic count/ data length, are skipped
+func DecodeTokenTransfers(events []*db.Events) []*db.TokenTransfers {
+
+	transfers := make([]*db.TokenTransfers, 0)
+
+	for _, v := range events {
+
+		if len(v.Topics) == 0 {
+			continue
+		}
+
+		switch common.HexToHash(v.Topics[0]) {
+
+		case transferTopic:
+
+			// ERC-20 keeps value in data, while ERC-721 indexes token id
+			if len(v.Topics) == 3 && len(v.Data) == 32 {
+				transfers = append(transfers, tokenTransfer(v, ERC20, 0, "", v.Topics[1], v.Topics[2], "", new(big.Int).SetBytes(v.Data).String()))
+			}
+
+			if len(v.Topics) == 4 && len(v.Data) == 0 {
+				transfers = append(transfers, tokenTransfer(v, ERC721, 0, "", v.Topics[1], v.Topics[2], common.HexToHash(v.Topics[3]).Big().String(), "1"))
+			}
+
+		case transferSingleTopic:
+
+			if len(v.Topics) == 4 && len(v.Data) == 64 {
+				transfers = append(transfers, tokenTransfer(v, ERC1155, 0, v.Topics[1], v.Topics[2], v.Topics[3], new(big.Int).SetBytes(v.Data[:32]).String(), new(big.Int).SetBytes(v.Data[32:]).String()))
+			}
+
+		case transferBatchTopic:
+
+			if len(v.Topics) != 4 {
+				continue
+			}
+
+			values, err := transferBatchArguments.Unpack(v.Data)
+			if err != nil {
+				continue
+			}
+
+			ids, amounts := values[0].([]*big.Int), values[1].([]*big.Int)
+			if len(ids) != len(amounts) {
+				continue
+			}
+
+			for k := range ids {
+				transfers = append(transfers, tokenTransfer(v, ERC1155, uint(k), v.Topics[1], v.Topics[2], v.Topics[3], ids[k].String(), amounts[k].String()))
+			}
+
+		}
+
+	}
+
+	return transfers
+
+}
+
+// tokenTransfer - Builds transfer, emitted in given event, where addresses are
+// passed as they're in topics i.e. left padded to 32 bytes
+func tokenTransfer(event *db.Events, standard string, batchIndex uint, operator string, from string, to string, tokenID string, amount string) *db.TokenTransfers {
+
+	transfer := &db.TokenTransfers{
+		BlockHash:       event.BlockHash,
+		LogIndex:        event.Index,
+		BatchIndex:      batchIndex,
+		Token:           event.Origin,
+		Standard:        standard,
+		From:            common.HexToAddress(from).Hex(),
+		To:              common.HexToAddress(to).Hex(),
+		TokenID:         tokenID,
+		Amount:          amount,
+		TransactionHash: event.TransactionHash,
+	}
+
+	if operator != "" {
+		transfer.Operator = common.HexToAddress(operator).Hex()
+	}
+
+	return transfer
+
+}
@@ -0,0 +1,69 @@
+package block
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/denniswon/validationcloud/app/db"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+)
+
+// topicOf - Address left padded to 32 bytes, as it's put in event topics
+func topicOf(address common.Address) string {
+	return common.BytesToHash(address.Bytes()).Hex()
+}
+
+func TestDecodeTokenTransfers(t *testing.T) {
+
+	token := common.HexToAddress("0xcb3fA413B23b12E402Cfcd8FA120f983FB70d8E8")
+	operator, from, to := common.HexToAddress("0x1"), common.HexToAddress("0x2"), common.HexToAddress("0x3")
+
+	batch, err := transferBatchArguments.Pack([]*big.Int{big.NewInt(7), big.NewInt(8)}, []*big.Int{big.NewInt(70), big.NewInt(80)})
+	if err != nil {
+		t.Fatalf("failed to encode batch : %s", err.Error())
+	}
+
+	events := []*db.Events{
+		{Index: 0, Topics: []string{transferTopic.Hex(), topicOf(from), topicOf(to)}, Data: math.U256Bytes(big.NewInt(1000))},
+		{Index: 1, Topics: []string{transferTopic.Hex(), topicOf(from), topicOf(to), common.BigToHash(big.NewInt(42)).Hex()}},
+		{Index: 2, Topics: []string{transferSingleTopic.Hex(), topicOf(operator), topicOf(from), topicOf(to)}, Data: append(math.U256Bytes(big.NewInt(5)), math.U256Bytes(big.NewInt(50))...)},
+		{Index: 3, Topics: []string{transferBatchTopic.Hex(), topicOf(operator), topicOf(from), topicOf(to)}, Data: batch},
+		// Not conforming to any standard layout
+		{Index: 4, Topics: []string{transferTopic.Hex(), topicOf(from)}, Data: math.U256Bytes(big.NewInt(1))},
+		{Index: 5, Topics: []string{transferBatchTopic.Hex(), topicOf(operator), topicOf(from), topicOf(to)}, Data: []byte{1}},
+		{Index: 6, Topics: []string{}},
+	}
+
+	for _, v := range events {
+		v.Origin = token.Hex()
+		v.TransactionHash = common.Hash{1}.Hex()
+		v.BlockHash = common.Hash{2}.Hex()
+	}
+
+	expected := []db.TokenTransfers{
+		{LogIndex: 0, Standard: ERC20, Amount: "1000"},
+		{LogIndex: 1, Standard: ERC721, TokenID: "42", Amount: "1"},
+		{LogIndex: 2, Standard: ERC1155, Operator: operator.Hex(), TokenID: "5", Amount: "50"},
+		{LogIndex: 3, BatchIndex: 0, Standard: ERC1155, Operator: operator.Hex(), TokenID: "7", Amount: "70"},
+		{LogIndex: 3, BatchIndex: 1, Standard: ERC1155, Operator: operator.Hex(), TokenID: "8", Amount: "80"},
+	}
+
+	transfers := DecodeTokenTransfers(events)
+	if len(transfers) != len(expected) {
+		t.Fatalf("expected %d transfers, got %d", len(expected), len(transfers))
+	}
+
+	for k, v := range transfers {
+
+		e := expected[k]
+		e.Token, e.From, e.To = token.Hex(), from.Hex(), to.Hex()
+		e.TransactionHash, e.BlockHash = common.Hash{1}.Hex(), common.Hash{2}.Hex()
+
+		if *v != e {
+			t.Errorf("transfer %d : expected %+v, got %+v", k, e, *v)
+		}
+
+	}
+
+}
@@ -0,0 +1,117 @@
+package block
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	"github.com/denniswon/validationcloud/app/chain"
+	"github.com/denniswon/validationcloud/app/db"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/gookit/color"
+	"github.com/lib/pq"
+)
+
+// Chains whose node is found out to not support tracing, no more attempts
+// are made for those, while other chains keep being traced
+var tracingUnsupported sync.Map
+
+// FetchTracesOfBlock - Traces all tx(s) of block, of given chain, using `callTracer`
+// & attaches flattened calls to respective packed tx
+//
+// If node doesn't support tracing, block is processed without traces
+func FetchTracesOfBlock(client chain.ChainSource, chainID uint64, block *types.Block, packedTxs []*db.PackedTransaction) error {
+
+	if _, ok := tracingUnsupported.Load(chainID); ok {
+		return nil
+	}
+
+	frames, err := client.TraceBlock(context.Background(), block)
+	if err != nil {
+
+		if chain.IsMethodUnsupported(err) {
+
+			if _, found := tracingUnsupported.LoadOrStore(chainID, true); !found {
+				log.Print(color.Yellow.Sprintf("[!] Node of chain %d doesn't support `debug_traceBlockByNumber`, skipping call traces : %s", chainID, err.Error()))
+			}
+
+			return nil
+
+		}
+
+		return err
+
+	}
+
+	// Packed tx(s) may not be in same order as tx(s) in block
+	byHash := make(map[string]*db.PackedTransaction, len(packedTxs))
+	for _, v := range packedTxs {
+		byHash[v.Tx.Hash] = v
+	}
+
+	for k, v := range block.Transactions() {
+
+		packedTx, ok := byHash[v.Hash().Hex()]
+		if !ok {
+			continue
+		}
+
+		packedTx.Traces = FlattenCallFrame(frames[k], v.Hash().Hex(), block.Hash().Hex())
+
+	}
+
+	return nil
+
+}
+
+// FlattenCallFrame - Walks call tree in depth first order, putting each call
+// into its own row, identified by its path in tree i.e. trace address
+func FlattenCallFrame(frame *chain.CallFrame, txHash string, blockHash string) []*db.Traces {
+
+	traces := make([]*db.Traces, 0, 1)
+
+	var walk func(*chain.CallFrame, []int64)
+	walk = func(frame *chain.CallFrame, address []int64) {
+
+		to := ""
+		if frame.To != nil {
+			to = frame.To.Hex()
+		}
+
+		value := "0"
+		if frame.Value != nil {
+			value = frame.Value.ToInt().String()
+		}
+
+		traces = append(traces, &db.Traces{
+			TransactionHash: txHash,
+			TraceAddress:    pq.Int64Array(address),
+			Type:            frame.Type,
+			From:            frame.From.Hex(),
+			To:              to,
+			Value:           value,
+			Input:           frame.Input,
+			Output:          frame.Output,
+			Error:           frame.Error,
+			GasUsed:         uint64(frame.GasUsed),
+			BlockHash:       blockHash,
+		})
+
+		for k, v := range frame.Calls {
+
+			// Each child gets its own copy of path
+			child := make([]int64, len(address)+1)
+			copy(child, address)
+			child[len(address)] = int64(k)
+
+			walk(v, child)
+
+		}
+
+	}
+
+	walk(frame, []int64{})
+
+	return traces
+
+}
@@ -0,0 +1,144 @@
+package block
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/denniswon/validationcloud/app/chain"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+func TestFlattenCallFrame(t *testing.T) {
+
+	a, b, c := common.HexToAddress("0xa"), common.HexToAddress("0xb"), common.HexToAddress("0xc")
+
+	frame := &chain.CallFrame{
+		Type:  "CALL",
+		From:  a,
+		To:    &b,
+		Value: (*hexutil.Big)(big.NewInt(10)),
+		Calls: []*chain.CallFrame{
+			{
+				Type: "DELEGATECALL",
+				From: b,
+				To:   &c,
+				Calls: []*chain.CallFrame{
+					{Type: "CREATE", From: b, To: &a, Value: (*hexutil.Big)(big.NewInt(1))},
+				},
+			},
+			{Type: "STATICCALL", From: b, To: &c, Error: "execution reverted"},
+		},
+	}
+
+	traces := FlattenCallFrame(frame, "0xtx", "0xblock")
+
+	expected := [][]int64{{}, {0}, {0, 0}, {1}}
+
+	if len(traces) != len(expected) {
+		t.Fatalf("expected %d traces, got %d", len(expected), len(traces))
+	}
+
+	for k, v := range traces {
+
+		if !reflect.DeepEqual([]int64(v.TraceAddress), expected[k]) {
+			t.Fatalf("trace %d : expected address %v, got %v", k, expected[k], v.TraceAddress)
+		}
+
+		if v.TransactionHash != "0xtx" || v.BlockHash != "0xblock" {
+			t.Fatalf("trace %d not linked to tx/ block", k)
+		}
+
+	}
+
+	if traces[1].Value != "0" || traces[2].Value != "1" || traces[2].Type != "CREATE" {
+		t.Fatalf("nested calls flattened with wrong data")
+	}
+
+	if traces[3].Error != "execution reverted" {
+		t.Fatalf("expected error of failed call to be kept")
+	}
+
+}
+
+func TestFetchTracesOfBlock(t *testing.T) {
+
+	fake := chain.NewFakeChain()
+	block := fake.Extend(1, 3)[0]
+
+	packedTxs, err := FetchTransactionsOfBlock(fake, block)
+	if err != nil {
+		t.Fatalf("failed to fetch tx(s) : %s", err.Error())
+	}
+
+	// Packed tx(s) may arrive in any order
+	packedTxs[0], packedTxs[2] = packedTxs[2], packedTxs[0]
+
+	if err := FetchTracesOfBlock(fake, testChainID, block, packedTxs); err != nil {
+		t.Fatalf("failed to fetch traces : %s", err.Error())
+	}
+
+	for _, v := range packedTxs {
+
+		if len(v.Traces) != 1 || v.Traces[0].TransactionHash != v.Tx.Hash {
+			t.Fatalf("tx %s got wrong traces", v.Tx.Hash)
+		}
+
+		if v.Traces[0].From != v.Tx.From || v.Traces[0].To != v.Tx.To || v.Traces[0].Value != v.Tx.Value {
+			t.Fatalf("trace of tx %s doesn't match tx", v.Tx.Hash)
+		}
+
+	}
+
+}
+
+func TestFetchTracesOfBlockUnsupported(t *testing.T) {
+
+	t.Cleanup(func() {
+		tracingUnsupported.Delete(testChainID)
+	})
+
+	fake := chain.NewFakeChain()
+	block := fake.Extend(1, 1)[0]
+
+	packedTxs, err := FetchTransactionsOfBlock(fake, block)
+	if err != nil {
+		t.Fatalf("failed to fetch tx(s) : %s", err.Error())
+	}
+
+	fake.FailWith(chain.MethodTraceBlock, errors.New("injected"))
+
+	if err := FetchTracesOfBlock(fake, testChainID, block, packedTxs); err == nil {
+		t.Fatalf("expected tracing failure to fail block")
+	}
+
+	fake.FailWith(chain.MethodTraceBlock, errors.New("the method debug_traceBlockByNumber does not exist/is not available"))
+
+	if err := FetchTracesOfBlock(fake, testChainID, block, packedTxs); err != nil {
+		t.Fatalf("expected block to be processed without traces : %s", err.Error())
+	}
+
+	if len(packedTxs[0].Traces) != 0 {
+		t.Fatalf("expected no traces")
+	}
+
+	// Node of other chain, supporting tracing, is still asked for traces
+	other := chain.NewFakeChain()
+	otherBlock := other.Extend(1, 1)[0]
+
+	otherTxs, err := FetchTransactionsOfBlock(other, otherBlock)
+	if err != nil {
+		t.Fatalf("failed to fetch tx(s) : %s", err.Error())
+	}
+
+	if err := FetchTracesOfBlock(other, testChainID+1, otherBlock, otherTxs); err != nil {
+		t.Fatalf("failed to fetch traces : %s", err.Error())
+	}
+
+	if len(otherTxs[0].Traces) == 0 {
+		t.Fatalf("expected traces of other chain")
+	}
+
+}
@@ -0,0 +1,115 @@
+package block
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"runtime"
+	"sort"
+	"sync"
+
+	cfg "github.com/denniswon/validationcloud/app/config"
+	d "github.com/denniswon/validationcloud/app/data"
+	"github.com/denniswon/validationcloud/app/db"
+	"github.com/gammazero/workerpool"
+	"gorm.io/gorm"
+)
+
+// Mismatch - Block in DB, found to be either not matching with canonical chain, as seen
+// by node, or inconsistent with itself
+type Mismatch struct {
+	Number uint64 `json:"number"`
+	Reason string `json:"reason"`
+}
+
+// VerifyBlock - Compares block in DB with canonical one, as per node, returns
+// reason why they don't match, empty if they do
+//
+// Tx(s) are counted only when all of them are supposed to be indexed i.e.
+// watchlist mode is not enabled
+func VerifyBlock(connection *d.BlockChainNodeConnection, _db *gorm.DB, number uint64) (string, error) {
+
+	stored := db.GetBlock(_db, connection.ChainID, number)
+	if stored == nil {
+		return "missing in DB", nil
+	}
+
+	block, err := connection.RPC.BlockByNumber(context.Background(), new(big.Int).SetUint64(number))
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch block %d : %s", number, err.Error())
+	}
+
+	if stored.Hash != block.Hash().Hex() {
+		return fmt.Sprintf("hash %s in DB, expected %s", stored.Hash, block.Hash().Hex()), nil
+	}
+
+	if cfg.IsWatchlistModeEnabled() {
+		return "", nil
+	}
+
+	if count := db.GetTransactionCountByBlockNumber(_db, connection.ChainID, number); count != int64(block.Transactions().Len()) {
+		return fmt.Sprintf("%d tx(s) in DB, expected %d", count, block.Transactions().Len()), nil
+	}
+
+	return "", nil
+
+}
+
+// VerifyBlocksByRange - Verifies all blocks in range(fromBlock, toBlock), both inclusive,
+// concurrently, returns ones not matching, in ascending order of block number
+func VerifyBlocksByRange(connection *d.BlockChainNodeConnection, _db *gorm.DB, fromBlock uint64, toBlock uint64) ([]*Mismatch, error) {
+
+	if !(fromBlock <= toBlock) {
+		return nil, fmt.Errorf("bad block range [%d, %d]", fromBlock, toBlock)
+	}
+
+	wp := workerpool.New(runtime.NumCPU() * int(cfg.GetConcurrencyFactor()))
+
+	var lock sync.Mutex
+	var failure error
+	mismatches := make([]*Mismatch, 0)
+
+	for i := fromBlock; i <= toBlock; i++ {
+
+		func(number uint64) {
+
+			wp.Submit(func() {
+
+				reason, err := VerifyBlock(connection, _db, number)
+
+				lock.Lock()
+				defer lock.Unlock()
+
+				if err != nil {
+					failure = err
+					return
+				}
+
+				if reason != "" {
+					mismatches = append(mismatches, &Mismatch{Number: number, Reason: reason})
+				}
+
+			})
+
+		}(i)
+
+		// Avoiding overflow, when range ends at highest possible number
+		if i == toBlock {
+			break
+		}
+
+	}
+
+	wp.StopWait()
+
+	if failure != nil {
+		return nil, failure
+	}
+
+	sort.Slice(mismatches, func(i, j int) bool {
+		return mismatches[i].Number < mismatches[j].Number
+	})
+
+	return mismatches, nil
+
+}
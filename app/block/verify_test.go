@@ -0,0 +1,71 @@
+package block
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/denniswon/validationcloud/app/chain"
+)
+
+func TestVerifyBlocksByRange(t *testing.T) {
+
+	_db := newTestDB(t)
+	fake := chain.NewFakeChain()
+
+	blocks := fake.Extend(6, 1)
+
+	// Block 3 never gets indexed
+	processAll(t, fake, _db, false, newTestQueue(t), append(blocks[:2:2], blocks[3:]...))
+
+	// Blocks 5 & 6 get replaced, after being indexed
+	if _, err := fake.Fork(5, 2, 2); err != nil {
+		t.Fatalf("failed to fork chain : %s", err.Error())
+	}
+
+	mismatches, err := VerifyBlocksByRange(newTestConnection(fake), _db, 1, 6)
+	if err != nil {
+		t.Fatalf("failed to verify blocks : %s", err.Error())
+	}
+
+	found := make([]uint64, 0, len(mismatches))
+	for _, v := range mismatches {
+		found = append(found, v.Number)
+	}
+
+	if !reflect.DeepEqual(found, []uint64{3, 5, 6}) {
+		t.Fatalf("expected blocks 3, 5 & 6 to not match, got %v", found)
+	}
+
+	if mismatches, err := VerifyBlocksByRange(newTestConnection(fake), _db, 1, 2); err != nil || len(mismatches) != 0 {
+		t.Fatalf("expected blocks 1 & 2 to match, got %v, %v", mismatches, err)
+	}
+
+}
+
+func TestRetryUntilDone(t *testing.T) {
+
+	_db := newTestDB(t)
+	fake := chain.NewFakeChain()
+	queue := newTestQueue(t)
+	status := newTestStatus()
+	connection := newTestConnection(fake)
+
+	blocks := fake.Extend(3, 1)
+
+	// Failed attempt leaves block waiting to be retried
+	for _, v := range blocks {
+		queue.Put(v.NumberU64())
+		queue.UnconfirmedFailed(v.NumberU64())
+	}
+
+	RetryUntilDone(connection, _db, nil, queue, status)
+
+	if stat := queue.Stat(); stat.UnconfirmedProgress+stat.UnconfirmedWaiting != 0 || stat.ConfirmedWaiting != 3 {
+		t.Fatalf("expected all blocks to be processed, got %+v", stat)
+	}
+
+	if processed := status.Done(); processed != 3 {
+		t.Fatalf("expected 3 blocks to be processed, got %d", processed)
+	}
+
+}
@@ -0,0 +1,86 @@
+package block
+
+import (
+	"github.com/denniswon/validationcloud/app/db"
+	"github.com/denniswon/validationcloud/app/watchlist"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// MayTouchWatchlist - Checks, without fetching receipts, whether any tx of block can
+// touch watched addresses i.e. sent from/ to watched address, deploying contract at
+// watched address or logs bloom of block telling some log may be emitted by/ indexing
+// watched address
+//
+// If it says no, receipts of block don't need to be fetched at all
+func MayTouchWatchlist(block *types.Block, watched *watchlist.Set) bool {
+
+	if watched.Len() == 0 {
+		return false
+	}
+
+	if watched.MatchesBloom(block.Bloom()) {
+		return true
+	}
+
+	for _, tx := range block.Transactions() {
+
+		if tx.To() != nil && watched.Contains(*tx.To()) {
+			return true
+		}
+
+		sender, err := TransactionSenderOf(block, tx)
+		if err != nil {
+			// Can't rule it out
+			return true
+		}
+
+		if watched.Contains(sender) {
+			return true
+		}
+
+		if tx.To() == nil && watched.Contains(crypto.CreateAddress(sender, tx.Nonce())) {
+			return true
+		}
+
+	}
+
+	return false
+
+}
+
+// FilterByWatchlist - Keeps only tx(s) touching watched addresses, either sent from/ to
+// watched address, deploying contract at watched address or emitting log, which is
+// emitted by/ indexing watched address
+//
+// Only logs emitted by/ indexing watched addresses are kept, along with token
+// transfers decoded from them
+func FilterByWatchlist(packedTxs []*db.PackedTransaction, watched *watchlist.Set) []*db.PackedTransaction {
+
+	filtered := make([]*db.PackedTransaction, 0)
+
+	for _, v := range packedTxs {
+
+		events := make([]*db.Events, 0)
+
+		for _, event := range v.Events {
+			if watched.MatchesEvent(event.Origin, event.Topics) {
+				events = append(events, event)
+			}
+		}
+
+		touches := watched.ContainsHex(v.Tx.From) || watched.ContainsHex(v.Tx.To) || watched.ContainsHex(v.Tx.Contract)
+		if !touches && len(events) == 0 {
+			continue
+		}
+
+		v.Events = events
+		v.TokenTransfers = DecodeTokenTransfers(events)
+
+		filtered = append(filtered, v)
+
+	}
+
+	return filtered
+
+}
@@ -0,0 +1,70 @@
+package block
+
+import (
+	"testing"
+
+	"github.com/denniswon/validationcloud/app/chain"
+	"github.com/denniswon/validationcloud/app/watchlist"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestMayTouchWatchlist(t *testing.T) {
+
+	fake := chain.NewFakeChain()
+	block := fake.Extend(1, 2)[0]
+
+	sender, err := TransactionSenderOf(block, block.Transactions()[0])
+	if err != nil {
+		t.Fatalf("failed to derive sender : %s", err.Error())
+	}
+
+	for _, v := range []struct {
+		name    string
+		watched *watchlist.Set
+		touches bool
+	}{
+		{"empty", watchlist.NewSet(), false},
+		{"unrelated", watchlist.NewSet(common.HexToAddress("0xdead")), false},
+		{"sender", watchlist.NewSet(sender), true},
+		{"recipient", watchlist.NewSet(*block.Transactions()[1].To()), true},
+	} {
+
+		if MayTouchWatchlist(block, v.watched) != v.touches {
+			t.Errorf("%s : expected block touching watchlist to be %v", v.name, v.touches)
+		}
+
+	}
+
+}
+
+func TestFilterByWatchlist(t *testing.T) {
+
+	fake := chain.NewFakeChain()
+	block := fake.Extend(1, 3)[0]
+
+	// Each tx transfers token, emitted by recipient, to recipient itself
+	recipient := *block.Transactions()[1].To()
+
+	packedTxs, err := FetchTransactionsOfBlock(fake, block)
+	if err != nil {
+		t.Fatalf("failed to fetch tx(s) : %s", err.Error())
+	}
+
+	filtered := FilterByWatchlist(packedTxs, watchlist.NewSet(recipient))
+	if len(filtered) != 1 {
+		t.Fatalf("expected 1 tx to be kept, got %d", len(filtered))
+	}
+
+	if filtered[0].Tx.Hash != block.Transactions()[1].Hash().Hex() {
+		t.Fatalf("expected tx %s to be kept, got %s", block.Transactions()[1].Hash().Hex(), filtered[0].Tx.Hash)
+	}
+
+	if len(filtered[0].Events) != 1 || len(filtered[0].TokenTransfers) != 1 {
+		t.Fatalf("expected 1 event & 1 token transfer, got %d & %d", len(filtered[0].Events), len(filtered[0].TokenTransfers))
+	}
+
+	if filtered := FilterByWatchlist(packedTxs, watchlist.NewSet(common.HexToAddress("0xdead"))); len(filtered) != 0 {
+		t.Fatalf("expected no tx to be kept, got %d", len(filtered))
+	}
+
+}
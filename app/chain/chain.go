@@ -0,0 +1,87 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ChainSource - Everything block processing pipeline needs to ask blockchain for,
+// so that it can be backed either by real node or by in-memory fake chain
+type ChainSource interface {
+	// ChainID - Identifier of chain, node is part of, as per EIP-155
+	ChainID(ctx context.Context) (*big.Int, error)
+
+	// SubscribeNewHead - Delivers header of each new chain head, on given channel
+	SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error)
+
+	// BlockNumber - Number of latest canonical block
+	BlockNumber(ctx context.Context) (uint64, error)
+
+	// HeaderByNumber - Canonical block header at given height, if number is nil, latest one
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+
+	// BlockByNumber - Canonical block at given height, if number is nil, latest block
+	BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error)
+
+	// BlockByHash - Block identified by hash, may not be part of canonical chain
+	BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error)
+
+	// BlockReceipts - Receipts of all tx(s) in block, in same order as tx(s)
+	BlockReceipts(ctx context.Context, block *types.Block) ([]*types.Receipt, error)
+
+	// TransactionReceipt - Receipt of tx, included in canonical chain
+	TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error)
+
+	// TransactionSender - Sender of tx, present at given index of block
+	TransactionSender(ctx context.Context, tx *types.Transaction, block common.Hash, index uint) (common.Address, error)
+
+	// TraceBlock - Call trace of each tx in block, in same order as tx(s)
+	TraceBlock(ctx context.Context, block *types.Block) ([]*CallFrame, error)
+
+	// CodeAt - Runtime bytecode of account at given height, if number is nil, latest one
+	CodeAt(ctx context.Context, account common.Address, number *big.Int) ([]byte, error)
+
+	// StorageAt - Value in storage slot of account at given height, if number is nil, latest one
+	StorageAt(ctx context.Context, account common.Address, key common.Hash, number *big.Int) ([]byte, error)
+
+	// CallContract - Executes message call against state at given height, without
+	// creating tx, if number is nil, latest one
+	CallContract(ctx context.Context, msg ethereum.CallMsg, number *big.Int) ([]byte, error)
+}
+
+// CheckReceipts - Making sure we've received one receipt for each tx in block,
+// in same order & all of them belong to this very block, otherwise node might
+// have served us receipts from some other branch of chain
+func CheckReceipts(block *types.Block, receipts []*types.Receipt) error {
+
+	txs := block.Transactions()
+
+	if len(receipts) != len(txs) {
+		return fmt.Errorf("expected %d receipts, received %d", len(txs), len(receipts))
+	}
+
+	for k, v := range receipts {
+
+		if v == nil {
+			return fmt.Errorf("missing receipt for tx %s", txs[k].Hash().Hex())
+		}
+
+		if v.TxHash != txs[k].Hash() {
+			return fmt.Errorf("receipt for tx %s received at index %d, expected %s", v.TxHash.Hex(), k, txs[k].Hash().Hex())
+		}
+
+		if v.BlockHash != block.Hash() {
+			return errors.New("receipts belong to different block")
+		}
+
+	}
+
+	return nil
+
+}
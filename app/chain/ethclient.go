@@ -0,0 +1,179 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"sync/atomic"
+
+	cfg "github.com/denniswon/validationcloud/app/config"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// EthClient - Chain source backed by blockchain node, connected over HTTP/ Websocket
+//
+// Keeps hold of underlying JSON-RPC client, for calls not exposed by ethclient
+// i.e. batch requests, `eth_getBlockReceipts`
+type EthClient struct {
+	*ethclient.Client
+	RPC *rpc.Client
+
+	// Once found out node doesn't support `eth_getBlockReceipts`, all subsequent
+	// attempts go for batch requests, in `auto` mode
+	blockReceiptsUnsupported int32
+}
+
+// NewEthClient - Wrapping JSON-RPC client into chain source
+func NewEthClient(client *rpc.Client) *EthClient {
+	return &EthClient{
+		Client: ethclient.NewClient(client),
+		RPC:    client,
+	}
+}
+
+// Dial - Connects to blockchain node, either using HTTP or Websocket
+// connection, depending upon URL scheme
+func Dial(url string) (*EthClient, error) {
+
+	client, err := rpc.Dial(url)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewEthClient(client), nil
+
+}
+
+// BlockReceipts - Fetches receipts of all tx(s) present in block, using strategy
+// chosen in config i.e. `ReceiptFetchMode`
+//
+// In `single` mode, pipeline fetches receipts one by one, so if invoked,
+// it works same as in `auto` mode
+func (e *EthClient) BlockReceipts(ctx context.Context, block *types.Block) ([]*types.Receipt, error) {
+
+	switch cfg.GetReceiptFetchMode() {
+
+	case "block":
+		return e.ReceiptsByBlock(ctx, block)
+
+	case "batch":
+		return e.ReceiptsInBatch(ctx, block)
+
+	}
+
+	if atomic.LoadInt32(&e.blockReceiptsUnsupported) == 0 {
+
+		receipts, err := e.ReceiptsByBlock(ctx, block)
+		if err == nil {
+			return receipts, nil
+		}
+
+		if !IsMethodUnsupported(err) {
+			return nil, err
+		}
+
+		if atomic.CompareAndSwapInt32(&e.blockReceiptsUnsupported, 0, 1) {
+			log.Printf("[!] Node doesn't support `eth_getBlockReceipts`, falling back to batch requests : %s\n", err.Error())
+		}
+
+	}
+
+	return e.ReceiptsInBatch(ctx, block)
+
+}
+
+// ReceiptsByBlock - Fetches all receipts of block in single `eth_getBlockReceipts` call
+func (e *EthClient) ReceiptsByBlock(ctx context.Context, block *types.Block) ([]*types.Receipt, error) {
+
+	var receipts []*types.Receipt
+
+	if err := e.RPC.CallContext(ctx, &receipts, "eth_getBlockReceipts", hexutil.EncodeUint64(block.NumberU64())); err != nil {
+		return nil, err
+	}
+
+	return receipts, nil
+
+}
+
+// ReceiptsInBatch - Fetches receipts of all tx(s) in block, by putting `eth_getTransactionReceipt`
+// calls into JSON-RPC batch requests, each of size `ReceiptBatchSize` at max
+func (e *EthClient) ReceiptsInBatch(ctx context.Context, block *types.Block) ([]*types.Receipt, error) {
+
+	txs := block.Transactions()
+	receipts := make([]*types.Receipt, len(txs))
+	size := int(cfg.GetReceiptBatchSize())
+
+	for i := 0; i < len(txs); i += size {
+
+		to := i + size
+		if to > len(txs) {
+			to = len(txs)
+		}
+
+		batch := make([]rpc.BatchElem, 0, to-i)
+
+		for j := i; j < to; j++ {
+			batch = append(batch, rpc.BatchElem{
+				Method: "eth_getTransactionReceipt",
+				Args:   []interface{}{txs[j].Hash()},
+				Result: &receipts[j],
+			})
+		}
+
+		if err := e.RPC.BatchCallContext(ctx, batch); err != nil {
+			return nil, err
+		}
+
+		for _, v := range batch {
+			if v.Error != nil {
+				return nil, v.Error
+			}
+		}
+
+	}
+
+	return receipts, nil
+
+}
+
+// IsMethodUnsupported - Checking whether node responded with error because
+// it doesn't support invoked method
+func IsMethodUnsupported(err error) bool {
+
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) && rpcErr.ErrorCode() == -32601 {
+		return true
+	}
+
+	msg := strings.ToLower(err.Error())
+
+	return strings.Contains(msg, "method") &&
+		(strings.Contains(msg, "not found") ||
+			strings.Contains(msg, "not supported") ||
+			strings.Contains(msg, "does not exist") ||
+			strings.Contains(msg, "not available"))
+
+}
+
+// IsExecutionReverted - Checking whether node responded with error because
+// message call got reverted, which is about the call, not the node
+func IsExecutionReverted(err error) bool {
+
+	if err == nil {
+		return false
+	}
+
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) && rpcErr.ErrorCode() == 3 {
+		return true
+	}
+
+	msg := strings.ToLower(err.Error())
+
+	return strings.Contains(msg, "execution reverted") || strings.Contains(msg, "invalid opcode")
+
+}
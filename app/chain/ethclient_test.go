@@ -0,0 +1,216 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/spf13/viper"
+)
+
+// receiptService - Serves `eth_getTransactionReceipt` from fake chain
+type receiptService struct {
+	chain *FakeChain
+}
+
+// GetTransactionReceipt - Like a real node, responds with null, when receipt is unknown
+func (r *receiptService) GetTransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+	receipt, err := r.chain.TransactionReceipt(ctx, hash)
+	if errors.Is(err, ethereum.NotFound) {
+		return nil, nil
+	}
+
+	return receipt, err
+}
+
+// blockReceiptService - Additionally serves `eth_getBlockReceipts`
+type blockReceiptService struct {
+	receiptService
+}
+
+func (b *blockReceiptService) GetBlockReceipts(ctx context.Context, number hexutil.Uint64) ([]*types.Receipt, error) {
+	return b.chain.BlockReceipts(ctx, b.chain.BlockAt(uint64(number)))
+}
+
+// newTestEthClient - Chain source talking to in-process JSON-RPC server, backed by fake chain
+func newTestEthClient(t *testing.T, service interface{}) *EthClient {
+
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", service); err != nil {
+		t.Fatalf("failed to register service : %s", err.Error())
+	}
+
+	client := NewEthClient(rpc.DialInProc(server))
+
+	t.Cleanup(func() {
+		client.Close()
+		server.Stop()
+	})
+
+	return client
+
+}
+
+// withConfig - Sets config value for duration of test
+func withConfig(t *testing.T, key string, value string) {
+
+	previous := viper.GetString(key)
+	viper.Set(key, value)
+
+	t.Cleanup(func() {
+		viper.Set(key, previous)
+	})
+
+}
+
+func TestEthClientReceiptsByBlock(t *testing.T) {
+
+	withConfig(t, "ReceiptFetchMode", "block")
+
+	fake := NewFakeChain()
+	block := fake.Extend(1, 4)[0]
+
+	client := newTestEthClient(t, &blockReceiptService{receiptService{chain: fake}})
+
+	receipts, err := client.BlockReceipts(context.Background(), block)
+	if err != nil {
+		t.Fatalf("failed to fetch receipts : %s", err.Error())
+	}
+
+	if err := CheckReceipts(block, receipts); err != nil {
+		t.Fatalf("bad receipts : %s", err.Error())
+	}
+
+}
+
+func TestEthClientReceiptsInBatch(t *testing.T) {
+
+	withConfig(t, "ReceiptFetchMode", "batch")
+	withConfig(t, "ReceiptBatchSize", "2")
+
+	fake := NewFakeChain()
+	block := fake.Extend(1, 5)[0]
+
+	client := newTestEthClient(t, &receiptService{chain: fake})
+
+	receipts, err := client.BlockReceipts(context.Background(), block)
+	if err != nil {
+		t.Fatalf("failed to fetch receipts : %s", err.Error())
+	}
+
+	if err := CheckReceipts(block, receipts); err != nil {
+		t.Fatalf("bad receipts : %s", err.Error())
+	}
+
+	// Receipt not yet available, gets reported as missing
+	fake.WithholdReceipt(block.Transactions()[3].Hash(), true)
+
+	receipts, err = client.BlockReceipts(context.Background(), block)
+	if err != nil {
+		t.Fatalf("failed to fetch receipts : %s", err.Error())
+	}
+
+	if err := CheckReceipts(block, receipts); err == nil {
+		t.Fatalf("expected missing receipt to be detected")
+	}
+
+}
+
+func TestEthClientReceiptsAutoFallback(t *testing.T) {
+
+	withConfig(t, "ReceiptFetchMode", "auto")
+
+	fake := NewFakeChain()
+	blocks := fake.Extend(2, 3)
+
+	client := newTestEthClient(t, &receiptService{chain: fake})
+
+	for _, v := range blocks {
+
+		receipts, err := client.BlockReceipts(context.Background(), v)
+		if err != nil {
+			t.Fatalf("failed to fetch receipts : %s", err.Error())
+		}
+
+		if err := CheckReceipts(v, receipts); err != nil {
+			t.Fatalf("bad receipts : %s", err.Error())
+		}
+
+	}
+
+	if client.blockReceiptsUnsupported != 1 {
+		t.Fatalf("expected `eth_getBlockReceipts` to be marked unsupported")
+	}
+
+}
+
+func TestEthClientReceiptsAutoPrefersBlock(t *testing.T) {
+
+	withConfig(t, "ReceiptFetchMode", "auto")
+
+	fake := NewFakeChain()
+	block := fake.Extend(1, 3)[0]
+
+	client := newTestEthClient(t, &blockReceiptService{receiptService{chain: fake}})
+
+	receipts, err := client.BlockReceipts(context.Background(), block)
+	if err != nil {
+		t.Fatalf("failed to fetch receipts : %s", err.Error())
+	}
+
+	if err := CheckReceipts(block, receipts); err != nil {
+		t.Fatalf("bad receipts : %s", err.Error())
+	}
+
+	if client.blockReceiptsUnsupported != 0 {
+		t.Fatalf("expected `eth_getBlockReceipts` to be considered supported")
+	}
+
+}
+
+func TestIsMethodUnsupported(t *testing.T) {
+
+	cases := []struct {
+		err         error
+		unsupported bool
+	}{
+		{errors.New("the method eth_getBlockReceipts does not exist/is not available"), true},
+		{errors.New("Method not found"), true},
+		{errors.New("method eth_getBlockReceipts not supported"), true},
+		{errors.New("header not found"), false},
+		{errors.New("request timed out"), false},
+	}
+
+	for _, v := range cases {
+		if IsMethodUnsupported(v.err) != v.unsupported {
+			t.Errorf("expected %v for %q", v.unsupported, v.err.Error())
+		}
+	}
+
+}
+
+func TestIsExecutionReverted(t *testing.T) {
+
+	cases := []struct {
+		err      error
+		reverted bool
+	}{
+		{errors.New("execution reverted"), true},
+		{errors.New("execution reverted: Ownable: caller is not the owner"), true},
+		{errors.New("invalid opcode: INVALID"), true},
+		{errors.New("missing trie node"), false},
+		{nil, false},
+	}
+
+	for _, v := range cases {
+		if IsExecutionReverted(v.err) != v.reverted {
+			t.Errorf("expected %v for %v", v.reverted, v.err)
+		}
+	}
+
+}
@@ -0,0 +1,598 @@
+package chain
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+// Names of chain source methods, to be used when injecting errors into fake chain
+const (
+	MethodChainID            = "ChainID"
+	MethodSubscribeNewHead   = "SubscribeNewHead"
+	MethodBlockNumber        = "BlockNumber"
+	MethodHeaderByNumber     = "HeaderByNumber"
+	MethodBlockByNumber      = "BlockByNumber"
+	MethodBlockByHash        = "BlockByHash"
+	MethodBlockReceipts      = "BlockReceipts"
+	MethodTransactionReceipt = "TransactionReceipt"
+	MethodTransactionSender  = "TransactionSender"
+	MethodTraceBlock         = "TraceBlock"
+	MethodCodeAt             = "CodeAt"
+	MethodStorageAt          = "StorageAt"
+	MethodCallContract       = "CallContract"
+)
+
+// Each tx in fake chain emits one ERC20 `Transfer` event
+var transferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+// Where canonical tx can be found
+type txLocation struct {
+	block common.Hash
+	index int
+}
+
+// FakeChain - In-memory chain source, for exercising block processing pipeline
+// without live blockchain node
+//
+// Chain starts with genesis block, which can be extended or forked at any height,
+// while receipts can be withheld & errors can be injected for any of the methods
+type FakeChain struct {
+	lock sync.RWMutex
+
+	chainID *big.Int
+	key     *ecdsa.PrivateKey
+	signer  types.Signer
+	nonce   uint64
+	forks   uint64
+
+	canonical []*types.Block
+	blocks    map[common.Hash]*types.Block
+	receipts  map[common.Hash][]*types.Receipt
+	txs       map[common.Hash]txLocation
+	withheld  map[common.Hash]bool
+	errors    map[string]error
+
+	// Account state, same at every height
+	code    map[common.Address][]byte
+	storage map[common.Address]map[common.Hash]common.Hash
+	calls   map[common.Address]map[[4]byte][]byte
+
+	// Heights `safe` & `finalized` block tags resolve to, those
+	// tags are not supported until set
+	tagged    bool
+	safe      uint64
+	finalized uint64
+
+	heads event.Feed
+}
+
+// NewFakeChain - Creates fake chain, having only genesis block
+func NewFakeChain() *FakeChain {
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		panic(err)
+	}
+
+	chainID := big.NewInt(1337)
+
+	genesis := types.NewBlock(&types.Header{
+		Number:     big.NewInt(0),
+		GasLimit:   30_000_000,
+		Difficulty: big.NewInt(1),
+	}, nil, nil, nil, trie.NewStackTrie(nil))
+
+	return &FakeChain{
+		chainID:   chainID,
+		key:       key,
+		signer:    types.LatestSignerForChainID(chainID),
+		canonical: []*types.Block{genesis},
+		blocks:    map[common.Hash]*types.Block{genesis.Hash(): genesis},
+		receipts:  map[common.Hash][]*types.Receipt{genesis.Hash(): {}},
+		txs:       make(map[common.Hash]txLocation),
+		withheld:  make(map[common.Hash]bool),
+		errors:    make(map[string]error),
+		code:      make(map[common.Address][]byte),
+		storage:   make(map[common.Address]map[common.Hash]common.Hash),
+		calls:     make(map[common.Address]map[[4]byte][]byte),
+	}
+
+}
+
+// Head - Latest block of canonical chain
+func (f *FakeChain) Head() *types.Block {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	return f.canonical[len(f.canonical)-1]
+}
+
+// BlockAt - Canonical block at given height, nil if chain is not that long
+func (f *FakeChain) BlockAt(number uint64) *types.Block {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	if number >= uint64(len(f.canonical)) {
+		return nil
+	}
+
+	return f.canonical[number]
+}
+
+// Extend - Mines `count` blocks on top of canonical chain, each having `txCount` tx(s),
+// subscribers get notified about each new head
+func (f *FakeChain) Extend(count int, txCount int) []*types.Block {
+
+	f.lock.Lock()
+
+	blocks := make([]*types.Block, count)
+	for i := range blocks {
+		blocks[i] = f.mine(f.canonical[len(f.canonical)-1], txCount)
+	}
+
+	f.lock.Unlock()
+
+	for _, v := range blocks {
+		f.heads.Send(v.Header())
+	}
+
+	return blocks
+
+}
+
+// Fork - Replaces canonical chain from block `number` onwards, with `count` newly mined
+// blocks, each having `txCount` tx(s), subscribers get notified only about new head
+//
+// Receipts of tx(s) in orphaned blocks, can't be looked up by tx hash anymore
+func (f *FakeChain) Fork(number uint64, count int, txCount int) ([]*types.Block, error) {
+
+	f.lock.Lock()
+
+	if number == 0 || number >= uint64(len(f.canonical)) {
+
+		f.lock.Unlock()
+		return nil, fmt.Errorf("can't fork at %d, chain head at %d", number, len(f.canonical)-1)
+
+	}
+
+	if count < 1 {
+
+		f.lock.Unlock()
+		return nil, errors.New("fork needs to have at least one block")
+
+	}
+
+	for _, v := range f.canonical[number:] {
+		for _, tx := range v.Transactions() {
+			delete(f.txs, tx.Hash())
+		}
+	}
+
+	f.canonical = f.canonical[:number]
+	f.forks++
+
+	blocks := make([]*types.Block, count)
+	for i := range blocks {
+		blocks[i] = f.mine(f.canonical[len(f.canonical)-1], txCount)
+	}
+
+	f.lock.Unlock()
+
+	f.heads.Send(blocks[len(blocks)-1].Header())
+
+	return blocks, nil
+
+}
+
+// WithholdReceipt - Makes receipt of tx unavailable, as if node hasn't yet indexed it,
+// until released by invoking with `withheld` set to false
+func (f *FakeChain) WithholdReceipt(hash common.Hash, withheld bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if withheld {
+		f.withheld[hash] = true
+		return
+	}
+
+	delete(f.withheld, hash)
+}
+
+// FailWith - All subsequent invocations of method, fail with given error,
+// until cleared by invoking with nil error
+func (f *FakeChain) FailWith(method string, err error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err == nil {
+		delete(f.errors, method)
+		return
+	}
+
+	f.errors[method] = err
+}
+
+// SetCode - Puts runtime bytecode in account
+func (f *FakeChain) SetCode(account common.Address, code []byte) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.code[account] = code
+}
+
+// SetStorageAt - Puts value in storage slot of account
+func (f *FakeChain) SetStorageAt(account common.Address, key common.Hash, value common.Hash) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if _, ok := f.storage[account]; !ok {
+		f.storage[account] = make(map[common.Hash]common.Hash)
+	}
+
+	f.storage[account][key] = value
+}
+
+// SetCallResult - Output of message call to account, invoking method identified by
+// selector, calls not set this way get reverted
+func (f *FakeChain) SetCallResult(account common.Address, selector []byte, output []byte) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if _, ok := f.calls[account]; !ok {
+		f.calls[account] = make(map[[4]byte][]byte)
+	}
+
+	var key [4]byte
+	copy(key[:], selector)
+
+	f.calls[account][key] = output
+}
+
+// SetFinality - Heights of canonical blocks, which `safe` & `finalized` block tags
+// resolve to
+func (f *FakeChain) SetFinality(safe uint64, finalized uint64) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.tagged = true
+	f.safe = safe
+	f.finalized = finalized
+}
+
+// mine - Builds new block on top of parent, having `txCount` value transfer tx(s),
+// while making it lookup-able as part of canonical chain
+//
+// Must be invoked while holding write lock
+func (f *FakeChain) mine(parent *types.Block, txCount int) *types.Block {
+
+	number := new(big.Int).Add(parent.Number(), common.Big1)
+
+	txs := make([]*types.Transaction, txCount)
+	receipts := make([]*types.Receipt, txCount)
+
+	var gasUsed uint64
+
+	for i := range txs {
+
+		to := common.BigToAddress(new(big.Int).SetUint64(f.nonce + 1))
+
+		tx, err := types.SignTx(types.NewTransaction(f.nonce, to, big.NewInt(1), 21000, big.NewInt(1_000_000_000), nil), f.signer, f.key)
+		if err != nil {
+			panic(err)
+		}
+
+		f.nonce++
+		gasUsed += 21000
+
+		receipts[i] = &types.Receipt{
+			Status:            types.ReceiptStatusSuccessful,
+			CumulativeGasUsed: gasUsed,
+			TxHash:            tx.Hash(),
+			GasUsed:           21000,
+			BlockNumber:       number,
+			TransactionIndex:  uint(i),
+			Logs: []*types.Log{
+				{
+					Address:     to,
+					Topics:      []common.Hash{transferTopic, common.BytesToHash(crypto.PubkeyToAddress(f.key.PublicKey).Bytes()), common.BytesToHash(to.Bytes())},
+					Data:        common.LeftPadBytes(big.NewInt(1).Bytes(), 32),
+					BlockNumber: number.Uint64(),
+					TxHash:      tx.Hash(),
+					TxIndex:     uint(i),
+					Index:       uint(i),
+				},
+			},
+		}
+		receipts[i].Bloom = types.CreateBloom(types.Receipts{receipts[i]})
+
+		txs[i] = tx
+
+	}
+
+	block := types.NewBlock(&types.Header{
+		ParentHash: parent.Hash(),
+		Number:     number,
+		GasLimit:   parent.GasLimit(),
+		GasUsed:    gasUsed,
+		Time:       parent.Time() + 12,
+		Difficulty: big.NewInt(1),
+		Extra:      []byte(fmt.Sprintf("fork-%d", f.forks)),
+	}, txs, nil, receipts, trie.NewStackTrie(nil))
+
+	for k, v := range receipts {
+
+		v.BlockHash = block.Hash()
+		for _, l := range v.Logs {
+			l.BlockHash = block.Hash()
+		}
+
+		f.txs[v.TxHash] = txLocation{block: block.Hash(), index: k}
+
+	}
+
+	f.canonical = append(f.canonical, block)
+	f.blocks[block.Hash()] = block
+	f.receipts[block.Hash()] = receipts
+
+	return block
+
+}
+
+// failure - Injected error for method, if any
+func (f *FakeChain) failure(method string) error {
+	return f.errors[method]
+}
+
+// ChainID - Identifier of fake chain, tx(s) are signed for
+func (f *FakeChain) ChainID(ctx context.Context) (*big.Int, error) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	if err := f.failure(MethodChainID); err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).Set(f.chainID), nil
+}
+
+// SubscribeNewHead - Delivers header of each new canonical head, on given channel
+func (f *FakeChain) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	if err := f.failure(MethodSubscribeNewHead); err != nil {
+		return nil, err
+	}
+
+	return f.heads.Subscribe(ch), nil
+}
+
+// BlockNumber - Number of latest canonical block
+func (f *FakeChain) BlockNumber(ctx context.Context) (uint64, error) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	if err := f.failure(MethodBlockNumber); err != nil {
+		return 0, err
+	}
+
+	return f.canonical[len(f.canonical)-1].NumberU64(), nil
+}
+
+// HeaderByNumber - Header of canonical block at given height, if number is nil, latest one,
+// `safe` & `finalized` block tags are resolved, once set
+func (f *FakeChain) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	if err := f.failure(MethodHeaderByNumber); err != nil {
+		return nil, err
+	}
+
+	if number == nil || number.Cmp(big.NewInt(int64(rpc.LatestBlockNumber))) == 0 {
+		return f.canonical[len(f.canonical)-1].Header(), nil
+	}
+
+	if f.tagged && number.Cmp(big.NewInt(int64(rpc.SafeBlockNumber))) == 0 {
+		number = new(big.Int).SetUint64(f.safe)
+	}
+
+	if f.tagged && number.Cmp(big.NewInt(int64(rpc.FinalizedBlockNumber))) == 0 {
+		number = new(big.Int).SetUint64(f.finalized)
+	}
+
+	if !number.IsUint64() || number.Uint64() >= uint64(len(f.canonical)) {
+		return nil, ethereum.NotFound
+	}
+
+	return f.canonical[number.Uint64()].Header(), nil
+}
+
+// BlockByNumber - Canonical block at given height, if number is nil, latest block
+func (f *FakeChain) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	if err := f.failure(MethodBlockByNumber); err != nil {
+		return nil, err
+	}
+
+	if number == nil {
+		return f.canonical[len(f.canonical)-1], nil
+	}
+
+	if !number.IsUint64() || number.Uint64() >= uint64(len(f.canonical)) {
+		return nil, ethereum.NotFound
+	}
+
+	return f.canonical[number.Uint64()], nil
+}
+
+// BlockByHash - Block identified by hash, including orphaned ones
+func (f *FakeChain) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	if err := f.failure(MethodBlockByHash); err != nil {
+		return nil, err
+	}
+
+	block, ok := f.blocks[hash]
+	if !ok {
+		return nil, ethereum.NotFound
+	}
+
+	return block, nil
+}
+
+// BlockReceipts - Receipts of all tx(s) in block, withheld ones are left nil
+func (f *FakeChain) BlockReceipts(ctx context.Context, block *types.Block) ([]*types.Receipt, error) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	if err := f.failure(MethodBlockReceipts); err != nil {
+		return nil, err
+	}
+
+	stored, ok := f.receipts[block.Hash()]
+	if !ok {
+		return nil, ethereum.NotFound
+	}
+
+	receipts := make([]*types.Receipt, len(stored))
+	for k, v := range stored {
+		if !f.withheld[v.TxHash] {
+			receipts[k] = v
+		}
+	}
+
+	return receipts, nil
+}
+
+// TransactionReceipt - Receipt of tx, included in canonical chain
+func (f *FakeChain) TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	if err := f.failure(MethodTransactionReceipt); err != nil {
+		return nil, err
+	}
+
+	location, ok := f.txs[hash]
+	if !ok || f.withheld[hash] {
+		return nil, ethereum.NotFound
+	}
+
+	return f.receipts[location.block][location.index], nil
+}
+
+// TransactionSender - Recovers sender of tx from its signature
+func (f *FakeChain) TransactionSender(ctx context.Context, tx *types.Transaction, block common.Hash, index uint) (common.Address, error) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	if err := f.failure(MethodTransactionSender); err != nil {
+		return common.Address{}, err
+	}
+
+	return types.Sender(f.signer, tx)
+}
+
+// TraceBlock - Each tx in fake chain is plain value transfer, so it's traced
+// as single top level call, without any nested call
+func (f *FakeChain) TraceBlock(ctx context.Context, block *types.Block) ([]*CallFrame, error) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	if err := f.failure(MethodTraceBlock); err != nil {
+		return nil, err
+	}
+
+	if _, ok := f.blocks[block.Hash()]; !ok {
+		return nil, ethereum.NotFound
+	}
+
+	frames := make([]*CallFrame, block.Transactions().Len())
+
+	for k, v := range block.Transactions() {
+
+		from, err := types.Sender(f.signer, v)
+		if err != nil {
+			return nil, err
+		}
+
+		frames[k] = &CallFrame{
+			Type:    "CALL",
+			From:    from,
+			To:      v.To(),
+			Value:   (*hexutil.Big)(v.Value()),
+			Gas:     hexutil.Uint64(v.Gas()),
+			GasUsed: hexutil.Uint64(f.receipts[block.Hash()][k].GasUsed),
+			Input:   v.Data(),
+		}
+
+	}
+
+	return frames, nil
+}
+
+// CodeAt - Runtime bytecode of account, empty if none set
+func (f *FakeChain) CodeAt(ctx context.Context, account common.Address, number *big.Int) ([]byte, error) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	if err := f.failure(MethodCodeAt); err != nil {
+		return nil, err
+	}
+
+	return f.code[account], nil
+}
+
+// StorageAt - Value in storage slot of account, zero if none set
+func (f *FakeChain) StorageAt(ctx context.Context, account common.Address, key common.Hash, number *big.Int) ([]byte, error) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	if err := f.failure(MethodStorageAt); err != nil {
+		return nil, err
+	}
+
+	return f.storage[account][key].Bytes(), nil
+}
+
+// CallContract - Output set for method invoked by message call, otherwise call gets reverted
+func (f *FakeChain) CallContract(ctx context.Context, msg ethereum.CallMsg, number *big.Int) ([]byte, error) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	if err := f.failure(MethodCallContract); err != nil {
+		return nil, err
+	}
+
+	if msg.To == nil || len(msg.Data) < 4 {
+		return nil, errors.New("execution reverted")
+	}
+
+	var key [4]byte
+	copy(key[:], msg.Data)
+
+	output, ok := f.calls[*msg.To][key]
+	if !ok {
+		return nil, errors.New("execution reverted")
+	}
+
+	return output, nil
+}
@@ -0,0 +1,218 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestFakeChainExtend(t *testing.T) {
+
+	fake := NewFakeChain()
+	blocks := fake.Extend(3, 2)
+
+	if len(blocks) != 3 {
+		t.Fatalf("expected 3 blocks, got %d", len(blocks))
+	}
+
+	if fake.Head().NumberU64() != 3 {
+		t.Fatalf("expected head at 3, got %d", fake.Head().NumberU64())
+	}
+
+	for k, v := range blocks {
+
+		if v.ParentHash() != fake.BlockAt(uint64(k)).Hash() {
+			t.Fatalf("block %d doesn't build on top of its parent", v.NumberU64())
+		}
+
+		block, err := fake.BlockByNumber(context.Background(), v.Number())
+		if err != nil || block.Hash() != v.Hash() {
+			t.Fatalf("failed to look up block %d by number : %v", v.NumberU64(), err)
+		}
+
+		receipts, err := fake.BlockReceipts(context.Background(), v)
+		if err != nil {
+			t.Fatalf("failed to fetch receipts of block %d : %s", v.NumberU64(), err.Error())
+		}
+
+		if err := CheckReceipts(v, receipts); err != nil {
+			t.Fatalf("bad receipts for block %d : %s", v.NumberU64(), err.Error())
+		}
+
+		for i, tx := range v.Transactions() {
+
+			receipt, err := fake.TransactionReceipt(context.Background(), tx.Hash())
+			if err != nil || receipt.TransactionIndex != uint(i) {
+				t.Fatalf("failed to look up receipt of tx %s : %v", tx.Hash().Hex(), err)
+			}
+
+			if _, err := fake.TransactionSender(context.Background(), tx, v.Hash(), uint(i)); err != nil {
+				t.Fatalf("failed to recover sender of tx %s : %s", tx.Hash().Hex(), err.Error())
+			}
+
+		}
+
+	}
+
+	if _, err := fake.BlockByNumber(context.Background(), big.NewInt(4)); !errors.Is(err, ethereum.NotFound) {
+		t.Fatalf("expected not found error beyond head, got %v", err)
+	}
+
+}
+
+func TestFakeChainFork(t *testing.T) {
+
+	fake := NewFakeChain()
+	old := fake.Extend(5, 1)
+
+	branch, err := fake.Fork(3, 4, 1)
+	if err != nil {
+		t.Fatalf("failed to fork : %s", err.Error())
+	}
+
+	if fake.Head().NumberU64() != 6 {
+		t.Fatalf("expected head at 6, got %d", fake.Head().NumberU64())
+	}
+
+	if branch[0].ParentHash() != old[1].Hash() {
+		t.Fatalf("fork doesn't build on top of block 2")
+	}
+
+	if branch[0].Hash() == old[2].Hash() {
+		t.Fatalf("forked block 3 has same hash as orphaned one")
+	}
+
+	// Orphaned blocks can still be looked up by hash, just like with a real node
+	if _, err := fake.BlockByHash(context.Background(), old[3].Hash()); err != nil {
+		t.Fatalf("failed to look up orphaned block by hash : %s", err.Error())
+	}
+
+	orphanedTx := old[3].Transactions()[0].Hash()
+	if _, err := fake.TransactionReceipt(context.Background(), orphanedTx); !errors.Is(err, ethereum.NotFound) {
+		t.Fatalf("expected receipt of orphaned tx to be gone, got %v", err)
+	}
+
+	survivingTx := old[1].Transactions()[0].Hash()
+	if _, err := fake.TransactionReceipt(context.Background(), survivingTx); err != nil {
+		t.Fatalf("receipt of tx below fork point got lost : %s", err.Error())
+	}
+
+	if _, err := fake.Fork(0, 1, 0); err == nil {
+		t.Fatalf("expected forking genesis to fail")
+	}
+
+	if _, err := fake.Fork(7, 1, 0); err == nil {
+		t.Fatalf("expected forking beyond head to fail")
+	}
+
+}
+
+func TestFakeChainWithholdReceipt(t *testing.T) {
+
+	fake := NewFakeChain()
+	block := fake.Extend(1, 3)[0]
+	tx := block.Transactions()[1].Hash()
+
+	fake.WithholdReceipt(tx, true)
+
+	receipts, err := fake.BlockReceipts(context.Background(), block)
+	if err != nil {
+		t.Fatalf("failed to fetch receipts : %s", err.Error())
+	}
+
+	if err := CheckReceipts(block, receipts); err == nil {
+		t.Fatalf("expected withheld receipt to be reported missing")
+	}
+
+	if _, err := fake.TransactionReceipt(context.Background(), tx); !errors.Is(err, ethereum.NotFound) {
+		t.Fatalf("expected withheld receipt to be not found, got %v", err)
+	}
+
+	fake.WithholdReceipt(tx, false)
+
+	receipts, err = fake.BlockReceipts(context.Background(), block)
+	if err != nil {
+		t.Fatalf("failed to fetch receipts : %s", err.Error())
+	}
+
+	if err := CheckReceipts(block, receipts); err != nil {
+		t.Fatalf("bad receipts after release : %s", err.Error())
+	}
+
+}
+
+func TestFakeChainFailWith(t *testing.T) {
+
+	fake := NewFakeChain()
+	fake.Extend(1, 1)
+
+	injected := errors.New("injected")
+	fake.FailWith(MethodBlockByNumber, injected)
+
+	if _, err := fake.BlockByNumber(context.Background(), big.NewInt(1)); !errors.Is(err, injected) {
+		t.Fatalf("expected injected error, got %v", err)
+	}
+
+	// Other methods keep working
+	if _, err := fake.BlockByHash(context.Background(), fake.Head().Hash()); err != nil {
+		t.Fatalf("unexpected error : %s", err.Error())
+	}
+
+	fake.FailWith(MethodBlockByNumber, nil)
+
+	if _, err := fake.BlockByNumber(context.Background(), big.NewInt(1)); err != nil {
+		t.Fatalf("expected error to be cleared, got %s", err.Error())
+	}
+
+}
+
+func TestFakeChainSubscribeNewHead(t *testing.T) {
+
+	fake := NewFakeChain()
+
+	headers := make(chan *types.Header, 1)
+
+	sub, err := fake.SubscribeNewHead(context.Background(), headers)
+	if err != nil {
+		t.Fatalf("failed to subscribe : %s", err.Error())
+	}
+	defer sub.Unsubscribe()
+
+	go fake.Extend(2, 0)
+
+	for i := uint64(1); i <= 2; i++ {
+
+		select {
+
+		case header := <-headers:
+			if header.Number.Uint64() != i {
+				t.Fatalf("expected header %d, got %d", i, header.Number.Uint64())
+			}
+
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for header %d", i)
+
+		}
+
+	}
+
+	go fake.Fork(1, 1, 0)
+
+	select {
+
+	case header := <-headers:
+		if header.Number.Uint64() != 1 || header.Hash() != fake.Head().Hash() {
+			t.Fatalf("expected header of forked head")
+		}
+
+	case <-time.After(time.Second):
+		t.Fatalf("timed out waiting for forked head")
+
+	}
+
+}
@@ -0,0 +1,755 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/gookit/color"
+)
+
+const (
+	// Weight given to latest observation, while updating moving averages
+	smoothing = 0.2
+
+	// After these many consecutive failures, endpoint is considered down,
+	// until health check finds it responsive again
+	maxConsecutiveFailures = 3
+
+	// Score penalties, in terms of milliseconds of latency, for endpoint
+	// failing all of its requests & for each block it's lagging behind
+	errorPenalty = 1000
+	lagPenalty   = 250
+
+	// How long health check waits for endpoint to respond
+	probeTimeout = 5 * time.Second
+)
+
+// ErrNoEndpoint - None of the endpoints in pool are connected
+var ErrNoEndpoint = errors.New("no blockchain node endpoint available")
+
+// errUnavailable - Endpoint responded, but doesn't have requested data yet,
+// which is not held against its health
+var errUnavailable = errors.New("data unavailable")
+
+// Endpoint - One blockchain node, along with health stats collected while talking to it
+type Endpoint struct {
+	lock sync.RWMutex
+
+	url    string
+	raw    string
+	source ChainSource
+	dial   func() (ChainSource, error)
+
+	latency     time.Duration
+	errorRate   float64
+	head        uint64
+	requests    uint64
+	failures    uint64
+	consecutive uint64
+	lastError   string
+	lastErrorAt time.Time
+}
+
+// EndpointStat - Snapshot of endpoint health, to be exposed over API
+type EndpointStat struct {
+	URL         string  `json:"url"`
+	Up          bool    `json:"up"`
+	Latency     string  `json:"latency"`
+	ErrorRate   float64 `json:"errorRate"`
+	Head        uint64  `json:"head"`
+	Lag         uint64  `json:"lag"`
+	Requests    uint64  `json:"requests"`
+	Failures    uint64  `json:"failures"`
+	Score       float64 `json:"score"`
+	LastError   string  `json:"lastError,omitempty"`
+	LastErrorAt int64   `json:"lastErrorAt,omitempty"`
+}
+
+// Pool - Chain source backed by multiple blockchain nodes, each call is routed
+// to healthiest one & on failure, transparently retried on next one
+type Pool struct {
+	name      string
+	endpoints []*Endpoint
+}
+
+// NewPool - Dials all endpoints, those which can't be connected to right now
+// are kept in pool, to be redialed during health check
+func NewPool(name string, urls []string) *Pool {
+
+	endpoints := make([]*Endpoint, 0, len(urls))
+
+	for _, v := range urls {
+
+		// Making copy, so that each dialer gets its own URL
+		_url := v
+
+		endpoint := &Endpoint{
+			url: MaskURL(_url),
+			raw: _url,
+			dial: func() (ChainSource, error) {
+				return Dial(_url)
+			},
+		}
+
+		if err := endpoint.redial(); err != nil {
+			log.Print(color.Red.Sprintf("[!] Failed to connect to %s endpoint %s : %s", name, endpoint.url, endpoint.lastError))
+		}
+
+		endpoints = append(endpoints, endpoint)
+
+	}
+
+	return &Pool{name: name, endpoints: endpoints}
+
+}
+
+// NewPoolOf - Pool of already connected chain sources, which can't be redialed
+func NewPoolOf(name string, sources ...ChainSource) *Pool {
+
+	endpoints := make([]*Endpoint, len(sources))
+
+	for k, v := range sources {
+		endpoints[k] = &Endpoint{url: fmt.Sprintf("%s-%d", name, k), source: v}
+	}
+
+	return &Pool{name: name, endpoints: endpoints}
+
+}
+
+// Connected - Number of endpoints, pool currently holds connection to
+func (p *Pool) Connected() int {
+
+	count := 0
+
+	for _, v := range p.endpoints {
+		if v.connected() != nil {
+			count++
+		}
+	}
+
+	return count
+
+}
+
+// MaskURL - Keeps only scheme & host of endpoint URL, given path & query
+// string often carry API keys, which must not be exposed
+func MaskURL(raw string) string {
+
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Host == "" {
+		return "<invalid>"
+	}
+
+	return fmt.Sprintf("%s://%s", parsed.Scheme, parsed.Host)
+
+}
+
+// redial - Attempts to (re-)establish connection with endpoint
+func (e *Endpoint) redial() error {
+
+	if e.dial == nil {
+		return ErrNoEndpoint
+	}
+
+	source, err := e.dial()
+	if err != nil {
+		e.failed(err)
+		return err
+	}
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	e.source = source
+	e.consecutive = 0
+
+	return nil
+
+}
+
+// connected - Chain source of endpoint, nil if not connected
+func (e *Endpoint) connected() ChainSource {
+
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+
+	return e.source
+
+}
+
+// up - Connected & not failing repeatedly
+func (e *Endpoint) up() bool {
+	return e.source != nil && e.consecutive < maxConsecutiveFailures
+}
+
+// score - Lower is better, latency in milliseconds, penalised for errors
+// & for lagging behind best known head
+func (e *Endpoint) score(best uint64) float64 {
+
+	score := float64(e.latency.Microseconds())/1000 + e.errorRate*errorPenalty
+
+	if best > e.head {
+		score += float64(best-e.head) * lagPenalty
+	}
+
+	return score
+
+}
+
+// succeeded - Endpoint responded in given time
+func (e *Endpoint) succeeded(elapsed time.Duration) {
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	e.requests++
+	e.consecutive = 0
+	e.errorRate *= 1 - smoothing
+
+	if e.latency == 0 {
+		e.latency = elapsed
+	} else {
+		e.latency = time.Duration(float64(e.latency)*(1-smoothing) + float64(elapsed)*smoothing)
+	}
+
+}
+
+// failed - Endpoint couldn't serve request
+func (e *Endpoint) failed(err error) {
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	e.requests++
+	e.failures++
+	e.consecutive++
+	e.errorRate = e.errorRate*(1-smoothing) + smoothing
+	e.lastError = err.Error()
+
+	// Error messages may carry full URL, which is not to be exposed
+	if e.raw != "" {
+		e.lastError = strings.ReplaceAll(e.lastError, e.raw, e.url)
+	}
+
+	e.lastErrorAt = time.Now().UTC()
+
+}
+
+// seen - Endpoint is aware of chain head at given height
+func (e *Endpoint) seen(head uint64) {
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if head > e.head {
+		e.head = head
+	}
+
+}
+
+// record - Updates endpoint health, depending upon outcome of request
+//
+// Endpoint not having data yet, is not its failure, neither is caller giving up
+func (e *Endpoint) record(ctx context.Context, elapsed time.Duration, err error) {
+
+	if ctx.Err() != nil {
+		return
+	}
+
+	if err == nil || errors.Is(err, ethereum.NotFound) || errors.Is(err, errUnavailable) {
+		e.succeeded(elapsed)
+		return
+	}
+
+	e.failed(err)
+
+}
+
+// best - Highest chain head, any of endpoints is aware of
+func (p *Pool) best() uint64 {
+
+	var best uint64
+
+	for _, v := range p.endpoints {
+
+		v.lock.RLock()
+		if v.head > best {
+			best = v.head
+		}
+		v.lock.RUnlock()
+
+	}
+
+	return best
+
+}
+
+// ranked - Connected endpoints, healthiest first
+//
+// When every endpoint is down, all connected ones are still attempted,
+// rather than failing right away
+func (p *Pool) ranked() []*Endpoint {
+
+	best := p.best()
+
+	type candidate struct {
+		endpoint *Endpoint
+		score    float64
+	}
+
+	up := make([]candidate, 0, len(p.endpoints))
+	down := make([]candidate, 0, len(p.endpoints))
+
+	for _, v := range p.endpoints {
+
+		v.lock.RLock()
+
+		if v.source != nil {
+
+			c := candidate{endpoint: v, score: v.score(best)}
+
+			if v.up() {
+				up = append(up, c)
+			} else {
+				down = append(down, c)
+			}
+
+		}
+
+		v.lock.RUnlock()
+
+	}
+
+	if len(up) == 0 {
+		up = down
+	}
+
+	sort.SliceStable(up, func(i, j int) bool {
+		return up[i].score < up[j].score
+	})
+
+	endpoints := make([]*Endpoint, len(up))
+	for k, v := range up {
+		endpoints[k] = v.endpoint
+	}
+
+	return endpoints
+
+}
+
+// do - Invokes call on healthiest endpoint, failing over to next ones until
+// one of them succeeds, last error is returned when all of them fail
+func (p *Pool) do(ctx context.Context, call func(ChainSource) error) error {
+
+	endpoints := p.ranked()
+	if len(endpoints) == 0 {
+		return ErrNoEndpoint
+	}
+
+	var err error
+
+	for _, v := range endpoints {
+
+		source := v.connected()
+		if source == nil {
+			continue
+		}
+
+		start := time.Now()
+		err = call(source)
+		v.record(ctx, time.Since(start), err)
+
+		if err == nil || ctx.Err() != nil {
+			break
+		}
+
+	}
+
+	return err
+
+}
+
+// SubscribeNewHead - Subscribes to new heads on healthiest endpoint
+func (p *Pool) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+
+	var subs ethereum.Subscription
+
+	err := p.do(ctx, func(source ChainSource) error {
+
+		var err error
+		subs, err = source.SubscribeNewHead(ctx, ch)
+		return err
+
+	})
+
+	return subs, err
+
+}
+
+// ChainID - Identifier of chain, as told by healthiest endpoint
+func (p *Pool) ChainID(ctx context.Context) (*big.Int, error) {
+
+	var id *big.Int
+
+	err := p.do(ctx, func(source ChainSource) error {
+
+		var err error
+		id, err = source.ChainID(ctx)
+		return err
+
+	})
+
+	return id, err
+
+}
+
+// BlockNumber - Number of latest canonical block, as known to healthiest endpoint
+func (p *Pool) BlockNumber(ctx context.Context) (uint64, error) {
+
+	var number uint64
+
+	err := p.do(ctx, func(source ChainSource) error {
+
+		var err error
+		number, err = source.BlockNumber(ctx)
+		return err
+
+	})
+
+	return number, err
+
+}
+
+// HeaderByNumber - Canonical block header at given height, if number is nil, latest one
+func (p *Pool) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+
+	var header *types.Header
+
+	err := p.do(ctx, func(source ChainSource) error {
+
+		var err error
+		header, err = source.HeaderByNumber(ctx, number)
+		return err
+
+	})
+
+	return header, err
+
+}
+
+// BlockByNumber - Canonical block at given height, if number is nil, latest block
+func (p *Pool) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+
+	var block *types.Block
+
+	err := p.do(ctx, func(source ChainSource) error {
+
+		var err error
+		block, err = source.BlockByNumber(ctx, number)
+		return err
+
+	})
+
+	return block, err
+
+}
+
+// BlockByHash - Block identified by hash, may not be part of canonical chain
+func (p *Pool) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
+
+	var block *types.Block
+
+	err := p.do(ctx, func(source ChainSource) error {
+
+		var err error
+		block, err = source.BlockByHash(ctx, hash)
+		return err
+
+	})
+
+	return block, err
+
+}
+
+// BlockReceipts - Receipts of all tx(s) in block, if endpoint serves incomplete
+// set of receipts, next one is attempted
+func (p *Pool) BlockReceipts(ctx context.Context, block *types.Block) ([]*types.Receipt, error) {
+
+	var receipts []*types.Receipt
+
+	err := p.do(ctx, func(source ChainSource) error {
+
+		var err error
+		receipts, err = source.BlockReceipts(ctx, block)
+		if err != nil {
+			return err
+		}
+
+		if err := CheckReceipts(block, receipts); err != nil {
+			return fmt.Errorf("%w : %s", errUnavailable, err.Error())
+		}
+
+		return nil
+
+	})
+
+	return receipts, err
+
+}
+
+// TransactionReceipt - Receipt of tx, included in canonical chain
+func (p *Pool) TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+
+	var receipt *types.Receipt
+
+	err := p.do(ctx, func(source ChainSource) error {
+
+		var err error
+		receipt, err = source.TransactionReceipt(ctx, hash)
+		return err
+
+	})
+
+	return receipt, err
+
+}
+
+// TransactionSender - Sender of tx, present at given index of block
+func (p *Pool) TransactionSender(ctx context.Context, tx *types.Transaction, block common.Hash, index uint) (common.Address, error) {
+
+	var sender common.Address
+
+	err := p.do(ctx, func(source ChainSource) error {
+
+		var err error
+		sender, err = source.TransactionSender(ctx, tx, block, index)
+		return err
+
+	})
+
+	return sender, err
+
+}
+
+// TraceBlock - Call trace of each tx in block, in same order as tx(s)
+func (p *Pool) TraceBlock(ctx context.Context, block *types.Block) ([]*CallFrame, error) {
+
+	var frames []*CallFrame
+
+	err := p.do(ctx, func(source ChainSource) error {
+
+		var err error
+		frames, err = source.TraceBlock(ctx, block)
+		return err
+
+	})
+
+	return frames, err
+
+}
+
+// CodeAt - Runtime bytecode of account at given height, if number is nil, latest one
+func (p *Pool) CodeAt(ctx context.Context, account common.Address, number *big.Int) ([]byte, error) {
+
+	var code []byte
+
+	err := p.do(ctx, func(source ChainSource) error {
+
+		var err error
+		code, err = source.CodeAt(ctx, account, number)
+		return err
+
+	})
+
+	return code, err
+
+}
+
+// StorageAt - Value in storage slot of account at given height, if number is nil, latest one
+func (p *Pool) StorageAt(ctx context.Context, account common.Address, key common.Hash, number *big.Int) ([]byte, error) {
+
+	var value []byte
+
+	err := p.do(ctx, func(source ChainSource) error {
+
+		var err error
+		value, err = source.StorageAt(ctx, account, key, number)
+		return err
+
+	})
+
+	return value, err
+
+}
+
+// CallContract - Executes message call against state at given height, if number is nil, latest one
+//
+// Reverted call is not held against endpoint, neither it's attempted on next one
+func (p *Pool) CallContract(ctx context.Context, msg ethereum.CallMsg, number *big.Int) ([]byte, error) {
+
+	var output []byte
+	var reverted error
+
+	err := p.do(ctx, func(source ChainSource) error {
+
+		var err error
+		output, err = source.CallContract(ctx, msg, number)
+
+		reverted = nil
+		if IsExecutionReverted(err) {
+			reverted = err
+			return nil
+		}
+
+		return err
+
+	})
+
+	if reverted != nil {
+		return nil, reverted
+	}
+
+	return output, err
+
+}
+
+// Probe - Checks health of each endpoint, by asking for latest header,
+// while attempting to reconnect to ones which got disconnected
+func (p *Pool) Probe(ctx context.Context) {
+
+	var wg sync.WaitGroup
+
+	for _, v := range p.endpoints {
+
+		wg.Add(1)
+
+		go func(endpoint *Endpoint) {
+
+			defer wg.Done()
+
+			if endpoint.connected() == nil {
+
+				if err := endpoint.redial(); err != nil {
+					return
+				}
+
+				log.Print(color.Green.Sprintf("[+] Reconnected to %s endpoint %s", p.name, endpoint.url))
+
+			}
+
+			_ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+			defer cancel()
+
+			start := time.Now()
+			header, err := endpoint.connected().HeaderByNumber(_ctx, nil)
+			endpoint.record(ctx, time.Since(start), err)
+
+			if err != nil {
+				return
+			}
+
+			endpoint.seen(header.Number.Uint64())
+
+		}(v)
+
+	}
+
+	wg.Wait()
+
+}
+
+// Monitor - Keeps probing endpoints periodically, until context is cancelled
+func (p *Pool) Monitor(ctx context.Context, interval time.Duration) {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+
+		p.Probe(ctx)
+
+		select {
+
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+
+		}
+
+	}
+
+}
+
+// Stats - Health snapshot of all endpoints in pool, healthiest first
+func (p *Pool) Stats() []*EndpointStat {
+
+	best := p.best()
+	stats := make([]*EndpointStat, 0, len(p.endpoints))
+
+	for _, v := range p.endpoints {
+
+		v.lock.RLock()
+
+		stat := &EndpointStat{
+			URL:       v.url,
+			Up:        v.up(),
+			Latency:   v.latency.String(),
+			ErrorRate: v.errorRate,
+			Head:      v.head,
+			Requests:  v.requests,
+			Failures:  v.failures,
+			Score:     v.score(best),
+			LastError: v.lastError,
+		}
+
+		if best > v.head {
+			stat.Lag = best - v.head
+		}
+
+		if !v.lastErrorAt.IsZero() {
+			stat.LastErrorAt = v.lastErrorAt.Unix()
+		}
+
+		v.lock.RUnlock()
+
+		stats = append(stats, stat)
+
+	}
+
+	sort.SliceStable(stats, func(i, j int) bool {
+
+		if stats[i].Up != stats[j].Up {
+			return stats[i].Up
+		}
+
+		return stats[i].Score < stats[j].Score
+
+	})
+
+	return stats
+
+}
+
+// StatsOf - Endpoint health snapshot, if chain source is backed by pool
+func StatsOf(source ChainSource) []*EndpointStat {
+
+	pool, ok := source.(*Pool)
+	if !ok {
+		return nil
+	}
+
+	return pool.Stats()
+
+}
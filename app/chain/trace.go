@@ -0,0 +1,74 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// CallFrame - Single call made during tx execution, as reported by `callTracer`,
+// along with all nested calls made from within it
+type CallFrame struct {
+	Type    string          `json:"type"`
+	From    common.Address  `json:"from"`
+	To      *common.Address `json:"to,omitempty"`
+	Value   *hexutil.Big    `json:"value,omitempty"`
+	Gas     hexutil.Uint64  `json:"gas"`
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	Input   hexutil.Bytes   `json:"input"`
+	Output  hexutil.Bytes   `json:"output,omitempty"`
+	Error   string          `json:"error,omitempty"`
+	Calls   []*CallFrame    `json:"calls,omitempty"`
+}
+
+// txTrace - Trace of single tx, as returned by `debug_traceBlockByNumber`,
+// recent node versions also put tx hash along with it
+type txTrace struct {
+	TxHash common.Hash `json:"txHash"`
+	Result *CallFrame  `json:"result"`
+	Error  string      `json:"error"`
+}
+
+// TraceBlock - Replays all tx(s) of block using `callTracer`, returns one top level
+// call frame per tx, in same order as tx(s)
+func (e *EthClient) TraceBlock(ctx context.Context, block *types.Block) ([]*CallFrame, error) {
+
+	var traces []*txTrace
+
+	if err := e.RPC.CallContext(ctx, &traces, "debug_traceBlockByNumber", hexutil.EncodeUint64(block.NumberU64()), map[string]interface{}{"tracer": "callTracer"}); err != nil {
+		return nil, err
+	}
+
+	txs := block.Transactions()
+
+	if len(traces) != len(txs) {
+		return nil, fmt.Errorf("expected %d traces, received %d", len(txs), len(traces))
+	}
+
+	frames := make([]*CallFrame, len(traces))
+
+	for k, v := range traces {
+
+		if v.Error != "" {
+			return nil, fmt.Errorf("failed to trace tx %s : %s", txs[k].Hash().Hex(), v.Error)
+		}
+
+		// Block at this height might have changed since we fetched it
+		if v.TxHash != (common.Hash{}) && v.TxHash != txs[k].Hash() {
+			return nil, fmt.Errorf("trace for tx %s received at index %d, expected %s", v.TxHash.Hex(), k, txs[k].Hash().Hex())
+		}
+
+		if v.Result == nil {
+			return nil, fmt.Errorf("missing trace for tx %s", txs[k].Hash().Hex())
+		}
+
+		frames[k] = v.Result
+
+	}
+
+	return frames, nil
+
+}
@@ -3,30 +3,63 @@ package app
 import (
 	"context"
 	"log"
+	"time"
 
 	"github.com/go-redis/redis/v8"
 
+	"github.com/denniswon/validationcloud/app/chain"
 	cfg "github.com/denniswon/validationcloud/app/config"
-	"github.com/ethereum/go-ethereum/ethclient"
 )
 
-// Connect to blockchain node, either using HTTP or Websocket connection
+// Connect to all configured blockchain nodes, either using HTTP or Websocket connection
 // depending upon true/ false, passed to function, respectively
-func getClient(isRPC bool) *ethclient.Client {
-	var client *ethclient.Client
-	var err error
-
+//
+// Calls are routed to healthiest of them, so service keeps running as long as
+// at least one of them is reachable
+func getClient(chainName string, urls []string, isRPC bool) *chain.Pool {
+	name := "websocket"
 	if isRPC {
-		client, err = ethclient.Dial(cfg.Get("RPCUrl"))
-	} else {
-		client, err = ethclient.Dial(cfg.Get("WebsocketUrl"))
+		name = "rpc"
+	}
+
+	// Endpoints of different chains are told apart in logs
+	if chainName != "" {
+		name = chainName + "/" + name
+	}
+
+	if len(urls) == 0 {
+		log.Fatalf("[!] No %s endpoint of blockchain node configured\n", name)
+	}
+
+	pool := chain.NewPool(name, urls)
+	if pool.Connected() == 0 {
+		log.Fatalf("[!] Failed to connect to blockchain : %v\n", chain.ErrNoEndpoint)
 	}
 
+	return pool
+}
+
+// Asks blockchain node which chain it's part of, when chain ID is configured, node
+// must be agreeing with it, otherwise data of different chains would get mixed up
+func getChainID(client chain.ChainSource, configured *cfg.Chain) uint64 {
+	name := configured.Name
+	if name == "" {
+		name = "blockchain"
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	chainID, err := client.ChainID(ctx)
 	if err != nil {
-		log.Fatalf("[!] Failed to connect to blockchain : %s\n", err.Error())
+		log.Fatalf("[!] Failed to fetch chain ID of %s : %s\n", name, err.Error())
+	}
+
+	if configured.ID != 0 && chainID.Uint64() != configured.ID {
+		log.Fatalf("[!] Chain ID of %s configured as %d, but node is on %d\n", name, configured.ID, chainID.Uint64())
 	}
 
-	return client
+	return chainID.Uint64()
 }
 
 // Creates connection to Redis server & returns that handle to be used for further communication
@@ -0,0 +1,268 @@
+package app
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+// command - Subcommand, along with what it does, for showing usage
+type command struct {
+	name        string
+	usage       string
+	description string
+	run         func(configFile string, args []string) error
+}
+
+// commands - All subcommands supported, when none given, `run` is assumed
+var commands = []*command{
+	{
+		name:        "run",
+		usage:       "run",
+		description: "Index all configured chains & serve API, in same process",
+		run: func(configFile string, args []string) error {
+
+			if err := parseFlags("run", args); err != nil {
+				return err
+			}
+
+			Run(configFile)
+			return nil
+
+		},
+	},
+	{
+		name:        "serve",
+		usage:       "serve",
+		description: "Serve API only, while blocks are indexed by instance(s) running `index`",
+		run: func(configFile string, args []string) error {
+
+			if err := parseFlags("serve", args); err != nil {
+				return err
+			}
+
+			Serve(configFile)
+			return nil
+
+		},
+	},
+	{
+		name:        "index",
+		usage:       "index",
+		description: "Index all configured chains, without serving API",
+		run: func(configFile string, args []string) error {
+
+			if err := parseFlags("index", args); err != nil {
+				return err
+			}
+
+			Index(configFile)
+			return nil
+
+		},
+	},
+	{
+		name:        "backfill",
+		usage:       "backfill --from <block> --to <block> [--chain <name|id>]",
+		description: "Fetch & persist blocks in range, which are missing in DB, & exit once done",
+		run: func(configFile string, args []string) error {
+
+			chainKey, from, to, err := parseRange(flag.NewFlagSet("backfill", flag.ContinueOnError), args)
+			if err != nil {
+				return err
+			}
+
+			Backfill(configFile, chainKey, from, to)
+			return nil
+
+		},
+	},
+	{
+		name:        "verify",
+		usage:       "verify --from <block> --to <block> [--chain <name|id>] [--stored [--requeue]]",
+		description: "Check blocks in range match canonical chain or, with --stored, integrity of stored ones",
+		run: func(configFile string, args []string) error {
+
+			chainKey, from, to, stored, requeue, err := parseVerify(args)
+			if err != nil {
+				return err
+			}
+
+			if stored {
+				VerifyStored(configFile, chainKey, from, to, requeue)
+				return nil
+			}
+
+			Verify(configFile, chainKey, from, to)
+			return nil
+
+		},
+	},
+	{
+		name:        "migrate",
+		usage:       "migrate",
+		description: "Migrate database schema to latest & exit",
+		run: func(configFile string, args []string) error {
+
+			if err := parseFlags("migrate", args); err != nil {
+				return err
+			}
+
+			Migrate(configFile)
+			return nil
+
+		},
+	},
+	{
+		name:        "prune",
+		usage:       "prune [--chain <name|id>]",
+		description: "Prune blocks beyond configured `PruneDepth` & `PruneAge` once & exit",
+		run: func(configFile string, args []string) error {
+
+			fs := flag.NewFlagSet("prune", flag.ContinueOnError)
+			chainKey := fs.String("chain", "", "Name or chain ID of chain to be pruned, all chains if not set")
+
+			if err := fs.Parse(args); err != nil {
+				return err
+			}
+
+			if fs.NArg() != 0 {
+				return fmt.Errorf("unexpected argument(s) %v", fs.Args())
+			}
+
+			Prune(configFile, *chainKey)
+			return nil
+
+		},
+	},
+}
+
+// Execute - Runs subcommand given in command line arguments, excluding
+// program name, using given config file
+func Execute(configFile string, args []string) {
+
+	if len(args) == 0 {
+		Run(configFile)
+		return
+	}
+
+	switch args[0] {
+
+	case "help", "-h", "-help", "--help":
+		usage(os.Stdout)
+		return
+
+	}
+
+	for _, v := range commands {
+
+		if v.name != args[0] {
+			continue
+		}
+
+		err := v.run(configFile, args[1:])
+		if err == nil {
+			return
+		}
+
+		// Usage of flags is already shown
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintf(os.Stderr, "[!] %s : %s\n\nUsage : evm-indexer %s\n", v.name, err.Error(), v.usage)
+		}
+
+		os.Exit(2)
+
+	}
+
+	fmt.Fprintf(os.Stderr, "[!] Unknown command `%s`\n\n", args[0])
+	usage(os.Stderr)
+
+	os.Exit(2)
+
+}
+
+// usage - Lists all subcommands
+func usage(w io.Writer) {
+
+	fmt.Fprintf(w, "Usage : evm-indexer [command]\n\nCommands :\n")
+
+	for _, v := range commands {
+		fmt.Fprintf(w, "  %-78s %s\n", v.usage, v.description)
+	}
+
+}
+
+// parseFlags - Parses arguments of subcommand, which doesn't accept any flags
+func parseFlags(name string, args []string) error {
+
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() != 0 {
+		return fmt.Errorf("unexpected argument(s) %v", fs.Args())
+	}
+
+	return nil
+
+}
+
+// parseVerify - Parses arguments of `verify`, block range along with whether stored
+// blocks are to be checked on their own & inconsistent ones requeued, latter
+// being allowed only along with former
+func parseVerify(args []string) (string, uint64, uint64, bool, bool, error) {
+
+	fs := flag.NewFlagSet("verify", flag.ContinueOnError)
+	stored := fs.Bool("stored", false, "Check integrity of stored blocks by rebuilding their tx & receipt tries, without asking node")
+	requeue := fs.Bool("requeue", false, "Process inconsistent stored blocks afresh")
+
+	chainKey, from, to, err := parseRange(fs, args)
+	if err != nil {
+		return "", 0, 0, false, false, err
+	}
+
+	if *requeue && !*stored {
+		return "", 0, 0, false, false, errors.New("--requeue needs --stored")
+	}
+
+	return chainKey, from, to, *stored, *requeue, nil
+
+}
+
+// parseRange - Parses arguments of subcommand working on block range, both ends
+// of which are required, chain is optional, along with flags already defined
+// in given flag set, if any
+func parseRange(fs *flag.FlagSet, args []string) (string, uint64, uint64, error) {
+
+	chainKey := fs.String("chain", "", "Name or chain ID of chain, first configured one if not set")
+	from := fs.Uint64("from", 0, "First block of range, inclusive")
+	to := fs.Uint64("to", 0, "Last block of range, inclusive")
+
+	if err := fs.Parse(args); err != nil {
+		return "", 0, 0, err
+	}
+
+	if fs.NArg() != 0 {
+		return "", 0, 0, fmt.Errorf("unexpected argument(s) %v", fs.Args())
+	}
+
+	given := make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) {
+		given[f.Name] = true
+	})
+
+	if !given["from"] || !given["to"] {
+		return "", 0, 0, errors.New("both --from & --to required")
+	}
+
+	if *from > *to {
+		return "", 0, 0, fmt.Errorf("bad block range [%d, %d]", *from, *to)
+	}
+
+	return *chainKey, *from, *to, nil
+
+}
@@ -0,0 +1,51 @@
+package app
+
+import (
+	"flag"
+	"testing"
+)
+
+func TestParseRange(t *testing.T) {
+
+	chainKey, from, to, err := parseRange(flag.NewFlagSet("backfill", flag.ContinueOnError), []string{"--from", "10", "--to", "20", "--chain", "polygon"})
+	if err != nil || chainKey != "polygon" || from != 10 || to != 20 {
+		t.Fatalf("expected polygon [10, 20], got %s [%d, %d], %v", chainKey, from, to, err)
+	}
+
+	// Range starting at genesis block
+	if _, from, to, err := parseRange(flag.NewFlagSet("verify", flag.ContinueOnError), []string{"--from=0", "--to=0"}); err != nil || from != 0 || to != 0 {
+		t.Fatalf("expected [0, 0], got [%d, %d], %v", from, to, err)
+	}
+
+	for _, args := range [][]string{
+		{"--from", "10"},
+		{"--to", "10"},
+		{"--from", "20", "--to", "10"},
+		{"--from", "-1", "--to", "10"},
+		{"--from", "1", "--to", "10", "extra"},
+	} {
+
+		if _, _, _, err := parseRange(flag.NewFlagSet("backfill", flag.ContinueOnError), args); err == nil {
+			t.Errorf("expected %v to be rejected", args)
+		}
+
+	}
+
+}
+
+func TestParseVerify(t *testing.T) {
+
+	if _, from, to, stored, requeue, err := parseVerify([]string{"--from", "1", "--to", "2", "--stored", "--requeue"}); err != nil || from != 1 || to != 2 || !stored || !requeue {
+		t.Fatalf("expected stored [1, 2] to be requeued, got [%d, %d], %v, %v, %v", from, to, stored, requeue, err)
+	}
+
+	if _, _, _, stored, requeue, err := parseVerify([]string{"--from", "1", "--to", "2"}); err != nil || stored || requeue {
+		t.Fatalf("expected blocks to be verified against node, got %v, %v, %v", stored, requeue, err)
+	}
+
+	// Only inconsistent stored blocks can be requeued
+	if _, _, _, _, _, err := parseVerify([]string{"--from", "1", "--to", "2", "--requeue"}); err == nil {
+		t.Fatal("expected --requeue without --stored to be rejected")
+	}
+
+}
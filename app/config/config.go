@@ -3,6 +3,7 @@ package config
 import (
 	"log"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/viper"
 )
@@ -94,3 +95,457 @@ func GetTimeRange() uint64 {
 	return parsedTimeRange
 
 }
+
+// GetMaxReorgDepth - Returns how many blocks at max the service will walk back,
+// following `ParentHash`, while looking for common ancestor during chain reorganization
+func GetMaxReorgDepth() uint64 {
+
+	depth := Get("MaxReorgDepth")
+	if depth == "" {
+		return 128
+	}
+
+	parsedDepth, err := strconv.ParseUint(depth, 10, 64)
+	if err != nil {
+		log.Printf("[!] Failed to parse max reorg depth : %s\n", err.Error())
+		return 128
+	}
+
+	return parsedDepth
+
+}
+
+// GetReceiptFetchMode - Returns strategy to be used for fetching tx receipts of a block
+//
+// `block` : Using `eth_getBlockReceipts`, all receipts of block in single call
+// `batch` : Using JSON-RPC batch request of `eth_getTransactionReceipt`(s)
+// `single` : One `eth_getTransactionReceipt` call per tx
+// `auto` : Attempts `block`, falls back to `batch` if node doesn't support it
+func GetReceiptFetchMode() string {
+
+	mode := strings.ToLower(Get("ReceiptFetchMode"))
+
+	switch mode {
+	case "auto", "block", "batch", "single":
+		return mode
+	case "":
+		return "auto"
+	default:
+		log.Printf("[!] Unsupported receipt fetch mode : %s, using `auto`\n", mode)
+		return "auto"
+	}
+
+}
+
+// GetReceiptBatchSize - Returns how many `eth_getTransactionReceipt` calls
+// to be put in single JSON-RPC batch request
+func GetReceiptBatchSize() uint64 {
+
+	size := Get("ReceiptBatchSize")
+	if size == "" {
+		return 100
+	}
+
+	parsedSize, err := strconv.ParseUint(size, 10, 64)
+	if err != nil || parsedSize == 0 {
+		log.Printf("[!] Failed to parse receipt batch size : %s\n", size)
+		return 100
+	}
+
+	return parsedSize
+
+}
+
+// GetHeadPollInterval - Returns how often ( in terms of second ) latest block number
+// to be polled, while subscription to new heads is down
+func GetHeadPollInterval() uint64 {
+
+	interval := Get("HeadPollInterval")
+	if interval == "" {
+		return 2
+	}
+
+	parsedInterval, err := strconv.ParseUint(interval, 10, 64)
+	if err != nil || parsedInterval == 0 {
+		log.Printf("[!] Failed to parse head poll interval : %s\n", interval)
+		return 2
+	}
+
+	return parsedInterval
+
+}
+
+// IsCallTracingEnabled - Returns whether calls made during tx execution
+// to be traced & persisted, set using `TraceCalls`
+func IsCallTracingEnabled() bool {
+	return strings.ToLower(Get("TraceCalls")) == "yes"
+}
+
+// nodeURLs - Returns comma separated blockchain node endpoints, set in `<prefix>RPCUrls`
+// or `<prefix>WebsocketUrls`, depending upon true/ false passed to function, respectively
+//
+// If list is not set, falls back to single endpoint set in `<prefix>RPCUrl` or `<prefix>WebsocketUrl`
+func nodeURLs(prefix string, isRPC bool) []string {
+
+	list, single := prefix+"WebsocketUrls", prefix+"WebsocketUrl"
+	if isRPC {
+		list, single = prefix+"RPCUrls", prefix+"RPCUrl"
+	}
+
+	urls := make([]string, 0)
+
+	for _, v := range strings.Split(Get(list), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			urls = append(urls, v)
+		}
+	}
+
+	if len(urls) == 0 && Get(single) != "" {
+		urls = append(urls, Get(single))
+	}
+
+	return urls
+
+}
+
+// Chain - Blockchain to be indexed, along with endpoints of its nodes
+//
+// Chain ID is 0, when it's not configured, then it's learnt from node
+//
+// Finality mode is set in `<prefix>FinalityMode`, falling back to `FinalityMode`,
+// as chains indexed together may not all have node's block tags
+type Chain struct {
+	Name          string
+	ID            uint64
+	RPCUrls       []string
+	WebsocketUrls []string
+	FinalityMode  string
+}
+
+// IsFinalityTagsEnabled - Returns whether blocks of this chain are to be considered
+// finalized as per node's `finalized` block tag
+func (c *Chain) IsFinalityTagsEnabled() bool {
+	return c.FinalityMode == "tags"
+}
+
+// chainOf - Reads configuration of chain, from keys having given prefix, exits if
+// chain ID is set, but malformed
+func chainOf(name string, prefix string) *Chain {
+
+	chain := &Chain{
+		Name:          name,
+		RPCUrls:       nodeURLs(prefix, true),
+		WebsocketUrls: nodeURLs(prefix, false),
+	}
+
+	mode := Get(prefix + "FinalityMode")
+	if mode == "" {
+		mode = Get("FinalityMode")
+	}
+
+	chain.FinalityMode = parseFinalityMode(mode)
+
+	if id := Get(prefix + "ChainID"); id != "" {
+
+		// Indexing under wrong chain ID would mix up data of chains
+		parsedID, err := strconv.ParseUint(id, 10, 64)
+		if err != nil {
+			log.Fatalf("[!] Failed to parse chain ID of %q : %s\n", name, err.Error())
+		}
+
+		chain.ID = parsedID
+
+	}
+
+	return chain
+
+}
+
+// GetChains - Returns all blockchains to be indexed, named in comma separated `Chains`,
+// each one having its node endpoints set in `<name>_RPCUrls` & `<name>_WebsocketUrls`
+// ( or single endpoint variants of them ) & chain ID in `<name>_ChainID`
+//
+// If list is not set, single unnamed chain is indexed, using `RPCUrls`, `WebsocketUrls`
+// & `ChainID`, as before
+func GetChains() []*Chain {
+
+	chains := make([]*Chain, 0)
+
+	for _, v := range strings.Split(Get("Chains"), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			chains = append(chains, chainOf(v, v+"_"))
+		}
+	}
+
+	if len(chains) == 0 {
+		chains = append(chains, chainOf("", ""))
+	}
+
+	return chains
+
+}
+
+// GetNodeHealthCheckInterval - Returns how often ( in terms of second ) health
+// of each blockchain node endpoint to be checked
+func GetNodeHealthCheckInterval() uint64 {
+
+	interval := Get("NodeHealthCheckInterval")
+	if interval == "" {
+		return 15
+	}
+
+	parsedInterval, err := strconv.ParseUint(interval, 10, 64)
+	if err != nil || parsedInterval == 0 {
+		log.Printf("[!] Failed to parse node health check interval : %s\n", interval)
+		return 15
+	}
+
+	return parsedInterval
+
+}
+
+// GetStartBlock - Returns lowest block number, the service is supposed to index,
+// set using `StartBlock`, blocks below it are neither synced nor looked for
+func GetStartBlock() uint64 {
+
+	start := Get("StartBlock")
+	if start == "" {
+		return 0
+	}
+
+	parsedStart, err := strconv.ParseUint(start, 10, 64)
+	if err != nil {
+		log.Printf("[!] Failed to parse start block : %s\n", err.Error())
+		return 0
+	}
+
+	return parsedStart
+
+}
+
+// GetHistoryDepth - Returns how many most recent blocks the service is supposed to
+// keep indexed, set using `HistoryDepth`, 0 denotes no limit
+func GetHistoryDepth() uint64 {
+
+	depth := Get("HistoryDepth")
+	if depth == "" {
+		return 0
+	}
+
+	parsedDepth, err := strconv.ParseUint(depth, 10, 64)
+	if err != nil {
+		log.Printf("[!] Failed to parse history depth : %s\n", err.Error())
+		return 0
+	}
+
+	return parsedDepth
+
+}
+
+// GetLowestBlockToSync - Given latest block number, returns lower end of window
+// of blocks, to be kept in sync, which is highest one of `StartBlock` & first
+// block of most recent `HistoryDepth` or `PruneDepth` blocks, because
+// there's no point in syncing blocks which are going to be pruned
+func GetLowestBlockToSync(latest uint64) uint64 {
+
+	lowest := GetStartBlock()
+
+	for _, depth := range []uint64{GetHistoryDepth(), GetPruneDepth()} {
+		if depth != 0 && latest+1 > depth && latest+1-depth > lowest {
+			lowest = latest + 1 - depth
+		}
+	}
+
+	return lowest
+
+}
+
+// GetPruneDepth - Returns how many most recent blocks to be retained in database,
+// set using `PruneDepth`, older ones get pruned, 0 denotes no limit
+func GetPruneDepth() uint64 {
+
+	depth := Get("PruneDepth")
+	if depth == "" {
+		return 0
+	}
+
+	parsedDepth, err := strconv.ParseUint(depth, 10, 64)
+	if err != nil {
+		log.Printf("[!] Failed to parse prune depth : %s\n", err.Error())
+		return 0
+	}
+
+	return parsedDepth
+
+}
+
+// GetPruneAge - Returns age of block ( in terms of second ), after which it gets
+// pruned from database, set using `PruneAge`, 0 denotes no limit
+func GetPruneAge() uint64 {
+
+	age := Get("PruneAge")
+	if age == "" {
+		return 0
+	}
+
+	parsedAge, err := strconv.ParseUint(age, 10, 64)
+	if err != nil {
+		log.Printf("[!] Failed to parse prune age : %s\n", err.Error())
+		return 0
+	}
+
+	return parsedAge
+
+}
+
+// IsPruningEnabled - Returns whether old blocks are to be pruned from database,
+// either by depth or by age
+func IsPruningEnabled() bool {
+	return GetPruneDepth() != 0 || GetPruneAge() != 0
+}
+
+// GetPruneInterval - Returns how often ( in terms of second ) pruner looks for
+// blocks to be pruned
+func GetPruneInterval() uint64 {
+
+	interval := Get("PruneInterval")
+	if interval == "" {
+		return 60
+	}
+
+	parsedInterval, err := strconv.ParseUint(interval, 10, 64)
+	if err != nil || parsedInterval == 0 {
+		log.Printf("[!] Failed to parse prune interval : %s\n", interval)
+		return 60
+	}
+
+	return parsedInterval
+
+}
+
+// GetPruneBatchSize - Returns how many blocks, along with all data belonging to
+// them, to be deleted in single DB transaction while pruning
+func GetPruneBatchSize() uint64 {
+
+	size := Get("PruneBatchSize")
+	if size == "" {
+		return 100
+	}
+
+	parsedSize, err := strconv.ParseUint(size, 10, 64)
+	if err != nil || parsedSize == 0 {
+		log.Printf("[!] Failed to parse prune batch size : %s\n", size)
+		return 100
+	}
+
+	return parsedSize
+
+}
+
+// GetIndexMode - Returns what's to be indexed, set using `IndexMode`
+//
+// `all` : All tx(s) & event(s) of each block
+// `watchlist` : Only tx(s) & event(s) touching addresses in watchlist
+func GetIndexMode() string {
+
+	mode := strings.ToLower(Get("IndexMode"))
+
+	switch mode {
+	case "all", "watchlist":
+		return mode
+	case "":
+		return "all"
+	default:
+		log.Printf("[!] Unsupported index mode : %s, using `all`\n", mode)
+		return "all"
+	}
+
+}
+
+// IsWatchlistModeEnabled - Returns whether only tx(s) & event(s) touching
+// addresses in watchlist are to be indexed
+func IsWatchlistModeEnabled() bool {
+	return GetIndexMode() == "watchlist"
+}
+
+// parseFinalityMode - Returns how it's decided whether block is finalized or not, either
+// by counting `BlockConfirmations` on top of it or by polling node's `safe` &
+// `finalized` block tags, falling back to `confirmations`
+func parseFinalityMode(mode string) string {
+
+	mode = strings.ToLower(mode)
+
+	switch mode {
+	case "confirmations", "tags":
+		return mode
+	case "":
+		return "confirmations"
+	default:
+		log.Printf("[!] Unsupported finality mode : %s, using `confirmations`\n", mode)
+		return "confirmations"
+	}
+
+}
+
+// GetFinalityPollInterval - Returns how often ( in terms of second ) `safe` &
+// `finalized` block tags to be polled, in tags based finality mode
+func GetFinalityPollInterval() uint64 {
+
+	interval := Get("FinalityPollInterval")
+	if interval == "" {
+		return 12
+	}
+
+	parsedInterval, err := strconv.ParseUint(interval, 10, 64)
+	if err != nil || parsedInterval == 0 {
+		log.Printf("[!] Failed to parse finality poll interval : %s\n", interval)
+		return 12
+	}
+
+	return parsedInterval
+
+}
+
+// GetMaxAttempts - Returns how many times processing of block can fail, before
+// it's given up on & dead-lettered, set using `MaxAttempts`, 0 for retrying forever
+func GetMaxAttempts() uint64 {
+
+	attempts := Get("MaxAttempts")
+	if attempts == "" {
+		return 20
+	}
+
+	parsedAttempts, err := strconv.ParseUint(attempts, 10, 64)
+	if err != nil {
+		log.Printf("[!] Failed to parse max attempts : %s\n", err.Error())
+		return 20
+	}
+
+	return parsedAttempts
+
+}
+
+// GetBulkWriteSize - Returns how many blocks, being backfilled by syncer, to be
+// written together using `COPY`, set using `BulkWriteSize`, 0 for writing them
+// one by one, same as blocks at head of chain
+func GetBulkWriteSize() uint64 {
+
+	size := Get("BulkWriteSize")
+	if size == "" {
+		return 100
+	}
+
+	parsedSize, err := strconv.ParseUint(size, 10, 64)
+	if err != nil {
+		log.Printf("[!] Failed to parse bulk write size : %s\n", err.Error())
+		return 100
+	}
+
+	return parsedSize
+
+}
+
+// IsBulkWriteEnabled - Returns whether blocks being backfilled are to be written in bulk
+func IsBulkWriteEnabled() bool {
+	return GetBulkWriteSize() != 0
+}
@@ -0,0 +1,86 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestGetLowestBlockToSync(t *testing.T) {
+
+	cases := []struct {
+		start, depth, prune string
+		latest              uint64
+		lowest              uint64
+	}{
+		{"", "", "", 100, 0},
+		{"10", "", "", 100, 10},
+		{"", "50", "", 100, 51},
+		{"", "500", "", 100, 0},
+		{"80", "50", "", 100, 80},
+		{"10", "50", "", 100, 51},
+		{"", "50", "20", 100, 81},
+		{"", "20", "50", 100, 81},
+		{"bad", "bad", "bad", 100, 0},
+	}
+
+	defer viper.Reset()
+
+	for _, v := range cases {
+
+		viper.Set("StartBlock", v.start)
+		viper.Set("HistoryDepth", v.depth)
+		viper.Set("PruneDepth", v.prune)
+
+		if lowest := GetLowestBlockToSync(v.latest); lowest != v.lowest {
+			t.Errorf("start %q, depth %q, prune %q, latest %d : expected %d, got %d", v.start, v.depth, v.prune, v.latest, v.lowest, lowest)
+		}
+
+	}
+
+}
+
+func TestGetChains(t *testing.T) {
+
+	defer viper.Reset()
+
+	viper.Set("RPCUrl", "http://localhost:8545")
+	viper.Set("WebsocketUrls", "ws://a:8546, ws://b:8546")
+
+	chains := GetChains()
+	if len(chains) != 1 {
+		t.Fatalf("expected single chain, got %d", len(chains))
+	}
+
+	if chains[0].Name != "" || chains[0].ID != 0 || len(chains[0].RPCUrls) != 1 || len(chains[0].WebsocketUrls) != 2 || chains[0].FinalityMode != "confirmations" {
+		t.Fatalf("unexpected unnamed chain %+v", chains[0])
+	}
+
+	viper.Set("Chains", "ethereum, arbitrum")
+	viper.Set("ethereum_RPCUrls", "http://eth:8545")
+	viper.Set("ethereum_WebsocketUrl", "ws://eth:8546")
+	viper.Set("ethereum_ChainID", "1")
+	viper.Set("arbitrum_RPCUrls", "http://arb-1:8545,http://arb-2:8545")
+	viper.Set("FinalityMode", "tags")
+	viper.Set("arbitrum_FinalityMode", "confirmations")
+
+	chains = GetChains()
+	if len(chains) != 2 {
+		t.Fatalf("expected 2 chains, got %d", len(chains))
+	}
+
+	if v := chains[0]; v.Name != "ethereum" || v.ID != 1 || len(v.RPCUrls) != 1 || len(v.WebsocketUrls) != 1 || !v.IsFinalityTagsEnabled() {
+		t.Fatalf("unexpected chain %+v", v)
+	}
+
+	// Finality mode set for chain overrides one set for all
+	if v := chains[1]; v.IsFinalityTagsEnabled() {
+		t.Fatalf("expected finality of %s to be decided by confirmations", v.Name)
+	}
+
+	// Chain ID not configured is learnt from node
+	if v := chains[1]; v.Name != "arbitrum" || v.ID != 0 || len(v.RPCUrls) != 2 || len(v.WebsocketUrls) != 0 {
+		t.Fatalf("unexpected chain %+v", v)
+	}
+
+}
@@ -0,0 +1,70 @@
+package data
+
+import (
+	"encoding/json"
+	"log"
+)
+
+// ABI - Contract ABI registered with service, extracted from db
+type ABI struct {
+	Address   string `json:"address" gorm:"column:address"`
+	ABI       []byte `json:"abi" gorm:"column:abi"`
+	UpdatedAt uint64 `json:"updatedAt" gorm:"column:updatedat"`
+}
+
+// MarshalJSON - Custom JSON encoder, putting ABI as it's, instead of
+// base64 encoded bytes
+func (a *ABI) MarshalJSON() ([]byte, error) {
+
+	return json.Marshal(&struct {
+		Address   string          `json:"address"`
+		ABI       json.RawMessage `json:"abi"`
+		UpdatedAt uint64          `json:"updatedAt"`
+	}{
+		Address:   a.Address,
+		ABI:       a.ABI,
+		UpdatedAt: a.UpdatedAt,
+	})
+
+}
+
+// ToJSON - Encoding into JSON
+func (a *ABI) ToJSON() []byte {
+
+	data, err := json.Marshal(a)
+	if err != nil {
+		log.Printf("[!] Failed to encode ABI to JSON : %s\n", err.Error())
+		return nil
+	}
+
+	return data
+
+}
+
+// Decoded - Tx calldata/ event log decoded using ABI of contract, holding
+// name of invoked method/ emitted event along with its arguments, in order
+type Decoded struct {
+	Name      string             `json:"name"`
+	Signature string             `json:"signature"`
+	Arguments []*DecodedArgument `json:"args"`
+}
+
+// DecodedArgument - Single named argument of decoded method call/ event,
+// where numbers are put as decimal strings & bytes as hex strings
+type DecodedArgument struct {
+	Name  string      `json:"name"`
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+// Signature - Best-effort guess of invoked method/ emitted event, found in signature
+// database using method selector/ event topic, when contract ABI isn't registered
+//
+// When multiple signatures collide, all of them are put as candidates & name is
+// set only if all of them agree on it
+type Signature struct {
+	Name       string   `json:"name,omitempty"`
+	Signature  string   `json:"signature,omitempty"`
+	Ambiguous  bool     `json:"ambiguous"`
+	Candidates []string `json:"candidates"`
+}
@@ -24,6 +24,13 @@ type Block struct {
 	TransactionRootHash string  `json:"txRootHash" gorm:"column:txroothash"`
 	ReceiptRootHash     string  `json:"receiptRootHash" gorm:"column:receiptroothash"`
 	ExtraData           []byte  `json:"extraData" gorm:"column:extradata"`
+	BaseFee             string  `json:"baseFee" gorm:"column:basefee"`
+	BlobGasUsed         uint64  `json:"blobGasUsed" gorm:"column:blobgasused"`
+	ExcessBlobGas       uint64  `json:"excessBlobGas" gorm:"column:excessblobgas"`
+	WithdrawalsRootHash string  `json:"withdrawalsRoot" gorm:"column:withdrawalsroothash"`
+	Chain               uint64  `json:"chain" gorm:"column:chain_id"`
+	Finality            string  `json:"finality,omitempty" gorm:"-"`
+	Removed             bool    `json:"removed" gorm:"-"`
 }
 
 // MarshalBinary - Implementing binary marshalling function, to be invoked
@@ -40,7 +47,7 @@ func (b *Block) MarshalJSON() ([]byte, error) {
 		extraData = fmt.Sprintf("0x%s", _h)
 	}
 
-	return []byte(fmt.Sprintf(`{"hash":%q,"number":%d,"time":%d,"parentHash":%q,"difficulty":%q,"gasUsed":%d,"gasLimit":%d,"nonce":%q,"miner":%q,"size":%f,"stateRootHash":%q,"uncleHash":%q,"txRootHash":%q,"receiptRootHash":%q,"extraData":%q}`,
+	return []byte(fmt.Sprintf(`{"hash":%q,"number":%d,"time":%d,"parentHash":%q,"difficulty":%q,"gasUsed":%d,"gasLimit":%d,"nonce":%q,"miner":%q,"size":%f,"stateRootHash":%q,"uncleHash":%q,"txRootHash":%q,"receiptRootHash":%q,"extraData":%q,"baseFee":%q,"blobGasUsed":%d,"excessBlobGas":%d,"withdrawalsRoot":%q,"chain":%d%s%s}`,
 		b.Hash,
 		b.Number,
 		b.Time,
@@ -55,7 +62,38 @@ func (b *Block) MarshalJSON() ([]byte, error) {
 		b.UncleHash,
 		b.TransactionRootHash,
 		b.ReceiptRootHash,
-		extraData)), nil
+		extraData,
+		b.BaseFee,
+		b.BlobGasUsed,
+		b.ExcessBlobGas,
+		b.WithdrawalsRootHash,
+		b.Chain,
+		finalityField(b.Finality),
+		removedField(b.Removed))), nil
+
+}
+
+// finalityField - Finality status of block, omitted when it's not known
+// e.g. block got retracted due to chain reorganization
+func finalityField(finality string) string {
+
+	if finality == "" {
+		return ""
+	}
+
+	return fmt.Sprintf(`,"finality":%q`, finality)
+
+}
+
+// removedField - Data retracted from pubsub topics due to chain reorganization
+// carries `removed` flag, for all others it's simply omitted
+func removedField(removed bool) string {
+
+	if !removed {
+		return ""
+	}
+
+	return `,"removed":true`
 
 }
 
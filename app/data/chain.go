@@ -0,0 +1,76 @@
+package data
+
+import (
+	"strconv"
+	"strings"
+
+	q "github.com/denniswon/validationcloud/app/queue"
+)
+
+// Chain - Everything needed for indexing one blockchain, so that multiple of them
+// can be indexed by single deployment, sharing same database & redis server
+type Chain struct {
+	ID           uint64
+	Name         string
+	FinalityMode string
+	Connection   *BlockChainNodeConnection
+	Status       *StatusHolder
+	Redis        *RedisInfo
+	Queue        *q.BlockProcessorQueue
+}
+
+// Label - Name of chain, if configured, otherwise its chain ID
+func (c *Chain) Label() string {
+
+	if c.Name != "" {
+		return c.Name
+	}
+
+	return strconv.FormatUint(c.ID, 10)
+
+}
+
+// Chains - All blockchains being indexed, in configured order
+type Chains []*Chain
+
+// Find - Looks up chain either by its name ( case insensitive ) or chain ID,
+// if key is empty, first configured chain is returned
+//
+// If not found, returns nil
+func (c Chains) Find(key string) *Chain {
+
+	if len(c) == 0 {
+		return nil
+	}
+
+	if key == "" {
+		return c[0]
+	}
+
+	for _, v := range c {
+		if v.Name != "" && strings.EqualFold(v.Name, key) {
+			return v
+		}
+	}
+
+	id, err := strconv.ParseUint(key, 10, 64)
+	if err != nil {
+		return nil
+	}
+
+	return c.ByID(id)
+
+}
+
+// ByID - Looks up chain by its chain ID, nil if it's not being indexed
+func (c Chains) ByID(id uint64) *Chain {
+
+	for _, v := range c {
+		if v.ID == id {
+			return v
+		}
+	}
+
+	return nil
+
+}
@@ -0,0 +1,55 @@
+package data
+
+import (
+	"encoding/json"
+	"log"
+)
+
+// Contract - Deployed contract, along with its implementation, if it's a proxy, to be
+// delivered to client in this format
+//
+// Creator & creation tx are empty, when only upgrade of proxy got indexed, but not
+// its deployment, `upgradedAt` is number of block, where implementation was last seen
+type Contract struct {
+	Address         string `json:"address" gorm:"column:address"`
+	Creator         string `json:"creator" gorm:"column:creator"`
+	TransactionHash string `json:"txHash" gorm:"column:txhash"`
+	BlockHash       string `json:"blockHash" gorm:"column:blockhash"`
+	BlockNumber     uint64 `json:"blockNumber" gorm:"column:blocknumber"`
+	CodeHash        string `json:"codeHash" gorm:"column:codehash"`
+	ProxyType       string `json:"proxyType,omitempty" gorm:"column:proxytype"`
+	Implementation  string `json:"implementation,omitempty" gorm:"column:implementation"`
+	UpgradedAt      uint64 `json:"upgradedAt,omitempty" gorm:"column:upgradedat"`
+}
+
+// ToJSON - Encoding into JSON
+func (c *Contract) ToJSON() []byte {
+
+	data, err := json.Marshal(c)
+	if err != nil {
+		log.Printf("[!] Failed to encode contract to JSON : %s\n", err.Error())
+		return nil
+	}
+
+	return data
+
+}
+
+// Contracts - A set of contracts, extracted from DB query result, to be supplied
+// to client in JSON encoded form
+type Contracts struct {
+	Contracts []*Contract `json:"contracts"`
+}
+
+// ToJSON - Encoding into JSON, to be invoked when delivering query result to client
+func (c *Contracts) ToJSON() []byte {
+
+	data, err := json.Marshal(c)
+	if err != nil {
+		log.Printf("[!] Failed to encode contracts to JSON : %s\n", err.Error())
+		return nil
+	}
+
+	return data
+
+}
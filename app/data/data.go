@@ -1,14 +1,29 @@
 package data
 
 import (
+	"fmt"
 	"sync"
 	"time"
 
-	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/denniswon/validationcloud/app/chain"
 	"github.com/go-redis/redis/v8"
 	"gorm.io/gorm"
 )
 
+// How service is learning about new chain heads
+const (
+	ModeSubscribed = "subscribed"
+	ModePolling    = "polling"
+)
+
+// How final block is, as per node's block tags or configured
+// block confirmations
+const (
+	FinalityLatest    = "latest"
+	FinalitySafe      = "safe"
+	FinalityFinalized = "finalized"
+)
+
 // SyncState - Whether the service is synced with blockchain or not
 type SyncState struct {
 	Done                    uint64
@@ -16,12 +31,22 @@ type SyncState struct {
 	BlockCountAtStartUp     uint64
 	MaxBlockNumberAtStartUp uint64
 	NewBlocksInserted       uint64
+	BlocksRemoved           uint64
 	LatestBlockNumber       uint64
+	Mode                    string
+	PrunedBelow             uint64
+	BlocksPruned            uint64
+	SafeBlockNumber         uint64
+	FinalizedBlockNumber    uint64
 }
 
 // BlockCountInDB - Blocks currently present in database
 func (s *SyncState) BlockCountInDB() uint64 {
-	return s.BlockCountAtStartUp + s.NewBlocksInserted
+	if s.BlocksRemoved+s.BlocksPruned > s.BlockCountAtStartUp+s.NewBlocksInserted {
+		return 0
+	}
+
+	return s.BlockCountAtStartUp + s.NewBlocksInserted - s.BlocksRemoved - s.BlocksPruned
 }
 
 // StatusHolder - Keeps track of progress. To be delivered when `/v1/synced` is queried
@@ -61,6 +86,17 @@ func (s *StatusHolder) IncrementBlocksInserted() {
 
 }
 
+// AddBlocksRemoved - thread safe increments number of blocks removed from DB since start,
+// due to chain reorganization
+func (s *StatusHolder) AddBlocksRemoved(count uint64) {
+
+	s.Mutex.Lock()
+	defer s.Mutex.Unlock()
+
+	s.State.BlocksRemoved += count
+
+}
+
 // IncrementBlocksProcessed - thread safe increments number of blocks processed by after it started
 func (s *StatusHolder) IncrementBlocksProcessed() {
 
@@ -121,10 +157,148 @@ func (s *StatusHolder) SetLatestBlockNumber(num uint64) {
 
 }
 
+// GetMode - thread safe read of how new chain heads are being learnt about
+func (s *StatusHolder) GetMode() string {
+
+	s.Mutex.RLock()
+	defer s.Mutex.RUnlock()
+
+	return s.State.Mode
+
+}
+
+// SetMode - thread safe write of how new chain heads are being learnt about
+func (s *StatusHolder) SetMode(mode string) {
+
+	s.Mutex.Lock()
+	defer s.Mutex.Unlock()
+
+	s.State.Mode = mode
+
+}
+
+// PrunedBelow - thread safe read of block number, below which all blocks are
+// pruned, so those are not to be fetched again
+func (s *StatusHolder) PrunedBelow() uint64 {
+
+	s.Mutex.RLock()
+	defer s.Mutex.RUnlock()
+
+	return s.State.PrunedBelow
+
+}
+
+// SetPrunedBelow - thread safe write of block number, below which all blocks are
+// being pruned, it only moves forward
+func (s *StatusHolder) SetPrunedBelow(num uint64) {
+
+	s.Mutex.Lock()
+	defer s.Mutex.Unlock()
+
+	if num > s.State.PrunedBelow {
+		s.State.PrunedBelow = num
+	}
+
+}
+
+// AddBlocksPruned - thread safe increments number of blocks pruned from DB since start
+func (s *StatusHolder) AddBlocksPruned(count uint64) {
+
+	s.Mutex.Lock()
+	defer s.Mutex.Unlock()
+
+	s.State.BlocksPruned += count
+
+}
+
+// GetBlocksPruned - thread safe read of number of blocks pruned from DB since start
+func (s *StatusHolder) GetBlocksPruned() uint64 {
+
+	s.Mutex.RLock()
+	defer s.Mutex.RUnlock()
+
+	return s.State.BlocksPruned
+
+}
+
+// SetFinality - thread safe write of safe & finalized heads, none of them
+// moves backward
+func (s *StatusHolder) SetFinality(safe uint64, finalized uint64) {
+
+	s.Mutex.Lock()
+	defer s.Mutex.Unlock()
+
+	if safe > s.State.SafeBlockNumber {
+		s.State.SafeBlockNumber = safe
+	}
+
+	if finalized > s.State.FinalizedBlockNumber {
+		s.State.FinalizedBlockNumber = finalized
+	}
+
+}
+
+// SafeBlockNumber - thread safe read of safe head
+func (s *StatusHolder) SafeBlockNumber() uint64 {
+
+	s.Mutex.RLock()
+	defer s.Mutex.RUnlock()
+
+	return s.State.SafeBlockNumber
+
+}
+
+// FinalizedBlockNumber - thread safe read of finalized head
+func (s *StatusHolder) FinalizedBlockNumber() uint64 {
+
+	s.Mutex.RLock()
+	defer s.Mutex.RUnlock()
+
+	return s.State.FinalizedBlockNumber
+
+}
+
+// FinalityOf - thread safe read of finality status of block, as per
+// last known safe & finalized heads
+func (s *StatusHolder) FinalityOf(num uint64) string {
+
+	s.Mutex.RLock()
+	defer s.Mutex.RUnlock()
+
+	if num <= s.State.FinalizedBlockNumber {
+		return FinalityFinalized
+	}
+
+	if num <= s.State.SafeBlockNumber {
+		return FinalitySafe
+	}
+
+	return FinalityLatest
+
+}
+
 // RedisInfo
 type RedisInfo struct {
 	Client *redis.Client
-	BlockPublishTopic, TxPublishTopic, EventPublishTopic string
+	BlockPublishTopic, TxPublishTopic, EventPublishTopic, WithdrawalPublishTopic string
+}
+
+// TopicOf - Pubsub topic, where given kind of data i.e. {block, transaction, event, withdrawal},
+// of given chain gets published
+func TopicOf(chainID uint64, kind string) string {
+	return fmt.Sprintf("%d/%s", chainID, kind)
+}
+
+// NewRedisInfo - Redis client along with pubsub topics, scoped to given chain, so that
+// data of multiple chains, being indexed by same deployment, doesn't get mixed up
+func NewRedisInfo(client *redis.Client, chainID uint64) *RedisInfo {
+	return &RedisInfo{
+		Client:                 client,
+		BlockPublishTopic:      TopicOf(chainID, "block"),
+		TxPublishTopic:         TopicOf(chainID, "transaction"),
+		EventPublishTopic:      TopicOf(chainID, "event"),
+		WithdrawalPublishTopic: TopicOf(chainID, "withdrawal"),
+	}
 }
 
 // ResultStatus
@@ -142,18 +316,22 @@ func (r ResultStatus) Total() uint64 {
 
 // Job - For running a block fetching job
 type Job struct {
-	Client *ethclient.Client
-	DB     *gorm.DB
-	Redis  *RedisInfo
-	Block  uint64
-	Status *StatusHolder
+	Connection *BlockChainNodeConnection
+	DB         *gorm.DB
+	Redis      *RedisInfo
+	Block      uint64
+	Status     *StatusHolder
 }
 
 // BlockChainNodeConnection - Holds network connection object for blockchain nodes
 //
 // Use `RPC` i.e. HTTP based connection, for querying blockchain for data
 // Use `Websocket` for real-time listening of events in blockchain
+//
+// Chain ID tells which chain these nodes are part of, all data fetched
+// from them gets stamped with it
 type BlockChainNodeConnection struct {
-	RPC       *ethclient.Client
-	Websocket *ethclient.Client
+	ChainID   uint64
+	RPC       chain.ChainSource
+	Websocket chain.ChainSource
 }
@@ -18,6 +18,10 @@ type Event struct {
 	Data            []byte         `gorm:"column:data"`
 	TransactionHash string         `gorm:"column:txhash"`
 	BlockHash       string         `gorm:"column:blockhash"`
+	Chain           uint64         `gorm:"column:chain_id"`
+	Removed         bool           `gorm:"-"`
+	Decoded         *Decoded       `gorm:"-"`
+	Signature       *Signature     `gorm:"-"`
 }
 
 // MarshalBinary - Implementing binary marshalling function, to be invoked
@@ -34,13 +38,32 @@ func (e *Event) MarshalJSON() ([]byte, error) {
 		data = fmt.Sprintf("0x%s", _h)
 	}
 
-	return []byte(fmt.Sprintf(`{"origin":%q,"index":%d,"topics":%v,"data":%q,"txHash":%q,"blockHash":%q}`,
+	annotations := ""
+	if e.Decoded != nil {
+		_decoded, err := json.Marshal(e.Decoded)
+		if err != nil {
+			return nil, err
+		}
+
+		annotations = fmt.Sprintf(`,"decoded":%s`, _decoded)
+	}
+
+	if e.Signature != nil {
+		_signature, err := json.Marshal(e.Signature)
+		if err != nil {
+			return nil, err
+		}
+
+		annotations = fmt.Sprintf(`%s,"signature":%s`, annotations, _signature)
+	}
+
+	return []byte(fmt.Sprintf(`{"origin":%q,"index":%d,"topics":%v,"data":%q,"txHash":%q,"blockHash":%q,"chain":%d%s%s}`,
 		e.Origin,
 		e.Index,
 		strings.Join(
 			strings.Fields(
 				fmt.Sprintf("%q", e.Topics)), ","),
-		data, e.TransactionHash, e.BlockHash)), nil
+		data, e.TransactionHash, e.BlockHash, e.Chain, annotations, removedField(e.Removed))), nil
 
 }
 
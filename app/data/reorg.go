@@ -0,0 +1,37 @@
+package data
+
+import (
+	"encoding/json"
+	"log"
+
+	"github.com/lib/pq"
+)
+
+// Reorg - Chain reorganization related info to be delivered to client in this format
+type Reorg struct {
+	Number         uint64         `json:"number" gorm:"column:number"`
+	AncestorHash   string         `json:"ancestorHash" gorm:"column:ancestorhash"`
+	NewHead        string         `json:"newHead" gorm:"column:newhead"`
+	Depth          uint64         `json:"depth" gorm:"column:depth"`
+	OrphanedHashes pq.StringArray `json:"orphanedHashes" gorm:"column:orphanedhashes;type:text[]"`
+	DetectedAt     uint64         `json:"detectedAt" gorm:"column:detectedat"`
+}
+
+// Reorgs - A set of chain reorganizations, extracted from DB query result
+// also to be supplied to client in JSON encoded form
+type Reorgs struct {
+	Reorgs []*Reorg `json:"reorgs"`
+}
+
+// ToJSON - Encoding into JSON, to be invoked when delivering query result to client
+func (r *Reorgs) ToJSON() []byte {
+
+	data, err := json.Marshal(r)
+	if err != nil {
+		log.Printf("[!] Failed to encode reorg data to JSON : %s\n", err.Error())
+		return nil
+	}
+
+	return data
+
+}
@@ -0,0 +1,41 @@
+package data
+
+import (
+	"encoding/json"
+	"log"
+)
+
+// TokenTransfer - ERC-20/ 721/ 1155 token transfer, decoded from standard transfer
+// event, to be delivered to client in this format
+type TokenTransfer struct {
+	Token           string `json:"token" gorm:"column:token"`
+	Standard        string `json:"standard" gorm:"column:standard"`
+	Operator        string `json:"operator,omitempty" gorm:"column:operator"`
+	From            string `json:"from" gorm:"column:from"`
+	To              string `json:"to" gorm:"column:to"`
+	TokenID         string `json:"tokenId,omitempty" gorm:"column:tokenid"`
+	Amount          string `json:"amount" gorm:"column:amount"`
+	LogIndex        uint   `json:"logIndex" gorm:"column:logindex"`
+	BatchIndex      uint   `json:"batchIndex" gorm:"column:batchindex"`
+	TransactionHash string `json:"txHash" gorm:"column:txhash"`
+	BlockHash       string `json:"blockHash" gorm:"column:blockhash"`
+}
+
+// TokenTransfers - A set of token transfers, extracted from DB query result, to be supplied
+// to client in JSON encoded form
+type TokenTransfers struct {
+	TokenTransfers []*TokenTransfer `json:"transfers"`
+}
+
+// ToJSON - Encoding into JSON, to be invoked when delivering query result to client
+func (t *TokenTransfers) ToJSON() []byte {
+
+	data, err := json.Marshal(t)
+	if err != nil {
+		log.Printf("[!] Failed to encode token transfer data to JSON : %s\n", err.Error())
+		return nil
+	}
+
+	return data
+
+}
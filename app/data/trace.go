@@ -0,0 +1,91 @@
+package data
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/lib/pq"
+)
+
+// Trace - Single call made during tx execution, to be delivered to client in this format
+type Trace struct {
+	TransactionHash string        `json:"txHash" gorm:"column:txhash"`
+	TraceAddress    pq.Int64Array `json:"traceAddress" gorm:"column:traceaddress;type:integer[]"`
+	Type            string        `json:"type" gorm:"column:type"`
+	From            string        `json:"from" gorm:"column:from"`
+	To              string        `json:"to" gorm:"column:to"`
+	Value           string        `json:"value" gorm:"column:value"`
+	Input           []byte        `json:"input" gorm:"column:input"`
+	Output          []byte        `json:"output" gorm:"column:output"`
+	Error           string        `json:"error" gorm:"column:error"`
+	GasUsed         uint64        `json:"gasUsed" gorm:"column:gasused"`
+	BlockHash       string        `json:"blockHash" gorm:"column:blockhash"`
+}
+
+// MarshalJSON - Custom JSON encoder, putting input/ output as hex encoded strings
+func (t *Trace) MarshalJSON() ([]byte, error) {
+
+	traceAddress := t.TraceAddress
+	if traceAddress == nil {
+		traceAddress = pq.Int64Array{}
+	}
+
+	return json.Marshal(&struct {
+		TransactionHash string  `json:"txHash"`
+		TraceAddress    []int64 `json:"traceAddress"`
+		Type            string  `json:"type"`
+		From            string  `json:"from"`
+		To              string  `json:"to"`
+		Value           string  `json:"value"`
+		Input           string  `json:"input"`
+		Output          string  `json:"output"`
+		Error           string  `json:"error,omitempty"`
+		GasUsed         uint64  `json:"gasUsed"`
+		BlockHash       string  `json:"blockHash"`
+	}{
+		TransactionHash: t.TransactionHash,
+		TraceAddress:    traceAddress,
+		Type:            t.Type,
+		From:            t.From,
+		To:              t.To,
+		Value:           t.Value,
+		Input:           HexOf(t.Input),
+		Output:          HexOf(t.Output),
+		Error:           t.Error,
+		GasUsed:         t.GasUsed,
+		BlockHash:       t.BlockHash,
+	})
+
+}
+
+// HexOf - Hex encoded form of bytes, with `0x` prefix, empty string if nothing to encode
+func HexOf(data []byte) string {
+
+	if len(data) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("0x%s", hex.EncodeToString(data))
+
+}
+
+// Traces - A set of calls, extracted from DB query result, to be supplied
+// to client in JSON encoded form
+type Traces struct {
+	Traces []*Trace `json:"traces"`
+}
+
+// ToJSON - Encoding into JSON, to be invoked when delivering query result to client
+func (t *Traces) ToJSON() []byte {
+
+	data, err := json.Marshal(t)
+	if err != nil {
+		log.Printf("[!] Failed to encode trace data to JSON : %s\n", err.Error())
+		return nil
+	}
+
+	return data
+
+}
@@ -1,27 +1,44 @@
 package data
 
 import (
-	"encoding/hex"
 	"encoding/json"
-	"fmt"
 	"log"
 	"strings"
+
+	"github.com/lib/pq"
 )
 
 // Transaction - Transaction holder struct, to be supplied when queried using tx hash
 type Transaction struct {
-	Hash      string `json:"hash" gorm:"column:hash"`
-	From      string `json:"from" gorm:"column:from"`
-	To        string `json:"to" gorm:"column:to"`
-	Contract  string `json:"contract" gorm:"column:contract"`
-	Value     string `json:"value" gorm:"column:value"`
-	Data      []byte `json:"data" gorm:"column:data"`
-	Gas       uint64 `json:"gas" gorm:"column:gas"`
-	GasPrice  string `json:"gasPrice" gorm:"column:gasprice"`
-	Cost      string `json:"cost" gorm:"column:cost"`
-	Nonce     uint64 `json:"nonce" gorm:"column:nonce"`
-	State     uint64 `json:"state" gorm:"column:state"`
-	BlockHash string `json:"blockHash" gorm:"column:blockhash"`
+	Hash                 string         `json:"hash" gorm:"column:hash"`
+	From                 string         `json:"from" gorm:"column:from"`
+	To                   string         `json:"to" gorm:"column:to"`
+	Contract             string         `json:"contract" gorm:"column:contract"`
+	Value                string         `json:"value" gorm:"column:value"`
+	Data                 []byte         `json:"data" gorm:"column:data"`
+	Gas                  uint64         `json:"gas" gorm:"column:gas"`
+	GasPrice             string         `json:"gasPrice" gorm:"column:gasprice"`
+	Cost                 string         `json:"cost" gorm:"column:cost"`
+	Nonce                uint64         `json:"nonce" gorm:"column:nonce"`
+	State                uint64         `json:"state" gorm:"column:state"`
+	BlockHash            string         `json:"blockHash" gorm:"column:blockhash"`
+	Type                 uint8          `json:"type" gorm:"column:type"`
+	ChainID              string         `json:"chainId" gorm:"column:chainid"`
+	Chain                uint64         `json:"chain" gorm:"column:chain_id"`
+	MaxFeePerGas         string         `json:"maxFeePerGas" gorm:"column:maxfeepergas"`
+	MaxPriorityFeePerGas string         `json:"maxPriorityFeePerGas" gorm:"column:maxpriorityfeepergas"`
+	EffectiveGasPrice    string         `json:"effectiveGasPrice" gorm:"column:effectivegasprice"`
+	GasUsed              uint64         `json:"gasUsed" gorm:"column:gasused"`
+	CumulativeGasUsed    uint64         `json:"cumulativeGasUsed" gorm:"column:cumulativegasused"`
+	AccessList           []byte         `json:"accessList" gorm:"column:accesslist"`
+	MaxFeePerBlobGas     string         `json:"maxFeePerBlobGas" gorm:"column:maxfeeperblobgas"`
+	BlobGasUsed          uint64         `json:"blobGasUsed" gorm:"column:blobgasused"`
+	BlobGasPrice         string         `json:"blobGasPrice" gorm:"column:blobgasprice"`
+	BlobHashes           pq.StringArray `json:"blobHashes" gorm:"column:blobhashes;type:text[]"`
+	LogsBloom            []byte         `json:"logsBloom" gorm:"column:logsbloom"`
+	Removed              bool           `json:"removed" gorm:"-"`
+	Decoded              *Decoded       `json:"decoded" gorm:"-"`
+	Signature            *Signature     `json:"signature" gorm:"-"`
 }
 
 // MarshalBinary - Implementing binary marshalling function, to be invoked
@@ -31,24 +48,79 @@ func (t *Transaction) MarshalBinary() ([]byte, error) {
 }
 
 // MarshalJSON - Custom JSON encoder
+//
+// Normal tx carries `to`, contract creation tx carries `contract`, fields
+// not applicable to this tx type are omitted
 func (t *Transaction) MarshalJSON() ([]byte, error) {
 
-	data := ""
-	if _h := hex.EncodeToString(t.Data); _h != "" {
-		data = fmt.Sprintf("0x%s", _h)
-	}
+	to, contract := t.To, ""
 
-	// When tx doesn't create contract i.e. normal tx
-	if !strings.HasPrefix(t.Contract, "0x") {
-		return []byte(fmt.Sprintf(`{"hash":%q,"from":%q,"to":%q,"value":%q,"data":%q,"gas":%d,"gasPrice":%q,"cost":%q,"nonce":%d,"state":%d,"blockHash":%q}`,
-			t.Hash, t.From, t.To, t.Value,
-			data, t.Gas, t.GasPrice, t.Cost, t.Nonce, t.State, t.BlockHash)), nil
+	// When tx creates contract
+	if strings.HasPrefix(t.Contract, "0x") {
+		to, contract = "", t.Contract
 	}
 
-	// When tx creates contract
-	return []byte(fmt.Sprintf(`{"hash":%q,"from":%q,"contract":%q,"value":%q,"data":%q,"gas":%d,"gasPrice":%q,"cost":%q,"nonce":%d,"state":%d,"blockHash":%q}`,
-		t.Hash, t.From, t.Contract, t.Value,
-		data, t.Gas, t.GasPrice, t.Cost, t.Nonce, t.State, t.BlockHash)), nil
+	return json.Marshal(&struct {
+		Hash                 string          `json:"hash"`
+		From                 string          `json:"from"`
+		To                   string          `json:"to,omitempty"`
+		Contract             string          `json:"contract,omitempty"`
+		Value                string          `json:"value"`
+		Data                 string          `json:"data"`
+		Gas                  uint64          `json:"gas"`
+		GasPrice             string          `json:"gasPrice"`
+		Cost                 string          `json:"cost"`
+		Nonce                uint64          `json:"nonce"`
+		State                uint64          `json:"state"`
+		BlockHash            string          `json:"blockHash"`
+		Type                 uint8           `json:"type"`
+		ChainID              string          `json:"chainId,omitempty"`
+		Chain                uint64          `json:"chain"`
+		MaxFeePerGas         string          `json:"maxFeePerGas,omitempty"`
+		MaxPriorityFeePerGas string          `json:"maxPriorityFeePerGas,omitempty"`
+		EffectiveGasPrice    string          `json:"effectiveGasPrice"`
+		GasUsed              uint64          `json:"gasUsed"`
+		CumulativeGasUsed    uint64          `json:"cumulativeGasUsed"`
+		AccessList           json.RawMessage `json:"accessList,omitempty"`
+		MaxFeePerBlobGas     string          `json:"maxFeePerBlobGas,omitempty"`
+		BlobGasUsed          uint64          `json:"blobGasUsed,omitempty"`
+		BlobGasPrice         string          `json:"blobGasPrice,omitempty"`
+		BlobHashes           []string        `json:"blobHashes,omitempty"`
+		LogsBloom            string          `json:"logsBloom"`
+		Decoded              *Decoded        `json:"decoded,omitempty"`
+		Signature            *Signature      `json:"signature,omitempty"`
+		Removed              bool            `json:"removed,omitempty"`
+	}{
+		Hash:                 t.Hash,
+		From:                 t.From,
+		To:                   to,
+		Contract:             contract,
+		Value:                t.Value,
+		Data:                 HexOf(t.Data),
+		Gas:                  t.Gas,
+		GasPrice:             t.GasPrice,
+		Cost:                 t.Cost,
+		Nonce:                t.Nonce,
+		State:                t.State,
+		BlockHash:            t.BlockHash,
+		Type:                 t.Type,
+		ChainID:              t.ChainID,
+		Chain:                t.Chain,
+		MaxFeePerGas:         t.MaxFeePerGas,
+		MaxPriorityFeePerGas: t.MaxPriorityFeePerGas,
+		EffectiveGasPrice:    t.EffectiveGasPrice,
+		GasUsed:              t.GasUsed,
+		CumulativeGasUsed:    t.CumulativeGasUsed,
+		AccessList:           t.AccessList,
+		MaxFeePerBlobGas:     t.MaxFeePerBlobGas,
+		BlobGasUsed:          t.BlobGasUsed,
+		BlobGasPrice:         t.BlobGasPrice,
+		BlobHashes:           t.BlobHashes,
+		LogsBloom:            HexOf(t.LogsBloom),
+		Decoded:              t.Decoded,
+		Signature:            t.Signature,
+		Removed:              t.Removed,
+	})
 
 }
 
@@ -0,0 +1,57 @@
+package data
+
+import (
+	"encoding/json"
+	"log"
+)
+
+// Uncle - Uncle/ ommer block header, along with block including it, to be
+// delivered to client in this format
+type Uncle struct {
+	BlockHash  string `json:"blockHash" gorm:"column:blockhash"`
+	Position   uint64 `json:"position" gorm:"column:position"`
+	Hash       string `json:"hash" gorm:"column:hash"`
+	Number     uint64 `json:"number" gorm:"column:number"`
+	Time       uint64 `json:"time" gorm:"column:time"`
+	ParentHash string `json:"parentHash" gorm:"column:parenthash"`
+	Difficulty string `json:"difficulty" gorm:"column:difficulty"`
+	GasUsed    uint64 `json:"gasUsed" gorm:"column:gasused"`
+	GasLimit   uint64 `json:"gasLimit" gorm:"column:gaslimit"`
+	Nonce      string `json:"nonce" gorm:"column:nonce"`
+	Miner      string `json:"miner" gorm:"column:miner"`
+	ExtraData  []byte `json:"extraData" gorm:"column:extradata"`
+}
+
+// MarshalJSON - Custom JSON encoder, putting extra data as hex encoded string
+func (u *Uncle) MarshalJSON() ([]byte, error) {
+
+	type uncle Uncle
+
+	return json.Marshal(&struct {
+		*uncle
+		ExtraData string `json:"extraData"`
+	}{
+		uncle:     (*uncle)(u),
+		ExtraData: HexOf(u.ExtraData),
+	})
+
+}
+
+// Uncles - Uncles referenced by block, extracted from DB query result, to be
+// supplied to client in JSON encoded form
+type Uncles struct {
+	Uncles []*Uncle `json:"uncles"`
+}
+
+// ToJSON - Encoding into JSON, to be invoked when delivering query result to client
+func (u *Uncles) ToJSON() []byte {
+
+	data, err := json.Marshal(u)
+	if err != nil {
+		log.Printf("[!] Failed to encode uncle data to JSON : %s\n", err.Error())
+		return nil
+	}
+
+	return data
+
+}
@@ -0,0 +1,44 @@
+package data
+
+import (
+	"encoding/json"
+	"log"
+)
+
+// WatchedAddress - Address being watched, extracted from db
+type WatchedAddress struct {
+	Address string `json:"address" gorm:"column:address"`
+	Label   string `json:"label,omitempty" gorm:"column:label"`
+	AddedAt uint64 `json:"addedAt" gorm:"column:addedat"`
+}
+
+// ToJSON - Encoding into JSON
+func (w *WatchedAddress) ToJSON() []byte {
+
+	data, err := json.Marshal(w)
+	if err != nil {
+		log.Printf("[!] Failed to encode watched address to JSON : %s\n", err.Error())
+		return nil
+	}
+
+	return data
+
+}
+
+// Watchlist - All addresses being watched, to be delivered to client
+type Watchlist struct {
+	Addresses []*WatchedAddress `json:"addresses"`
+}
+
+// ToJSON - Encoding into JSON, to be invoked when delivering query result to client
+func (w *Watchlist) ToJSON() []byte {
+
+	data, err := json.Marshal(w)
+	if err != nil {
+		log.Printf("[!] Failed to encode watchlist to JSON : %s\n", err.Error())
+		return nil
+	}
+
+	return data
+
+}
@@ -0,0 +1,45 @@
+package data
+
+import (
+	"encoding/json"
+	"log"
+)
+
+// Withdrawal - Validator withdrawal credited to execution layer account, to be
+// delivered to client in this format
+//
+// Amount is in Gwei
+type Withdrawal struct {
+	Index          uint64 `json:"index" gorm:"column:index"`
+	ValidatorIndex uint64 `json:"validatorIndex" gorm:"column:validatorindex"`
+	Address        string `json:"address" gorm:"column:address"`
+	Amount         uint64 `json:"amount" gorm:"column:amount"`
+	BlockHash      string `json:"blockHash" gorm:"column:blockhash"`
+	Chain          uint64 `json:"chain" gorm:"column:chain_id"`
+	Removed        bool   `json:"removed,omitempty" gorm:"-"`
+}
+
+// MarshalBinary - Implementing binary marshalling function, to be invoked
+// by redis before publishing data on channel
+func (w *Withdrawal) MarshalBinary() ([]byte, error) {
+	return json.Marshal(w)
+}
+
+// Withdrawals - A set of withdrawals, extracted from DB query result, to be supplied
+// to client in JSON encoded form
+type Withdrawals struct {
+	Withdrawals []*Withdrawal `json:"withdrawals"`
+}
+
+// ToJSON - Encoding into JSON, to be invoked when delivering query result to client
+func (w *Withdrawals) ToJSON() []byte {
+
+	data, err := json.Marshal(w)
+	if err != nil {
+		log.Printf("[!] Failed to encode withdrawal data to JSON : %s\n", err.Error())
+		return nil
+	}
+
+	return data
+
+}
@@ -0,0 +1,46 @@
+package db
+
+import (
+	"errors"
+
+	"github.com/denniswon/validationcloud/app/data"
+	"github.com/ethereum/go-ethereum/common"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// UpsertABI - Persisting contract ABI, if one is already registered for
+// same contract, it's replaced
+func UpsertABI(db *gorm.DB, abi *ABIs) error {
+
+	if abi == nil {
+		return errors.New("empty ABI received while attempting to persist")
+	}
+
+	return db.Clauses(clause.OnConflict{UpdateAll: true}).Create(abi).Error
+
+}
+
+// DeleteABI - Removes ABI registered for contract, returns whether
+// anything got removed or not
+func DeleteABI(db *gorm.DB, address common.Address) (bool, error) {
+
+	result := db.Where("address = ?", address.Hex()).Delete(&ABIs{})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected != 0, nil
+
+}
+
+// GetABI - Given contract address, returns ABI registered for it
+func GetABI(db *gorm.DB, address common.Address) *data.ABI {
+	var abi data.ABI
+
+	if err := db.Model(&ABIs{}).Where("address = ?", address.Hex()).First(&abi).Error; err != nil {
+		return nil
+	}
+
+	return &abi
+}
@@ -15,24 +15,33 @@ import (
 // Also checks equality with existing data, if mismatch found,
 // updated with latest data
 //
+// If block hash itself has changed, it's chain reorganization, so persisted block
+// along with all of its descendants are rolled back & that reorg gets recorded,
+// rolled back blocks are returned, so that those can be retracted from pubsub topics
+//
 // Tries to wrap db modifications inside database transaction to
 // guarantee consistency, other read only operations being performed without
 // protection of db transaction
 //
 // 👆 gives us performance improvement, also taste of atomic db operation
 // i.e. either whole block data is written or nothing is written
-func StoreBlock(dbWOTx *gorm.DB, block *PackedBlock, status *d.StatusHolder, queue *q.BlockProcessorQueue) error {
+func StoreBlock(dbWOTx *gorm.DB, block *PackedBlock, status *d.StatusHolder, queue *q.BlockProcessorQueue) ([]*PackedBlock, error) {
 
 	if block == nil {
-		return errors.New("empty block received while attempting to persist")
+		return nil, errors.New("empty block received while attempting to persist")
 	}
 
+	// Block is already stamped with chain ID, it's fetched from
+	chainID := block.Block.Chain
+
+	var orphaned []*PackedBlock
+
 	// -- Starting DB transaction
-	return dbWOTx.Transaction(func(dbWTx *gorm.DB) error {
+	err := dbWOTx.Transaction(func(dbWTx *gorm.DB) error {
 
 		blockInserted := false
 
-		persistedBlock := GetBlock(dbWTx, block.Block.Number)
+		persistedBlock := GetBlock(dbWTx, chainID, block.Block.Number)
 		if persistedBlock == nil {
 
 			if err := PutBlock(dbWTx, block.Block); err != nil {
@@ -41,12 +50,49 @@ func StoreBlock(dbWOTx *gorm.DB, block *PackedBlock, status *d.StatusHolder, que
 
 			blockInserted = true
 
+		} else if persistedBlock.Hash != block.Block.Hash {
+
+			// Block at this height got replaced, so it & all its descendants
+			// present in DB, got orphaned due to chain reorganization
+			removed, err := RemoveBlocksInRange(dbWTx, chainID, block.Block.Number, GetCurrentBlockNumber(dbWTx, chainID), "")
+			if err != nil {
+				return err
+			}
+
+			orphaned = removed
+
+			if _, err := PutReorg(dbWTx, orphaned, block.Block.ParentHash, block.Block.Hash); err != nil {
+				return err
+			}
+
+			log.Printf("[!] Block %d replaced due to chain reorganization, orphaned %d block(s)\n", block.Block.Number, len(orphaned))
+
+			if status != nil {
+				status.AddBlocksRemoved(uint64(len(orphaned)))
+			}
+
+			// Descendants need to be processed again, as they're now
+			// gone from DB
+			if queue != nil {
+				for _, v := range orphaned {
+					if v.Block.Number > block.Block.Number {
+						queue.Reorged(v.Block.Number)
+					}
+				}
+			}
+
+			if err := PutBlock(dbWTx, block.Block); err != nil {
+				return err
+			}
+
+			blockInserted = true
+
 		} else if !persistedBlock.SimilarTo(block.Block) {
 
 			log.Printf("[!] Block %d already present in DB, similar ❌\n", block.Block.Number)
 
 			// cascaded deletion !
-			if err := DeleteBlock(dbWTx, block.Block.Number); err != nil {
+			if err := DeleteBlock(dbWTx, chainID, block.Block.Number); err != nil {
 				return err
 			}
 
@@ -63,6 +109,22 @@ func StoreBlock(dbWOTx *gorm.DB, block *PackedBlock, status *d.StatusHolder, que
 
 		}
 
+		for _, w := range block.Withdrawals {
+
+			if err := UpsertWithdrawal(dbWTx, w); err != nil {
+				return err
+			}
+
+		}
+
+		for _, u := range block.Uncles {
+
+			if err := UpsertUncle(dbWTx, u); err != nil {
+				return err
+			}
+
+		}
+
 		if block.Transactions == nil {
 
 			// During 👆 flow, if we've really inserted a new block into database,
@@ -89,6 +151,38 @@ func StoreBlock(dbWOTx *gorm.DB, block *PackedBlock, status *d.StatusHolder, que
 
 			}
 
+			for _, tr := range t.Traces {
+
+				if err := UpsertTrace(dbWTx, tr); err != nil {
+					return err
+				}
+
+			}
+
+			for _, tt := range t.TokenTransfers {
+
+				if err := UpsertTokenTransfer(dbWTx, tt); err != nil {
+					return err
+				}
+
+			}
+
+			for _, c := range t.Contracts {
+
+				if err := UpsertContract(dbWTx, c); err != nil {
+					return err
+				}
+
+			}
+
+			for _, c := range t.Upgrades {
+
+				if err := RefreshProxy(dbWTx, c); err != nil {
+					return err
+				}
+
+			}
+
 		}
 
 		// During 👆 flow, if we've really inserted a new block into database,
@@ -103,13 +197,19 @@ func StoreBlock(dbWOTx *gorm.DB, block *PackedBlock, status *d.StatusHolder, que
 	})
 	// -- Ending DB transaction
 
+	if err != nil {
+		return nil, err
+	}
+
+	return orphaned, nil
+
 }
 
-// GetBlock - Fetch block by number, from database
-func GetBlock(_db *gorm.DB, number uint64) *Blocks {
+// GetBlock - Fetch block of given chain by number, from database
+func GetBlock(_db *gorm.DB, chainID uint64, number uint64) *Blocks {
 	var block Blocks
 
-	if err := _db.Where("number = ?", number).First(&block).Error; err != nil {
+	if err := _db.Where("chain_id = ? and number = ?", chainID, number).First(&block).Error; err != nil {
 		return nil
 	}
 
@@ -123,30 +223,34 @@ func PutBlock(dbWTx *gorm.DB, block *Blocks) error {
 
 }
 
-// DeleteBlock - Delete block entry, identified by block number, while
+// DeleteBlock - Delete block entry, identified by chain ID & block number, while
 // cascading all dependent entries ( i.e. in transactions/ events table )
-func DeleteBlock(dbWTx *gorm.DB, number uint64) error {
-	return dbWTx.Where("number = ?", number).Delete(&Blocks{}).Error
+func DeleteBlock(dbWTx *gorm.DB, chainID uint64, number uint64) error {
+	return dbWTx.Where("chain_id = ? and number = ?", chainID, number).Delete(&Blocks{}).Error
 }
 
 // UpdateBlock - Updating already existing block
 func UpdateBlock(dbWTx *gorm.DB, block *Blocks) error {
 
-	return dbWTx.Model(&Blocks{}).Where("number = ?", block.Number).Updates(map[string]interface{}{
-		"hash":            block.Hash,
-		"time":            block.Time,
-		"parenthash":      block.ParentHash,
-		"difficulty":      block.Difficulty,
-		"gasused":         block.GasUsed,
-		"gaslimit":        block.GasLimit,
-		"nonce":           block.Nonce,
-		"miner":           block.Miner,
-		"size":            block.Size,
-		"stateroothash":   block.StateRootHash,
-		"unclehash":       block.UncleHash,
-		"txroothash":      block.TransactionRootHash,
-		"receiptroothash": block.ReceiptRootHash,
-		"extradata":       block.ExtraData,
+	return dbWTx.Model(&Blocks{}).Where("chain_id = ? and number = ?", block.Chain, block.Number).Updates(map[string]interface{}{
+		"hash":                block.Hash,
+		"time":                block.Time,
+		"parenthash":          block.ParentHash,
+		"difficulty":          block.Difficulty,
+		"gasused":             block.GasUsed,
+		"gaslimit":            block.GasLimit,
+		"nonce":               block.Nonce,
+		"miner":               block.Miner,
+		"size":                block.Size,
+		"stateroothash":       block.StateRootHash,
+		"unclehash":           block.UncleHash,
+		"txroothash":          block.TransactionRootHash,
+		"receiptroothash":     block.ReceiptRootHash,
+		"extradata":           block.ExtraData,
+		"basefee":             block.BaseFee,
+		"blobgasused":         block.BlobGasUsed,
+		"excessblobgas":       block.ExcessBlobGas,
+		"withdrawalsroothash": block.WithdrawalsRootHash,
 	}).Error
 
 }
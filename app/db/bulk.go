@@ -0,0 +1,520 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/stdlib"
+	"gorm.io/gorm"
+)
+
+// bulkTable - Table written by bulk writer, along with how rows to be
+// written into it, are collected from blocks
+type bulkTable struct {
+	model   interface{}
+	staging string
+	rows    func([]*PackedBlock) []interface{}
+	merge   func(table string, staging string, columns []string, primary []string) string
+}
+
+// BulkWrite - Writes many blocks, along with all data belonging to them, in single
+// DB transaction, by copying them into temporary staging tables using `COPY` &
+// merging those into actual tables, in one statement per table
+//
+// Unlike `StoreBlock`, blocks already present at same height are left untouched, so
+// that caller can fall back to per block path, which knows how to deal with chain
+// reorganization, for those. Returned are hashes of blocks, which are inserted now
+// & which are present in DB, after writing, latter including former
+func BulkWrite(_db *gorm.DB, blocks []*PackedBlock) (map[string]bool, map[string]bool, error) {
+
+	inserted := make(map[string]bool)
+	present := make(map[string]bool)
+
+	if len(blocks) == 0 {
+		return inserted, present, nil
+	}
+
+	for _, v := range blocks {
+		if v == nil {
+			return nil, nil, errors.New("empty block received while attempting to persist in bulk")
+		}
+	}
+
+	// Contracts deployed & upgraded in later blocks need to win
+	sorted := make([]*PackedBlock, len(blocks))
+	copy(sorted, blocks)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Block.Number < sorted[j].Block.Number
+	})
+
+	ctx := context.Background()
+
+	sqlDB, err := _db.DB()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer conn.Close()
+
+	err = conn.Raw(func(driverConn interface{}) error {
+
+		_conn, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return errors.New("bulk writing needs pgx backed connection")
+		}
+
+		tx, err := _conn.Conn().Begin(ctx)
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback(ctx)
+
+		for _, v := range bulkTables {
+
+			if err := bulkWriteTable(ctx, _db, tx, v, sorted, inserted); err != nil {
+				return err
+			}
+
+		}
+
+		hashes := make([]string, 0, len(sorted))
+		for _, v := range sorted {
+			hashes = append(hashes, v.Block.Hash)
+		}
+
+		rows, err := tx.Query(ctx, `select "hash" from "blocks" where "hash" = any($1)`, hashes)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+
+			var hash string
+			if err := rows.Scan(&hash); err != nil {
+				return err
+			}
+
+			present[strings.TrimSpace(hash)] = true
+
+		}
+
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		return tx.Commit(ctx)
+
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return inserted, present, nil
+
+}
+
+// bulkWriteTable - Copies rows into staging table, which gets dropped when DB
+// transaction ends, & merges them into actual table
+//
+// Hashes of newly inserted blocks are collected, when it's blocks table
+func bulkWriteTable(ctx context.Context, _db *gorm.DB, tx pgx.Tx, table *bulkTable, blocks []*PackedBlock, inserted map[string]bool) error {
+
+	rows := table.rows(blocks)
+	if len(rows) == 0 {
+		return nil
+	}
+
+	stmt := &gorm.Statement{DB: _db}
+	if err := stmt.Parse(table.model); err != nil {
+		return err
+	}
+
+	columns := stmt.Schema.DBNames
+
+	values := make([][]interface{}, 0, len(rows))
+	for _, v := range rows {
+
+		rv := reflect.Indirect(reflect.ValueOf(v))
+
+		value := make([]interface{}, 0, len(columns))
+		for _, c := range columns {
+			field, _ := stmt.Schema.FieldsByDBName[c].ValueOf(rv)
+			value = append(value, field)
+		}
+
+		values = append(values, value)
+
+	}
+
+	if _, err := tx.Exec(ctx, fmt.Sprintf(`create temp table %s (like %s including defaults) on commit drop`, quote(table.staging), quote(stmt.Schema.Table))); err != nil {
+		return fmt.Errorf("failed to create staging table %s : %s", table.staging, err.Error())
+	}
+
+	if _, err := tx.CopyFrom(ctx, pgx.Identifier{table.staging}, columns, pgx.CopyFromRows(values)); err != nil {
+		return fmt.Errorf("failed to copy into %s : %s", table.staging, err.Error())
+	}
+
+	merge := table.merge(stmt.Schema.Table, table.staging, columns, stmt.Schema.PrimaryFieldDBNames)
+
+	// Only blocks tell which of them got inserted
+	if table.staging != "bulk_blocks" {
+
+		if _, err := tx.Exec(ctx, merge); err != nil {
+			return fmt.Errorf("failed to merge into %s : %s", stmt.Schema.Table, err.Error())
+		}
+
+		return nil
+
+	}
+
+	hashes, err := tx.Query(ctx, merge)
+	if err != nil {
+		return fmt.Errorf("failed to merge into %s : %s", stmt.Schema.Table, err.Error())
+	}
+	defer hashes.Close()
+
+	for hashes.Next() {
+
+		var hash string
+		if err := hashes.Scan(&hash); err != nil {
+			return err
+		}
+
+		inserted[strings.TrimSpace(hash)] = true
+
+	}
+
+	return hashes.Err()
+
+}
+
+// bulkTables - Tables written by bulk writer, in order, so that rows referred
+// to by foreign keys, are written first
+var bulkTables = []*bulkTable{
+	{
+		model:   &Blocks{},
+		staging: "bulk_blocks",
+		rows: func(blocks []*PackedBlock) []interface{} {
+
+			rows := make([]interface{}, 0, len(blocks))
+			for _, v := range blocks {
+				rows = append(rows, v.Block)
+			}
+
+			return rows
+
+		},
+		// Some other block may already be present at same height
+		merge: func(table string, staging string, columns []string, primary []string) string {
+			return fmt.Sprintf(`insert into %s (%s) select %s from %s on conflict do nothing returning "hash"`, quote(table), quoteAll(columns), quoteAll(columns), quote(staging))
+		},
+	},
+	{
+		model:   &Transactions{},
+		staging: "bulk_transactions",
+		rows: func(blocks []*PackedBlock) []interface{} {
+
+			rows := make([]interface{}, 0)
+			for _, v := range blocks {
+				for _, t := range v.Transactions {
+					rows = append(rows, t.Tx)
+				}
+			}
+
+			return rows
+
+		},
+		merge: upsertAll,
+	},
+	{
+		model:   &Events{},
+		staging: "bulk_events",
+		rows: func(blocks []*PackedBlock) []interface{} {
+
+			rows := make([]interface{}, 0)
+			for _, v := range blocks {
+				for _, t := range v.Transactions {
+					for _, e := range t.Events {
+						rows = append(rows, e)
+					}
+				}
+			}
+
+			return rows
+
+		},
+		merge: upsertAll,
+	},
+	{
+		model:   &Traces{},
+		staging: "bulk_traces",
+		rows: func(blocks []*PackedBlock) []interface{} {
+
+			rows := make([]interface{}, 0)
+			for _, v := range blocks {
+				for _, t := range v.Transactions {
+					for _, tr := range t.Traces {
+						rows = append(rows, tr)
+					}
+				}
+			}
+
+			return rows
+
+		},
+		merge: upsertAll,
+	},
+	{
+		model:   &TokenTransfers{},
+		staging: "bulk_token_transfers",
+		rows: func(blocks []*PackedBlock) []interface{} {
+
+			rows := make([]interface{}, 0)
+			for _, v := range blocks {
+				for _, t := range v.Transactions {
+					for _, tt := range t.TokenTransfers {
+						rows = append(rows, tt)
+					}
+				}
+			}
+
+			return rows
+
+		},
+		merge: upsertAll,
+	},
+	{
+		model:   &Withdrawals{},
+		staging: "bulk_withdrawals",
+		rows: func(blocks []*PackedBlock) []interface{} {
+
+			rows := make([]interface{}, 0)
+			for _, v := range blocks {
+				for _, w := range v.Withdrawals {
+					rows = append(rows, w)
+				}
+			}
+
+			return rows
+
+		},
+		merge: upsertAll,
+	},
+	{
+		model:   &Uncles{},
+		staging: "bulk_uncles",
+		rows: func(blocks []*PackedBlock) []interface{} {
+
+			rows := make([]interface{}, 0)
+			for _, v := range blocks {
+				for _, u := range v.Uncles {
+					rows = append(rows, u)
+				}
+			}
+
+			return rows
+
+		},
+		merge: upsertAll,
+	},
+	{
+		model:   &Contracts{},
+		staging: "bulk_contracts",
+		rows: func(blocks []*PackedBlock) []interface{} {
+
+			deployed, _ := FoldContracts(blocks)
+
+			rows := make([]interface{}, 0, len(deployed))
+			for _, v := range deployed {
+				rows = append(rows, v)
+			}
+
+			return rows
+
+		},
+		merge: upsertAll,
+	},
+	{
+		model:   &Contracts{},
+		staging: "bulk_upgrades",
+		rows: func(blocks []*PackedBlock) []interface{} {
+
+			_, upgraded := FoldContracts(blocks)
+
+			rows := make([]interface{}, 0, len(upgraded))
+			for _, v := range upgraded {
+				rows = append(rows, v)
+			}
+
+			return rows
+
+		},
+		// Same as `RefreshProxy`, only implementation gets updated
+		merge: func(table string, staging string, columns []string, primary []string) string {
+			return mergeInto(table, staging, columns, primary, []string{"proxytype", "implementation", "upgradedat"})
+		},
+	},
+}
+
+// FoldContracts - Deployments & proxy upgrades found in blocks, ordered by block number,
+// folded into one entry per contract, so that each of them is written only once
+//
+// Upgrade of contract deployed in these blocks, gets applied on its deployment, rest of
+// upgrades are returned separately, as only implementation is to be updated for them
+func FoldContracts(blocks []*PackedBlock) ([]*Contracts, []*Contracts) {
+
+	deployed := make([]*Contracts, 0)
+	upgraded := make([]*Contracts, 0)
+
+	deployedAt := make(map[string]int)
+	upgradedAt := make(map[string]int)
+
+	for _, v := range blocks {
+
+		for _, t := range v.Transactions {
+
+			for _, c := range t.Contracts {
+
+				_c := *c
+
+				// Redeployed at same address, later one wins, along with
+				// upgrades following it
+				if idx, ok := deployedAt[c.Address]; ok {
+					deployed[idx] = &_c
+					continue
+				}
+
+				// Previously upgraded proxy, got destroyed & redeployed
+				if idx, ok := upgradedAt[c.Address]; ok {
+					upgraded[idx] = nil
+					delete(upgradedAt, c.Address)
+				}
+
+				deployedAt[c.Address] = len(deployed)
+				deployed = append(deployed, &_c)
+
+			}
+
+			for _, c := range t.Upgrades {
+
+				if idx, ok := deployedAt[c.Address]; ok {
+
+					deployed[idx].ProxyType = c.ProxyType
+					deployed[idx].Implementation = c.Implementation
+					deployed[idx].UpgradedAt = c.UpgradedAt
+					continue
+
+				}
+
+				_c := *c
+
+				if idx, ok := upgradedAt[c.Address]; ok {
+					upgraded[idx] = &_c
+					continue
+				}
+
+				upgradedAt[c.Address] = len(upgraded)
+				upgraded = append(upgraded, &_c)
+
+			}
+
+		}
+
+	}
+
+	_upgraded := make([]*Contracts, 0, len(upgraded))
+	for _, v := range upgraded {
+		if v != nil {
+			_upgraded = append(_upgraded, v)
+		}
+	}
+
+	return deployed, _upgraded
+
+}
+
+// upsertAll - Same as upsert with all columns getting updated on conflict, while
+// rows of blocks which couldn't be written are skipped
+func upsertAll(table string, staging string, columns []string, primary []string) string {
+
+	updated := make([]string, 0, len(columns))
+
+	for _, c := range columns {
+
+		if !contains(primary, c) {
+			updated = append(updated, c)
+		}
+
+	}
+
+	return mergeInto(table, staging, columns, primary, updated)
+
+}
+
+// mergeInto - Statement for inserting rows from staging table, whose block is
+// present in DB, into actual one, updating given columns on conflict
+//
+// Rows are inserted in order of primary key, so that concurrent writers
+// lock them in same order
+func mergeInto(table string, staging string, columns []string, primary []string, updated []string) string {
+
+	assignments := make([]string, 0, len(updated))
+	for _, c := range updated {
+		assignments = append(assignments, fmt.Sprintf("%s = excluded.%s", quote(c), quote(c)))
+	}
+
+	selected := make([]string, 0, len(columns))
+	for _, c := range columns {
+		selected = append(selected, "s."+quote(c))
+	}
+
+	ordered := make([]string, 0, len(primary))
+	for _, c := range primary {
+		ordered = append(ordered, "s."+quote(c))
+	}
+
+	return fmt.Sprintf(`insert into %s (%s) select %s from %s s where exists (select 1 from "blocks" b where b."hash" = s."blockhash") order by %s on conflict (%s) do update set %s`,
+		quote(table), quoteAll(columns), strings.Join(selected, ", "), quote(staging), strings.Join(ordered, ", "), quoteAll(primary), strings.Join(assignments, ", "))
+
+}
+
+// quote - Quoted identifier, as column names like `from` & `to` are keywords
+func quote(identifier string) string {
+	return pgx.Identifier{identifier}.Sanitize()
+}
+
+// quoteAll - Comma separated quoted identifiers
+func quoteAll(identifiers []string) string {
+
+	quoted := make([]string, 0, len(identifiers))
+	for _, v := range identifiers {
+		quoted = append(quoted, quote(v))
+	}
+
+	return strings.Join(quoted, ", ")
+
+}
+
+// contains - Whether given identifier is present in list
+func contains(identifiers []string, identifier string) bool {
+
+	for _, v := range identifiers {
+		if v == identifier {
+			return true
+		}
+	}
+
+	return false
+
+}
@@ -0,0 +1,77 @@
+package db
+
+import (
+	"errors"
+
+	"github.com/denniswon/validationcloud/app/data"
+	"github.com/ethereum/go-ethereum/common"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// UpsertContract - Persisting deployed contract, if it's already present i.e. redeployed
+// at same address, it's replaced with latest data
+func UpsertContract(dbWTx *gorm.DB, contract *Contracts) error {
+
+	if contract == nil {
+		return errors.New("empty contract received while attempting to persist")
+	}
+
+	return dbWTx.Clauses(clause.OnConflict{UpdateAll: true}).Create(contract).Error
+
+}
+
+// RefreshProxy - Updating implementation of upgraded proxy, if its deployment
+// isn't indexed, it's persisted without creator
+func RefreshProxy(dbWTx *gorm.DB, contract *Contracts) error {
+
+	if contract == nil {
+		return errors.New("empty proxy received while attempting to persist")
+	}
+
+	return dbWTx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "chain_id"}, {Name: "address"}},
+		DoUpdates: clause.AssignmentColumns([]string{"proxytype", "implementation", "upgradedat"}),
+	}).Create(contract).Error
+
+}
+
+// GetContract - Given contract address, returns contract along with its creator
+// & implementation, if it's a proxy
+func GetContract(db *gorm.DB, chainID uint64, address common.Address) *data.Contract {
+	var contract data.Contract
+
+	if err := db.Model(&Contracts{}).Where("chain_id = ? and address = ?", chainID, address.Hex()).First(&contract).Error; err != nil {
+		return nil
+	}
+
+	return &contract
+}
+
+// GetContractsByCreatorByBlockNumberRange - Given creator address & block number range,
+// returns all contracts deployed by it in that range
+func GetContractsByCreatorByBlockNumberRange(db *gorm.DB, chainID uint64, creator common.Address, from uint64, to uint64) *data.Contracts {
+	var contracts []*data.Contract
+
+	if err := db.Model(&Contracts{}).Where("chain_id = ? and creator = ? and blocknumber >= ? and blocknumber <= ?", chainID, creator.Hex(), from, to).Order("blocknumber asc").Find(&contracts).Error; err != nil {
+		return nil
+	}
+
+	return &data.Contracts{
+		Contracts: contracts,
+	}
+}
+
+// GetContractsByCreatorByBlockTimeRange - Given creator address & block time range,
+// returns all contracts deployed by it in that time span
+func GetContractsByCreatorByBlockTimeRange(db *gorm.DB, chainID uint64, creator common.Address, from uint64, to uint64) *data.Contracts {
+	var contracts []*data.Contract
+
+	if err := db.Model(&Contracts{}).Joins("left join blocks on contracts.blockhash = blocks.hash").Where("contracts.chain_id = ? and contracts.creator = ? and blocks.time >= ? and blocks.time <= ?", chainID, creator.Hex(), from, to).Select("contracts.*").Order("contracts.blocknumber asc").Find(&contracts).Error; err != nil {
+		return nil
+	}
+
+	return &data.Contracts{
+		Contracts: contracts,
+	}
+}
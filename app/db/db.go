@@ -12,17 +12,158 @@ import (
 
 // Connect - Connecting to postgresql database
 func Connect() *gorm.DB {
-	_db, err := gorm.Open(postgres.Open(fmt.Sprintf("postgresql://%s:%s@%s:%s/%s",
+	_db, err := Open(fmt.Sprintf("postgresql://%s:%s@%s:%s/%s",
 		cfg.Get("DB_USER"), cfg.Get("DB_PASSWORD"), cfg.Get("DB_HOST"),
-		cfg.Get("DB_PORT"), cfg.Get("DB_NAME"))),
+		cfg.Get("DB_PORT"), cfg.Get("DB_NAME")))
+	if err != nil {
+		log.Fatalf("[!] Failed to connect to db : %s\n", err.Error())
+	}
+
+	return _db
+}
+
+// Open - Connecting to postgresql database identified by given DSN,
+// while migrating schema to latest
+func Open(dsn string) (*gorm.DB, error) {
+	_db, err := gorm.Open(postgres.Open(dsn),
 		&gorm.Config{
 			Logger:                 logger.Default.LogMode(logger.Silent),
 			SkipDefaultTransaction: true, // all db writing to be wrapped inside transaction manually
 		})
 	if err != nil {
-		log.Fatalf("[!] Failed to connect to db : %s\n", err.Error())
+		return nil, err
 	}
 
-	_db.AutoMigrate(&Blocks{}, &Transactions{}, &Events{})
-	return _db
+	if err := upgradeToMultiChain(_db); err != nil {
+		return nil, err
+	}
+
+	if err := _db.AutoMigrate(&Blocks{}, &Transactions{}, &Events{}, &Traces{}, &Withdrawals{}, &Uncles{}, &TokenTransfers{}, &Contracts{}, &Reorgs{}, &Retractions{}, &ABIs{}, &Watchlist{}, &QueuedBlocks{}); err != nil {
+		return nil, err
+	}
+
+	if err := dropContractsCascade(_db); err != nil {
+		return nil, err
+	}
+
+	return _db, nil
+}
+
+// dropContractsCascade - Contracts used to be deleted along with block they were
+// deployed in, which made pruning lose them, so that constraint gets dropped
+// from databases created earlier
+func dropContractsCascade(_db *gorm.DB) error {
+
+	if !_db.Migrator().HasConstraint(&Contracts{}, "fk_blocks_contracts") {
+		return nil
+	}
+
+	log.Printf("[+] Dropping cascaded deletion of contracts along with blocks\n")
+
+	return _db.Migrator().DropConstraint(&Contracts{}, "fk_blocks_contracts")
+
+}
+
+// upgradeToMultiChain - Tables created before multiple chains could be indexed, don't have
+// `chain_id` column, so it's added to those having primary key, which is unique only with
+// in chain, & their primary key gets widened to include it
+//
+// Constraints which need to be scoped to chain are dropped, to be recreated by
+// auto migration, which also takes care of adding column to rest of the tables
+//
+// Rows already present get chain ID 0, until adopted by first configured chain
+func upgradeToMultiChain(_db *gorm.DB) error {
+
+	// Fresh database or already upgraded
+	if !_db.Migrator().HasTable(&Transactions{}) || _db.Migrator().HasColumn(&Transactions{}, "chain_id") {
+		return nil
+	}
+
+	log.Printf("[+] Upgrading database schema for indexing multiple chains\n")
+
+	return _db.Transaction(func(dbWTx *gorm.DB) error {
+
+		for _, stmt := range []string{
+			"alter table if exists events drop constraint if exists fk_transactions_events",
+			"alter table blocks drop constraint if exists blocks_number_key",
+			"alter table transactions add column chain_id bigint not null default 0",
+			"alter table transactions drop constraint transactions_pkey, add primary key (chain_id, hash)",
+			"alter table if exists traces add column if not exists chain_id bigint not null default 0",
+			"alter table if exists traces drop constraint if exists traces_pkey, add primary key (chain_id, txhash, traceaddress)",
+			"alter table if exists contracts add column if not exists chain_id bigint not null default 0",
+			"alter table if exists contracts drop constraint if exists contracts_pkey, add primary key (chain_id, address)",
+		} {
+
+			if err := dbWTx.Exec(stmt).Error; err != nil {
+				return err
+			}
+
+		}
+
+		return nil
+
+	})
+
+}
+
+// AdoptLegacyRows - Rows persisted before multiple chains could be indexed, carry
+// chain ID 0, those are considered to be belonging to given chain, which is
+// supposed to be the one, being indexed earlier
+//
+// Tx(s) are updated before event(s), so that updates get cascaded to event(s)
+//
+// Once adopted, nothing is done on subsequent invocations, without scanning tables
+func AdoptLegacyRows(_db *gorm.DB, chainID uint64) error {
+
+	pending, err := hasLegacyRows(_db)
+	if err != nil {
+		return err
+	}
+
+	if !pending {
+		return nil
+	}
+
+	log.Printf("[+] Adopting already indexed data into chain %d\n", chainID)
+
+	return _db.Transaction(func(dbWTx *gorm.DB) error {
+
+		for _, v := range []interface{}{&Blocks{}, &Transactions{}, &Events{}, &Traces{}, &Withdrawals{}, &Uncles{}, &TokenTransfers{}, &Contracts{}, &Reorgs{}} {
+
+			if err := dbWTx.Model(v).Where("chain_id = 0").Update("chain_id", chainID).Error; err != nil {
+				return err
+			}
+
+		}
+
+		return nil
+
+	})
+
+}
+
+// hasLegacyRows - Checks whether rows persisted before multiple chains could be indexed, are
+// yet to be adopted, looking only at tables having index leading on `chain_id`, so that
+// it's cheap to check
+//
+// Rows of rest of the tables always belong to some block & all of them get adopted
+// in same DB transaction, so those can't be left behind on their own
+func hasLegacyRows(_db *gorm.DB) (bool, error) {
+
+	for _, v := range []interface{}{&Blocks{}, &Transactions{}, &Traces{}, &Contracts{}, &Reorgs{}} {
+
+		var found []uint64
+
+		if err := _db.Model(v).Where("chain_id = 0").Limit(1).Pluck("chain_id", &found).Error; err != nil {
+			return false, err
+		}
+
+		if len(found) != 0 {
+			return true, nil
+		}
+
+	}
+
+	return false, nil
+
 }
@@ -0,0 +1,49 @@
+package db
+
+import (
+	"gorm.io/gorm"
+)
+
+// GetStoredBlocksInRange - Blocks in given range, both inclusive, as they're
+// stored, in ascending order of block number
+func GetStoredBlocksInRange(_db *gorm.DB, chainID uint64, from uint64, to uint64) ([]*Blocks, error) {
+
+	var blocks []*Blocks
+
+	if err := _db.Where("chain_id = ? and number >= ? and number <= ?", chainID, from, to).Order("number asc").Find(&blocks).Error; err != nil {
+		return nil, err
+	}
+
+	return blocks, nil
+
+}
+
+// GetStoredBlockContent - Tx(s) of block, in order they're packed in it, along
+// with events emitted, in order they're logged, as they're stored
+func GetStoredBlockContent(_db *gorm.DB, chainID uint64, blockHash string) ([]*Transactions, []*Events, error) {
+
+	var txs []*Transactions
+
+	if err := _db.Where("chain_id = ? and blockhash = ?", chainID, blockHash).Order(`"index" asc`).Find(&txs).Error; err != nil {
+		return nil, nil, err
+	}
+
+	var events []*Events
+
+	if err := _db.Where("chain_id = ? and blockhash = ?", chainID, blockHash).Order(`"index" asc`).Find(&events).Error; err != nil {
+		return nil, nil, err
+	}
+
+	return txs, events, nil
+
+}
+
+// RemoveBlock - Deletes block, along with all data belonging to it, so that
+// it gets written afresh, when it's processed next time
+func RemoveBlock(_db *gorm.DB, chainID uint64, number uint64) error {
+
+	return _db.Transaction(func(dbWTx *gorm.DB) error {
+		return DeleteBlock(dbWTx, chainID, number)
+	})
+
+}
@@ -12,24 +12,36 @@ type Tabler interface {
 }
 
 // Blocks - Mined block info holder table model
+//
+// Each chain has only one block at some height, while block hash is
+// unique across chains
 type Blocks struct {
-	Hash                string       `gorm:"column:hash;type:char(66);primaryKey"`
-	Number              uint64       `gorm:"column:number;type:bigint;not null;unique;index:,sort:asc"`
-	Time                uint64       `gorm:"column:time;type:bigint;not null;index:,sort:asc"`
-	ParentHash          string       `gorm:"column:parenthash;type:char(66);not null"`
-	Difficulty          string       `gorm:"column:difficulty;type:varchar;not null"`
-	GasUsed             uint64       `gorm:"column:gasused;type:bigint;not null"`
-	GasLimit            uint64       `gorm:"column:gaslimit;type:bigint;not null"`
-	Nonce               string       `gorm:"column:nonce;type:varchar;not null"`
-	Miner               string       `gorm:"column:miner;type:char(42);not null"`
-	Size                float64      `gorm:"column:size;type:float(8);not null"`
-	StateRootHash       string       `gorm:"column:stateroothash;type:char(66);not null"`
-	UncleHash           string       `gorm:"column:unclehash;type:char(66);not null"`
-	TransactionRootHash string       `gorm:"column:txroothash;type:char(66);not null"`
-	ReceiptRootHash     string       `gorm:"column:receiptroothash;type:char(66);not null"`
-	ExtraData           []byte       `gorm:"column:extradata;type:bytea"`
-	Transactions        Transactions `gorm:"foreignKey:blockhash;constraint:OnDelete:CASCADE;"`
-	Events              Events       `gorm:"foreignKey:blockhash;constraint:OnDelete:CASCADE;"`
+	Chain               uint64         `gorm:"column:chain_id;type:bigint;not null;default:0;uniqueIndex:idx_blocks_chain_number,priority:1"`
+	Hash                string         `gorm:"column:hash;type:char(66);primaryKey"`
+	Number              uint64         `gorm:"column:number;type:bigint;not null;uniqueIndex:idx_blocks_chain_number,priority:2;index:,sort:asc"`
+	Time                uint64         `gorm:"column:time;type:bigint;not null;index:,sort:asc"`
+	ParentHash          string         `gorm:"column:parenthash;type:char(66);not null"`
+	Difficulty          string         `gorm:"column:difficulty;type:varchar;not null"`
+	GasUsed             uint64         `gorm:"column:gasused;type:bigint;not null"`
+	GasLimit            uint64         `gorm:"column:gaslimit;type:bigint;not null"`
+	Nonce               string         `gorm:"column:nonce;type:varchar;not null"`
+	Miner               string         `gorm:"column:miner;type:char(42);not null"`
+	Size                float64        `gorm:"column:size;type:float(8);not null"`
+	StateRootHash       string         `gorm:"column:stateroothash;type:char(66);not null"`
+	UncleHash           string         `gorm:"column:unclehash;type:char(66);not null"`
+	TransactionRootHash string         `gorm:"column:txroothash;type:char(66);not null"`
+	ReceiptRootHash     string         `gorm:"column:receiptroothash;type:char(66);not null"`
+	ExtraData           []byte         `gorm:"column:extradata;type:bytea"`
+	BaseFee             string         `gorm:"column:basefee;type:varchar;not null;default:''"`
+	BlobGasUsed         uint64         `gorm:"column:blobgasused;type:bigint;not null;default:0"`
+	ExcessBlobGas       uint64         `gorm:"column:excessblobgas;type:bigint;not null;default:0"`
+	WithdrawalsRootHash string         `gorm:"column:withdrawalsroothash;type:varchar;not null;default:''"`
+	Transactions        Transactions   `gorm:"foreignKey:blockhash;constraint:OnDelete:CASCADE;"`
+	Events              Events         `gorm:"foreignKey:blockhash;constraint:OnDelete:CASCADE;"`
+	Traces              Traces         `gorm:"foreignKey:blockhash;constraint:OnDelete:CASCADE;"`
+	Withdrawals         Withdrawals    `gorm:"foreignKey:blockhash;constraint:OnDelete:CASCADE;"`
+	Uncles              Uncles         `gorm:"foreignKey:blockhash;constraint:OnDelete:CASCADE;"`
+	TokenTransfers      TokenTransfers `gorm:"foreignKey:blockhash;constraint:OnDelete:CASCADE;"`
 }
 
 // TableName - Overriding default table name
@@ -53,24 +65,57 @@ func (b *Blocks) SimilarTo(_b *Blocks) bool {
 		b.UncleHash == _b.UncleHash &&
 		b.TransactionRootHash == _b.TransactionRootHash &&
 		b.ReceiptRootHash == _b.ReceiptRootHash &&
-		bytes.Equal(b.ExtraData, _b.ExtraData)
+		bytes.Equal(b.ExtraData, _b.ExtraData) &&
+		b.BaseFee == _b.BaseFee &&
+		b.BlobGasUsed == _b.BlobGasUsed &&
+		b.ExcessBlobGas == _b.ExcessBlobGas &&
+		b.WithdrawalsRootHash == _b.WithdrawalsRootHash
 }
 
 // Transactions - Blockchain transaction holder table model
+//
+// Fee market & blob related fields are left empty for tx types
+// not carrying them, cost is what sender actually paid i.e. value along with
+// execution & blob gas fees
+//
+// Signature, position in block & post state root of receipt, if any, are kept so
+// that both tx & receipt tries of block can be rebuilt, for verifying integrity
+//
+// Tx hash is unique only with in chain, because legacy tx(s), not bound to
+// any chain, can be replayed on others
 type Transactions struct {
-	Hash      string `gorm:"column:hash;type:char(66);primaryKey"`
-	From      string `gorm:"column:from;type:char(42);not null;index"`
-	To        string `gorm:"column:to;type:char(42);index"`
-	Contract  string `gorm:"column:contract;type:char(42);index"`
-	Value     string `gorm:"column:value;type:varchar"`
-	Data      []byte `gorm:"column:data;type:bytea"`
-	Gas       uint64 `gorm:"column:gas;type:bigint;not null"`
-	GasPrice  string `gorm:"column:gasprice;type:varchar;not null"`
-	Cost      string `gorm:"column:cost;type:varchar;not null"`
-	Nonce     uint64 `gorm:"column:nonce;type:bigint;not null;index"`
-	State     uint64 `gorm:"column:state;type:smallint;not null"`
-	BlockHash string `gorm:"column:blockhash;type:char(66);not null;index"`
-	Events    Events `gorm:"foreignKey:txhash;constraint:OnDelete:CASCADE;"`
+	Chain                uint64         `gorm:"column:chain_id;type:bigint;not null;default:0;primaryKey"`
+	Hash                 string         `gorm:"column:hash;type:char(66);primaryKey"`
+	From                 string         `gorm:"column:from;type:char(42);not null;index"`
+	To                   string         `gorm:"column:to;type:char(42);index"`
+	Contract             string         `gorm:"column:contract;type:char(42);index"`
+	Value                string         `gorm:"column:value;type:varchar"`
+	Data                 []byte         `gorm:"column:data;type:bytea"`
+	Gas                  uint64         `gorm:"column:gas;type:bigint;not null"`
+	GasPrice             string         `gorm:"column:gasprice;type:varchar;not null"`
+	Cost                 string         `gorm:"column:cost;type:varchar;not null"`
+	Nonce                uint64         `gorm:"column:nonce;type:bigint;not null;index"`
+	State                uint64         `gorm:"column:state;type:smallint;not null"`
+	BlockHash            string         `gorm:"column:blockhash;type:char(66);not null;index"`
+	Type                 uint8          `gorm:"column:type;type:smallint;not null;default:0;index"`
+	ChainID              string         `gorm:"column:chainid;type:varchar;not null;default:''"`
+	MaxFeePerGas         string         `gorm:"column:maxfeepergas;type:varchar;not null;default:''"`
+	MaxPriorityFeePerGas string         `gorm:"column:maxpriorityfeepergas;type:varchar;not null;default:''"`
+	EffectiveGasPrice    string         `gorm:"column:effectivegasprice;type:varchar;not null;default:''"`
+	GasUsed              uint64         `gorm:"column:gasused;type:bigint;not null;default:0"`
+	CumulativeGasUsed    uint64         `gorm:"column:cumulativegasused;type:bigint;not null;default:0"`
+	AccessList           []byte         `gorm:"column:accesslist;type:jsonb"`
+	MaxFeePerBlobGas     string         `gorm:"column:maxfeeperblobgas;type:varchar;not null;default:''"`
+	BlobGasUsed          uint64         `gorm:"column:blobgasused;type:bigint;not null;default:0"`
+	BlobGasPrice         string         `gorm:"column:blobgasprice;type:varchar;not null;default:''"`
+	BlobHashes           pq.StringArray `gorm:"column:blobhashes;type:text[]"`
+	LogsBloom            []byte         `gorm:"column:logsbloom;type:bytea"`
+	PostState            []byte         `gorm:"column:poststate;type:bytea"`
+	Index                uint           `gorm:"column:index;type:integer;not null;default:0"`
+	V                    string         `gorm:"column:v;type:varchar;not null;default:''"`
+	R                    string         `gorm:"column:r;type:varchar;not null;default:''"`
+	S                    string         `gorm:"column:s;type:varchar;not null;default:''"`
+	Events               Events         `gorm:"foreignKey:Chain,TransactionHash;references:Chain,Hash;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
 }
 
 // TableName - Overriding default table name
@@ -80,6 +125,7 @@ func (Transactions) TableName() string {
 
 // Events - Events emitted from smart contracts to be held in this table
 type Events struct {
+	Chain           uint64         `gorm:"column:chain_id;type:bigint;not null;default:0"`
 	BlockHash       string         `gorm:"column:blockhash;type:char(66);not null;primaryKey"`
 	Index           uint           `gorm:"column:index;type:integer;not null;primaryKey"`
 	Origin          string         `gorm:"column:origin;type:char(42);not null;index"`
@@ -93,11 +139,216 @@ func (Events) TableName() string {
 	return "events"
 }
 
+// Traces - Calls made during tx execution, flattened out from call tree, to be held in this table
+//
+// Trace address is path of call in tree, top level call has empty one
+type Traces struct {
+	Chain           uint64        `gorm:"column:chain_id;type:bigint;not null;default:0;primaryKey"`
+	TransactionHash string        `gorm:"column:txhash;type:char(66);not null;primaryKey"`
+	TraceAddress    pq.Int64Array `gorm:"column:traceaddress;type:integer[];not null;primaryKey"`
+	Type            string        `gorm:"column:type;type:varchar;not null"`
+	From            string        `gorm:"column:from;type:char(42);not null;index"`
+	To              string        `gorm:"column:to;type:char(42);index"`
+	Value           string        `gorm:"column:value;type:varchar"`
+	Input           []byte        `gorm:"column:input;type:bytea"`
+	Output          []byte        `gorm:"column:output;type:bytea"`
+	Error           string        `gorm:"column:error;type:varchar"`
+	GasUsed         uint64        `gorm:"column:gasused;type:bigint;not null"`
+	BlockHash       string        `gorm:"column:blockhash;type:char(66);not null;index"`
+}
+
+// TableName - Overriding default table name
+func (Traces) TableName() string {
+	return "traces"
+}
+
+// TokenTransfers - ERC-20/ 721/ 1155 token transfers, decoded from standard transfer
+// events, to be held in this table
+//
+// Each id transferred using ERC-1155 `TransferBatch`, is held in its own row, identified
+// by its position in batch, for all others batch index is 0
+type TokenTransfers struct {
+	Chain           uint64 `gorm:"column:chain_id;type:bigint;not null;default:0"`
+	BlockHash       string `gorm:"column:blockhash;type:char(66);not null;primaryKey"`
+	LogIndex        uint   `gorm:"column:logindex;type:integer;not null;primaryKey"`
+	BatchIndex      uint   `gorm:"column:batchindex;type:integer;not null;primaryKey"`
+	Token           string `gorm:"column:token;type:char(42);not null;index"`
+	Standard        string `gorm:"column:standard;type:varchar;not null;index"`
+	Operator        string `gorm:"column:operator;type:char(42)"`
+	From            string `gorm:"column:from;type:char(42);not null;index"`
+	To              string `gorm:"column:to;type:char(42);not null;index"`
+	TokenID         string `gorm:"column:tokenid;type:varchar"`
+	Amount          string `gorm:"column:amount;type:varchar;not null"`
+	TransactionHash string `gorm:"column:txhash;type:char(66);not null;index"`
+}
+
+// TableName - Overriding default table name
+func (TokenTransfers) TableName() string {
+	return "token_transfers"
+}
+
+// Withdrawals - Validator withdrawals from beacon chain, credited to execution
+// layer accounts in post-Shanghai blocks, to be held in this table
+//
+// Amount is in Gwei, as it's on beacon chain
+type Withdrawals struct {
+	Chain          uint64 `gorm:"column:chain_id;type:bigint;not null;default:0"`
+	BlockHash      string `gorm:"column:blockhash;type:char(66);not null;primaryKey"`
+	Index          uint64 `gorm:"column:index;type:bigint;not null;primaryKey"`
+	ValidatorIndex uint64 `gorm:"column:validatorindex;type:bigint;not null;index"`
+	Address        string `gorm:"column:address;type:char(42);not null;index"`
+	Amount         uint64 `gorm:"column:amount;type:bigint;not null"`
+}
+
+// TableName - Overriding default table name
+func (Withdrawals) TableName() string {
+	return "withdrawals"
+}
+
+// Uncles - Headers of uncle/ ommer blocks, referenced by including block, to be held in this table
+//
+// Position is index of uncle in including block's uncle list
+type Uncles struct {
+	Chain      uint64 `gorm:"column:chain_id;type:bigint;not null;default:0"`
+	BlockHash  string `gorm:"column:blockhash;type:char(66);not null;primaryKey"`
+	Position   uint64 `gorm:"column:position;type:smallint;not null;primaryKey"`
+	Hash       string `gorm:"column:hash;type:char(66);not null;index"`
+	Number     uint64 `gorm:"column:number;type:bigint;not null;index:,sort:asc"`
+	Time       uint64 `gorm:"column:time;type:bigint;not null"`
+	ParentHash string `gorm:"column:parenthash;type:char(66);not null"`
+	Difficulty string `gorm:"column:difficulty;type:varchar;not null"`
+	GasUsed    uint64 `gorm:"column:gasused;type:bigint;not null"`
+	GasLimit   uint64 `gorm:"column:gaslimit;type:bigint;not null"`
+	Nonce      string `gorm:"column:nonce;type:varchar;not null"`
+	Miner      string `gorm:"column:miner;type:char(42);not null;index"`
+	ExtraData  []byte `gorm:"column:extradata;type:bytea"`
+}
+
+// TableName - Overriding default table name
+func (Uncles) TableName() string {
+	return "uncles"
+}
+
+// Contracts - Contracts deployed either by tx or by another contract, to be held in this table,
+// along with implementation contract, if it's a proxy
+//
+// Proxies which got upgraded, without their deployment being indexed, are also held here,
+// without creator & creation tx
+//
+// Not cascaded from blocks, so that contracts outlive pruning of blocks they were
+// deployed in, those are only deleted when their block gets rolled back
+type Contracts struct {
+	Chain           uint64 `gorm:"column:chain_id;type:bigint;not null;default:0;primaryKey"`
+	Address         string `gorm:"column:address;type:char(42);primaryKey"`
+	Creator         string `gorm:"column:creator;type:varchar;not null;default:'';index"`
+	TransactionHash string `gorm:"column:txhash;type:varchar;not null;default:''"`
+	BlockHash       string `gorm:"column:blockhash;type:char(66);not null;index"`
+	BlockNumber     uint64 `gorm:"column:blocknumber;type:bigint;not null;index:,sort:asc"`
+	CodeHash        string `gorm:"column:codehash;type:char(66);not null"`
+	ProxyType       string `gorm:"column:proxytype;type:varchar;not null;default:''"`
+	Implementation  string `gorm:"column:implementation;type:varchar;not null;default:''"`
+	UpgradedAt      uint64 `gorm:"column:upgradedat;type:bigint;not null;default:0"`
+}
+
+// TableName - Overriding default table name
+func (Contracts) TableName() string {
+	return "contracts"
+}
+
+// Reorgs - Chain reorganizations detected by the service, to be held in this table,
+// so that it can be found out later which blocks got orphaned & replaced
+type Reorgs struct {
+	ID             uint64         `gorm:"column:id;type:bigserial;primaryKey"`
+	Chain          uint64         `gorm:"column:chain_id;type:bigint;not null;default:0;index"`
+	Number         uint64         `gorm:"column:number;type:bigint;not null;index:,sort:asc"`
+	AncestorHash   string         `gorm:"column:ancestorhash;type:char(66);not null"`
+	NewHead        string         `gorm:"column:newhead;type:char(66);not null"`
+	Depth          uint64         `gorm:"column:depth;type:bigint;not null"`
+	OrphanedHashes pq.StringArray `gorm:"column:orphanedhashes;type:text[];not null"`
+	DetectedAt     uint64         `gorm:"column:detectedat;type:bigint;not null"`
+}
+
+// TableName - Overriding default table name
+func (Reorgs) TableName() string {
+	return "reorgs"
+}
+
+// Retractions - Blocks orphaned due to chain reorganization, along with all data belonging to
+// them, which are yet to be retracted from pubsub topics, held until retraction gets published,
+// so that it's not lost, even if service goes down meanwhile
+//
+// Same block orphaned more than once, is held only once
+type Retractions struct {
+	Chain  uint64 `gorm:"column:chain_id;type:bigint;primaryKey"`
+	Hash   string `gorm:"column:hash;type:char(66);primaryKey"`
+	Number uint64 `gorm:"column:number;type:bigint;not null"`
+	Block  []byte `gorm:"column:block;type:jsonb;not null"`
+}
+
+// TableName - Overriding default table name
+func (Retractions) TableName() string {
+	return "retractions"
+}
+
+// ABIs - Contract ABI(s) registered via admin API, used for decoding
+// calldata of tx(s) sent to contract & event logs emitted by it
+//
+// Shared by all chains being indexed, as same contract is
+// usually deployed at same address on each of them
+type ABIs struct {
+	Address   string `gorm:"column:address;type:char(42);primaryKey"`
+	ABI       []byte `gorm:"column:abi;type:jsonb;not null"`
+	UpdatedAt uint64 `gorm:"column:updatedat;type:bigint;not null"`
+}
+
+// TableName - Overriding default table name
+func (ABIs) TableName() string {
+	return "abis"
+}
+
+// Watchlist - Addresses being watched, when service runs in watchlist mode, only
+// tx(s) & event(s) touching them get indexed, on all chains being indexed
+type Watchlist struct {
+	Address string `gorm:"column:address;type:char(42);primaryKey"`
+	Label   string `gorm:"column:label;type:varchar;not null;default:''"`
+	AddedAt uint64 `gorm:"column:addedat;type:bigint;not null"`
+}
+
+// TableName - Overriding default table name
+func (Watchlist) TableName() string {
+	return "watchlist"
+}
+
+// QueuedBlocks - State of blocks living in block processor queue, persisted so that
+// blocks waiting for confirmation, along with their retry history, survive restarts
+type QueuedBlocks struct {
+	Chain           uint64 `gorm:"column:chain_id;type:bigint;primaryKey"`
+	Number          uint64 `gorm:"column:number;type:bigint;primaryKey"`
+	Published       bool   `gorm:"column:published;type:boolean;not null"`
+	UnconfirmedDone bool   `gorm:"column:unconfirmeddone;type:boolean;not null"`
+	ConfirmedDone   bool   `gorm:"column:confirmeddone;type:boolean;not null"`
+	Attempts        uint64 `gorm:"column:attempts;type:bigint;not null"`
+	LastAttempted   int64  `gorm:"column:lastattempted;type:bigint;not null"`
+	Delay           uint64 `gorm:"column:delay;type:bigint;not null"`
+	DeadLettered    bool   `gorm:"column:deadlettered;type:boolean;not null;default:false;index"`
+	Discarded       bool   `gorm:"column:discarded;type:boolean;not null;default:false"`
+	LastError       string `gorm:"column:lasterror;type:text;not null;default:''"`
+}
+
+// TableName - Overriding default table name
+func (QueuedBlocks) TableName() string {
+	return "queued_blocks"
+}
+
 // PackedTransaction - All data that is stored in a tx, to be passed from
 // tx data fetcher to whole block data persist handler function
 type PackedTransaction struct {
-	Tx     *Transactions
-	Events []*Events
+	Tx             *Transactions
+	Events         []*Events
+	Traces         []*Traces
+	TokenTransfers []*TokenTransfers
+	Contracts      []*Contracts
+	Upgrades       []*Contracts
 }
 
 // PackedBlock - Whole block data to be persisted in a single
@@ -106,4 +357,48 @@ type PackedTransaction struct {
 type PackedBlock struct {
 	Block        *Blocks
 	Transactions []*PackedTransaction
+	Withdrawals  []*Withdrawals
+	Uncles       []*Uncles
+}
+
+// OnChain - Stamping whole block data with chain ID, it's fetched from
+// before it gets published & persisted
+func (p *PackedBlock) OnChain(chainID uint64) {
+
+	p.Block.Chain = chainID
+
+	for _, v := range p.Withdrawals {
+		v.Chain = chainID
+	}
+
+	for _, v := range p.Uncles {
+		v.Chain = chainID
+	}
+
+	for _, t := range p.Transactions {
+
+		t.Tx.Chain = chainID
+
+		for _, v := range t.Events {
+			v.Chain = chainID
+		}
+
+		for _, v := range t.Traces {
+			v.Chain = chainID
+		}
+
+		for _, v := range t.TokenTransfers {
+			v.Chain = chainID
+		}
+
+		for _, v := range t.Contracts {
+			v.Chain = chainID
+		}
+
+		for _, v := range t.Upgrades {
+			v.Chain = chainID
+		}
+
+	}
+
 }
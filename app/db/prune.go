@@ -0,0 +1,37 @@
+package db
+
+import (
+	"database/sql"
+
+	"gorm.io/gorm"
+)
+
+// PruneBlocksBelow - Deletes at max `limit` oldest blocks, having number lower than given
+// one, all tx(s), event(s) & other data belonging to them get deleted along with them,
+// due to cascading foreign key constraints
+//
+// Returns how many blocks of given chain got deleted
+func PruneBlocksBelow(_db *gorm.DB, chainID uint64, number uint64, limit uint64) (uint64, error) {
+
+	oldest := _db.Model(&Blocks{}).Select("hash").Where("chain_id = ? and number < ?", chainID, number).Order("number asc").Limit(int(limit))
+
+	result := _db.Where("hash in (?)", oldest).Delete(&Blocks{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return uint64(result.RowsAffected), nil
+
+}
+
+// GetOldestBlockNumberSince - Returns lowest number of block of given chain, mined at or
+// after given unix timestamp, false if there's no such block in database
+func GetOldestBlockNumberSince(db *gorm.DB, chainID uint64, time uint64) (uint64, bool) {
+	var number sql.NullInt64
+
+	if err := db.Raw("select min(number) from blocks where chain_id = ? and time >= ?", chainID, time).Scan(&number).Error; err != nil || !number.Valid {
+		return 0, false
+	}
+
+	return uint64(number.Int64), true
+}
@@ -12,12 +12,12 @@ import (
 )
 
 // GetAllBlockNumbersInRange - Returns all block numbers in given range, both inclusive
-func GetAllBlockNumbersInRange(db *gorm.DB, from uint64, to uint64) []uint64 {
+func GetAllBlockNumbersInRange(db *gorm.DB, chainID uint64, from uint64, to uint64) []uint64 {
 
 	var blocks []uint64
 	rangeFrom := math.Min(float64(from), float64(to))
 	rangeTo := math.Max(float64(from), float64(to))
-	if err := db.Model(&Blocks{}).Where("number >= ? and number <= ?", rangeFrom, rangeTo).Order("number asc").Select("number").Find(&blocks).Error; err != nil {
+	if err := db.Model(&Blocks{}).Where("chain_id = ? and number >= ? and number <= ?", chainID, rangeFrom, rangeTo).Order("number asc").Select("number").Find(&blocks).Error; err != nil {
 
 		log.Printf("[!] Failed to fetch block numbers by range : %s\n", err.Error())
 		return nil
@@ -30,10 +30,10 @@ func GetAllBlockNumbersInRange(db *gorm.DB, from uint64, to uint64) []uint64 {
 
 // GetCurrentOldestBlockNumber - Fetches what's lowest block number present in database,
 // which denotes if it's not 0, from here we can start syncing again, until we reach 0
-func GetCurrentOldestBlockNumber(db *gorm.DB) uint64 {
+func GetCurrentOldestBlockNumber(db *gorm.DB, chainID uint64) uint64 {
 	var number uint64
 
-	if err := db.Raw("select min(number) from blocks").Scan(&number).Error; err != nil {
+	if err := db.Raw("select min(number) from blocks where chain_id = ?", chainID).Scan(&number).Error; err != nil {
 		return 0
 	}
 
@@ -42,10 +42,10 @@ func GetCurrentOldestBlockNumber(db *gorm.DB) uint64 {
 
 // GetCurrentBlockNumber - Returns highest block number, which got processed
 // by the service
-func GetCurrentBlockNumber(db *gorm.DB) uint64 {
+func GetCurrentBlockNumber(db *gorm.DB, chainID uint64) uint64 {
 	var number uint64
 
-	if err := db.Raw("select max(number) from blocks").Scan(&number).Error; err != nil {
+	if err := db.Raw("select max(number) from blocks where chain_id = ?", chainID).Scan(&number).Error; err != nil {
 		return 0
 	}
 
@@ -63,10 +63,25 @@ func GetCurrentBlockNumber(db *gorm.DB) uint64 {
 //
 // All other block count calculation requirements can be fulfilled by
 // using in-memory program state holder
-func GetBlockCount(db *gorm.DB) uint64 {
+func GetBlockCount(db *gorm.DB, chainID uint64) uint64 {
 	var number int64
 
-	if err := db.Model(&Blocks{}).Count(&number).Error; err != nil {
+	if err := db.Model(&Blocks{}).Where("chain_id = ?", chainID).Count(&number).Error; err != nil {
+		return 0
+	}
+
+	return uint64(number)
+}
+
+// GetBlockCountInRange - Returns how many blocks currently present in database,
+// in given block number range, both inclusive
+//
+// Served by index on block number, but still to be used only when window of
+// blocks being kept in sync is bounded
+func GetBlockCountInRange(db *gorm.DB, chainID uint64, from uint64, to uint64) uint64 {
+	var number int64
+
+	if err := db.Model(&Blocks{}).Where("chain_id = ? and number >= ? and number <= ?", chainID, from, to).Count(&number).Error; err != nil {
 		return 0
 	}
 
@@ -76,10 +91,10 @@ func GetBlockCount(db *gorm.DB) uint64 {
 // GetBlockByHash - Given blockhash finds out block related information
 //
 // If not found, returns nil
-func GetBlockByHash(db *gorm.DB, hash common.Hash) *data.Block {
+func GetBlockByHash(db *gorm.DB, chainID uint64, hash common.Hash) *data.Block {
 	var block data.Block
 
-	if res := db.Model(&Blocks{}).Where("hash = ?", hash.Hex()).First(&block); res.Error != nil {
+	if res := db.Model(&Blocks{}).Where("chain_id = ? and hash = ?", chainID, hash.Hex()).First(&block); res.Error != nil {
 		return nil
 	}
 
@@ -89,10 +104,10 @@ func GetBlockByHash(db *gorm.DB, hash common.Hash) *data.Block {
 // GetBlockByNumber - Fetch block using block number
 //
 // If not found, returns nil
-func GetBlockByNumber(db *gorm.DB, number uint64) *data.Block {
+func GetBlockByNumber(db *gorm.DB, chainID uint64, number uint64) *data.Block {
 	var block data.Block
 
-	if res := db.Model(&Blocks{}).Where("number = ?", number).First(&block); res.Error != nil {
+	if res := db.Model(&Blocks{}).Where("chain_id = ? and number = ?", chainID, number).First(&block); res.Error != nil {
 		return nil
 	}
 
@@ -106,10 +121,10 @@ func GetBlockByNumber(db *gorm.DB, number uint64) *data.Block {
 //
 // If more blocks are requested, simply to be rejected
 // In that case, consider splitting them such that they satisfy criteria
-func GetBlocksByNumberRange(db *gorm.DB, from uint64, to uint64) *data.Blocks {
+func GetBlocksByNumberRange(db *gorm.DB, chainID uint64, from uint64, to uint64) *data.Blocks {
 	var blocks []*data.Block
 
-	if res := db.Model(&Blocks{}).Where("number >= ? and number <= ?", from, to).Order("number asc").Find(&blocks); res.Error != nil {
+	if res := db.Model(&Blocks{}).Where("chain_id = ? and number >= ? and number <= ?", chainID, from, to).Order("number asc").Find(&blocks); res.Error != nil {
 		return nil
 	}
 
@@ -122,10 +137,10 @@ func GetBlocksByNumberRange(db *gorm.DB, from uint64, to uint64) *data.Blocks {
 // mined in that time span
 //
 // If asked to find out blocks in time span larger than 60 sec, simply drops query request
-func GetBlocksByTimeRange(db *gorm.DB, from uint64, to uint64) *data.Blocks {
+func GetBlocksByTimeRange(db *gorm.DB, chainID uint64, from uint64, to uint64) *data.Blocks {
 	var blocks []*data.Block
 
-	if res := db.Model(&Blocks{}).Where("time >= ? and time <= ?", from, to).Order("number asc").Find(&blocks); res.Error != nil {
+	if res := db.Model(&Blocks{}).Where("chain_id = ? and time >= ? and time <= ?", chainID, from, to).Order("number asc").Find(&blocks); res.Error != nil {
 		return nil
 	}
 
@@ -136,11 +151,11 @@ func GetBlocksByTimeRange(db *gorm.DB, from uint64, to uint64) *data.Blocks {
 
 // GetTransactionCountByBlockHash - Given block hash, finds out how many
 // transactions are packed in that block
-func GetTransactionCountByBlockHash(db *gorm.DB, hash common.Hash) int64 {
+func GetTransactionCountByBlockHash(db *gorm.DB, chainID uint64, hash common.Hash) int64 {
 
 	var count int64
 
-	if err := db.Model(&Transactions{}).Where("blockhash = ?", hash.Hex()).Count(&count).Error; err != nil {
+	if err := db.Model(&Transactions{}).Where("chain_id = ? and blockhash = ?", chainID, hash.Hex()).Count(&count).Error; err != nil {
 		return 0
 	}
 
@@ -150,10 +165,10 @@ func GetTransactionCountByBlockHash(db *gorm.DB, hash common.Hash) int64 {
 
 // GetTransactionsByBlockHash - Given block hash, returns all transactions
 // present in that block
-func GetTransactionsByBlockHash(db *gorm.DB, hash common.Hash) *data.Transactions {
+func GetTransactionsByBlockHash(db *gorm.DB, chainID uint64, hash common.Hash) *data.Transactions {
 	var tx []*data.Transaction
 
-	if res := db.Model(&Transactions{}).Where("blockhash = ?", hash.Hex()).Find(&tx); res.Error != nil {
+	if res := db.Model(&Transactions{}).Where("chain_id = ? and blockhash = ?", chainID, hash.Hex()).Find(&tx); res.Error != nil {
 		return nil
 	}
 
@@ -164,11 +179,11 @@ func GetTransactionsByBlockHash(db *gorm.DB, hash common.Hash) *data.Transaction
 
 // GetTransactionCountByBlockNumber - Given block number, finds out how many
 // transactions are packed in that block
-func GetTransactionCountByBlockNumber(db *gorm.DB, number uint64) int64 {
+func GetTransactionCountByBlockNumber(db *gorm.DB, chainID uint64, number uint64) int64 {
 
 	var count int64
 
-	if err := db.Model(&Transactions{}).Where("blockhash = (?)", db.Model(&Blocks{}).Where("number = ?", number).Select("hash")).Count(&count).Error; err != nil {
+	if err := db.Model(&Transactions{}).Where("chain_id = ? and blockhash = (?)", chainID, db.Model(&Blocks{}).Where("chain_id = ? and number = ?", chainID, number).Select("hash")).Count(&count).Error; err != nil {
 		return 0
 	}
 
@@ -178,10 +193,10 @@ func GetTransactionCountByBlockNumber(db *gorm.DB, number uint64) int64 {
 
 // GetTransactionsByBlockNumber - Given block number, returns all transactions
 // present in that block
-func GetTransactionsByBlockNumber(db *gorm.DB, number uint64) *data.Transactions {
+func GetTransactionsByBlockNumber(db *gorm.DB, chainID uint64, number uint64) *data.Transactions {
 	var tx []*data.Transaction
 
-	if res := db.Model(&Transactions{}).Where("blockhash = (?)", db.Model(&Blocks{}).Where("number = ?", number).Select("hash")).Find(&tx); res.Error != nil {
+	if res := db.Model(&Transactions{}).Where("chain_id = ? and blockhash = (?)", chainID, db.Model(&Blocks{}).Where("chain_id = ? and number = ?", chainID, number).Select("hash")).Find(&tx); res.Error != nil {
 		return nil
 	}
 
@@ -191,10 +206,10 @@ func GetTransactionsByBlockNumber(db *gorm.DB, number uint64) *data.Transactions
 }
 
 // GetTransactionByHash - Given tx hash, extracts out transaction related data
-func GetTransactionByHash(db *gorm.DB, hash common.Hash) *data.Transaction {
+func GetTransactionByHash(db *gorm.DB, chainID uint64, hash common.Hash) *data.Transaction {
 	var tx data.Transaction
 
-	if err := db.Model(&Transactions{}).Where("hash = ?", hash.Hex()).First(&tx).Error; err != nil {
+	if err := db.Model(&Transactions{}).Where("chain_id = ? and hash = ?", chainID, hash.Hex()).First(&tx).Error; err != nil {
 		return nil
 	}
 
@@ -203,11 +218,11 @@ func GetTransactionByHash(db *gorm.DB, hash common.Hash) *data.Transaction {
 
 // GetTransactionCountFromAccountByBlockNumberRange - Given account address & block number range, it can find out
 // how many tx(s) were sent from this account in specified block range
-func GetTransactionCountFromAccountByBlockNumberRange(db *gorm.DB, account common.Address, from uint64, to uint64) int64 {
+func GetTransactionCountFromAccountByBlockNumberRange(db *gorm.DB, chainID uint64, account common.Address, from uint64, to uint64) int64 {
 
 	var count int64
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.from = ? and blocks.number >= ? and blocks.number <= ?", account.Hex(), from, to).Count(&count).Error; err != nil {
+	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.chain_id = ? and transactions.from = ? and blocks.number >= ? and blocks.number <= ?", chainID, account.Hex(), from, to).Count(&count).Error; err != nil {
 		return 0
 	}
 
@@ -217,10 +232,10 @@ func GetTransactionCountFromAccountByBlockNumberRange(db *gorm.DB, account commo
 
 // GetTransactionsFromAccountByBlockNumberRange - Given account address & block number range, it can find out
 // all transactions which are performed from this account
-func GetTransactionsFromAccountByBlockNumberRange(db *gorm.DB, account common.Address, from uint64, to uint64) *data.Transactions {
+func GetTransactionsFromAccountByBlockNumberRange(db *gorm.DB, chainID uint64, account common.Address, from uint64, to uint64) *data.Transactions {
 	var tx []*data.Transaction
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.from = ? and blocks.number >= ? and blocks.number <= ?", account.Hex(), from, to).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash").Find(&tx).Error; err != nil {
+	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.chain_id = ? and transactions.from = ? and blocks.number >= ? and blocks.number <= ?", chainID, account.Hex(), from, to).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash, transactions.type, transactions.chainid, transactions.chain_id, transactions.maxfeepergas, transactions.maxpriorityfeepergas, transactions.effectivegasprice, transactions.gasused, transactions.cumulativegasused, transactions.accesslist, transactions.maxfeeperblobgas, transactions.blobgasused, transactions.blobgasprice, transactions.blobhashes, transactions.logsbloom").Find(&tx).Error; err != nil {
 		return nil
 	}
 
@@ -231,11 +246,11 @@ func GetTransactionsFromAccountByBlockNumberRange(db *gorm.DB, account common.Ad
 
 // GetTransactionCountFromAccountByBlockTimeRange - Given account address & block mining time stamp range, it can find out
 // count of all tx(s) performed by this address, with in that time span
-func GetTransactionCountFromAccountByBlockTimeRange(db *gorm.DB, account common.Address, from uint64, to uint64) int64 {
+func GetTransactionCountFromAccountByBlockTimeRange(db *gorm.DB, chainID uint64, account common.Address, from uint64, to uint64) int64 {
 
 	var count int64
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.from = ? and blocks.time >= ? and blocks.time <= ?", account.Hex(), from, to).Count(&count).Error; err != nil {
+	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.chain_id = ? and transactions.from = ? and blocks.time >= ? and blocks.time <= ?", chainID, account.Hex(), from, to).Count(&count).Error; err != nil {
 		return 0
 	}
 
@@ -245,10 +260,10 @@ func GetTransactionCountFromAccountByBlockTimeRange(db *gorm.DB, account common.
 
 // GetTransactionsFromAccountByBlockTimeRange - Given account address & block mining time stamp range, it can find out
 // all tx(s) performed from this account, with in that time span
-func GetTransactionsFromAccountByBlockTimeRange(db *gorm.DB, account common.Address, from uint64, to uint64) *data.Transactions {
+func GetTransactionsFromAccountByBlockTimeRange(db *gorm.DB, chainID uint64, account common.Address, from uint64, to uint64) *data.Transactions {
 	var tx []*data.Transaction
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.from = ? and blocks.time >= ? and blocks.time <= ?", account.Hex(), from, to).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash").Find(&tx).Error; err != nil {
+	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.chain_id = ? and transactions.from = ? and blocks.time >= ? and blocks.time <= ?", chainID, account.Hex(), from, to).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash, transactions.type, transactions.chainid, transactions.chain_id, transactions.maxfeepergas, transactions.maxpriorityfeepergas, transactions.effectivegasprice, transactions.gasused, transactions.cumulativegasused, transactions.accesslist, transactions.maxfeeperblobgas, transactions.blobgasused, transactions.blobgasprice, transactions.blobhashes, transactions.logsbloom").Find(&tx).Error; err != nil {
 		return nil
 	}
 
@@ -259,11 +274,11 @@ func GetTransactionsFromAccountByBlockTimeRange(db *gorm.DB, account common.Addr
 
 // GetTransactionCountToAccountByBlockNumberRange - Given account address & block number range, returns #-of transactions where
 // `account` was in `to` field
-func GetTransactionCountToAccountByBlockNumberRange(db *gorm.DB, account common.Address, from uint64, to uint64) int64 {
+func GetTransactionCountToAccountByBlockNumberRange(db *gorm.DB, chainID uint64, account common.Address, from uint64, to uint64) int64 {
 
 	var count int64
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.to = ? and blocks.number >= ? and blocks.number <= ?", account.Hex(), from, to).Count(&count).Error; err != nil {
+	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.chain_id = ? and transactions.to = ? and blocks.number >= ? and blocks.number <= ?", chainID, account.Hex(), from, to).Count(&count).Error; err != nil {
 		return 0
 	}
 
@@ -273,10 +288,10 @@ func GetTransactionCountToAccountByBlockNumberRange(db *gorm.DB, account common.
 
 // GetTransactionsToAccountByBlockNumberRange - Given account address & block number range, returns transactions where
 // `account` was in `to` field
-func GetTransactionsToAccountByBlockNumberRange(db *gorm.DB, account common.Address, from uint64, to uint64) *data.Transactions {
+func GetTransactionsToAccountByBlockNumberRange(db *gorm.DB, chainID uint64, account common.Address, from uint64, to uint64) *data.Transactions {
 	var tx []*data.Transaction
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.to = ? and blocks.number >= ? and blocks.number <= ?", account.Hex(), from, to).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash").Find(&tx).Error; err != nil {
+	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.chain_id = ? and transactions.to = ? and blocks.number >= ? and blocks.number <= ?", chainID, account.Hex(), from, to).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash, transactions.type, transactions.chainid, transactions.chain_id, transactions.maxfeepergas, transactions.maxpriorityfeepergas, transactions.effectivegasprice, transactions.gasused, transactions.cumulativegasused, transactions.accesslist, transactions.maxfeeperblobgas, transactions.blobgasused, transactions.blobgasprice, transactions.blobhashes, transactions.logsbloom").Find(&tx).Error; err != nil {
 		return nil
 	}
 
@@ -287,11 +302,11 @@ func GetTransactionsToAccountByBlockNumberRange(db *gorm.DB, account common.Addr
 
 // GetTransactionCountToAccountByBlockTimeRange - Given account address which is present in `to` field of tx(s)
 // held in blocks mined with in given time range, returns those tx count
-func GetTransactionCountToAccountByBlockTimeRange(db *gorm.DB, account common.Address, from uint64, to uint64) int64 {
+func GetTransactionCountToAccountByBlockTimeRange(db *gorm.DB, chainID uint64, account common.Address, from uint64, to uint64) int64 {
 
 	var count int64
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.to = ? and blocks.time >= ? and blocks.time <= ?", account.Hex(), from, to).Count(&count).Error; err != nil {
+	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.chain_id = ? and transactions.to = ? and blocks.time >= ? and blocks.time <= ?", chainID, account.Hex(), from, to).Count(&count).Error; err != nil {
 		return 0
 	}
 
@@ -301,10 +316,10 @@ func GetTransactionCountToAccountByBlockTimeRange(db *gorm.DB, account common.Ad
 
 // GetTransactionsToAccountByBlockTimeRange - Given account address which is present in `to` field of tx(s)
 // held in blocks mined with in given time range
-func GetTransactionsToAccountByBlockTimeRange(db *gorm.DB, account common.Address, from uint64, to uint64) *data.Transactions {
+func GetTransactionsToAccountByBlockTimeRange(db *gorm.DB, chainID uint64, account common.Address, from uint64, to uint64) *data.Transactions {
 	var tx []*data.Transaction
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.to = ? and blocks.time >= ? and blocks.time <= ?", account.Hex(), from, to).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash").Find(&tx).Error; err != nil {
+	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.chain_id = ? and transactions.to = ? and blocks.time >= ? and blocks.time <= ?", chainID, account.Hex(), from, to).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash, transactions.type, transactions.chainid, transactions.chain_id, transactions.maxfeepergas, transactions.maxpriorityfeepergas, transactions.effectivegasprice, transactions.gasused, transactions.cumulativegasused, transactions.accesslist, transactions.maxfeeperblobgas, transactions.blobgasused, transactions.blobgasprice, transactions.blobhashes, transactions.logsbloom").Find(&tx).Error; err != nil {
 		return nil
 	}
 
@@ -315,11 +330,11 @@ func GetTransactionsToAccountByBlockTimeRange(db *gorm.DB, account common.Addres
 
 // GetTransactionCountBetweenAccountsByBlockNumberRange - Given from & to account addresses & block number range,
 // returns #-of transactions where `from` & `to` fields are matching
-func GetTransactionCountBetweenAccountsByBlockNumberRange(db *gorm.DB, fromAccount common.Address, toAccount common.Address, from uint64, to uint64) int64 {
+func GetTransactionCountBetweenAccountsByBlockNumberRange(db *gorm.DB, chainID uint64, fromAccount common.Address, toAccount common.Address, from uint64, to uint64) int64 {
 
 	var count int64
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.from = ? and transactions.to = ? and blocks.number >= ? and blocks.number <= ?", fromAccount.Hex(), toAccount.Hex(), from, to).Count(&count).Error; err != nil {
+	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.chain_id = ? and transactions.from = ? and transactions.to = ? and blocks.number >= ? and blocks.number <= ?", chainID, fromAccount.Hex(), toAccount.Hex(), from, to).Count(&count).Error; err != nil {
 		return 0
 	}
 
@@ -329,10 +344,10 @@ func GetTransactionCountBetweenAccountsByBlockNumberRange(db *gorm.DB, fromAccou
 
 // GetTransactionsBetweenAccountsByBlockNumberRange - Given from & to account addresses & block number range,
 // returns transactions where `from` & `to` fields are matching
-func GetTransactionsBetweenAccountsByBlockNumberRange(db *gorm.DB, fromAccount common.Address, toAccount common.Address, from uint64, to uint64) *data.Transactions {
+func GetTransactionsBetweenAccountsByBlockNumberRange(db *gorm.DB, chainID uint64, fromAccount common.Address, toAccount common.Address, from uint64, to uint64) *data.Transactions {
 	var tx []*data.Transaction
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.from = ? and transactions.to = ? and blocks.number >= ? and blocks.number <= ?", fromAccount.Hex(), toAccount.Hex(), from, to).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash").Find(&tx).Error; err != nil {
+	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.chain_id = ? and transactions.from = ? and transactions.to = ? and blocks.number >= ? and blocks.number <= ?", chainID, fromAccount.Hex(), toAccount.Hex(), from, to).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash, transactions.type, transactions.chainid, transactions.chain_id, transactions.maxfeepergas, transactions.maxpriorityfeepergas, transactions.effectivegasprice, transactions.gasused, transactions.cumulativegasused, transactions.accesslist, transactions.maxfeeperblobgas, transactions.blobgasused, transactions.blobgasprice, transactions.blobhashes, transactions.logsbloom").Find(&tx).Error; err != nil {
 		return nil
 	}
 
@@ -343,11 +358,11 @@ func GetTransactionsBetweenAccountsByBlockNumberRange(db *gorm.DB, fromAccount c
 
 // GetTransactionCountBetweenAccountsByBlockTimeRange - Given from & to account addresses & block mining time range,
 // returns #-of transactions where `from` & `to` fields are matching
-func GetTransactionCountBetweenAccountsByBlockTimeRange(db *gorm.DB, fromAccount common.Address, toAccount common.Address, from uint64, to uint64) int64 {
+func GetTransactionCountBetweenAccountsByBlockTimeRange(db *gorm.DB, chainID uint64, fromAccount common.Address, toAccount common.Address, from uint64, to uint64) int64 {
 
 	var count int64
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.from = ? and transactions.to = ? and blocks.time >= ? and blocks.time <= ?", fromAccount.Hex(), toAccount.Hex(), from, to).Count(&count).Error; err != nil {
+	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.chain_id = ? and transactions.from = ? and transactions.to = ? and blocks.time >= ? and blocks.time <= ?", chainID, fromAccount.Hex(), toAccount.Hex(), from, to).Count(&count).Error; err != nil {
 		return 0
 	}
 
@@ -357,10 +372,10 @@ func GetTransactionCountBetweenAccountsByBlockTimeRange(db *gorm.DB, fromAccount
 
 // GetTransactionsBetweenAccountsByBlockTimeRange - Given from & to account addresses & block mining time range,
 // returns transactions where `from` & `to` fields are matching
-func GetTransactionsBetweenAccountsByBlockTimeRange(db *gorm.DB, fromAccount common.Address, toAccount common.Address, from uint64, to uint64) *data.Transactions {
+func GetTransactionsBetweenAccountsByBlockTimeRange(db *gorm.DB, chainID uint64, fromAccount common.Address, toAccount common.Address, from uint64, to uint64) *data.Transactions {
 	var tx []*data.Transaction
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.from = ? and transactions.to = ? and blocks.time >= ? and blocks.time <= ?", fromAccount.Hex(), toAccount.Hex(), from, to).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash").Find(&tx).Error; err != nil {
+	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.chain_id = ? and transactions.from = ? and transactions.to = ? and blocks.time >= ? and blocks.time <= ?", chainID, fromAccount.Hex(), toAccount.Hex(), from, to).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash, transactions.type, transactions.chainid, transactions.chain_id, transactions.maxfeepergas, transactions.maxpriorityfeepergas, transactions.effectivegasprice, transactions.gasused, transactions.cumulativegasused, transactions.accesslist, transactions.maxfeeperblobgas, transactions.blobgasused, transactions.blobgasprice, transactions.blobhashes, transactions.logsbloom").Find(&tx).Error; err != nil {
 		return nil
 	}
 
@@ -371,10 +386,10 @@ func GetTransactionsBetweenAccountsByBlockTimeRange(db *gorm.DB, fromAccount com
 
 // GetContractCreationTransactionsFromAccountByBlockNumberRange - Fetch all contract creation tx(s) from given account
 // with in specific block number range
-func GetContractCreationTransactionsFromAccountByBlockNumberRange(db *gorm.DB, account common.Address, from uint64, to uint64) *data.Transactions {
+func GetContractCreationTransactionsFromAccountByBlockNumberRange(db *gorm.DB, chainID uint64, account common.Address, from uint64, to uint64) *data.Transactions {
 	var tx []*data.Transaction
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.from = ? and transactions.contract <> '' and blocks.number >= ? and blocks.number <= ?", account.Hex(), from, to).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash").Find(&tx).Error; err != nil {
+	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.chain_id = ? and transactions.from = ? and transactions.contract <> '' and blocks.number >= ? and blocks.number <= ?", chainID, account.Hex(), from, to).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash, transactions.type, transactions.chainid, transactions.chain_id, transactions.maxfeepergas, transactions.maxpriorityfeepergas, transactions.effectivegasprice, transactions.gasused, transactions.cumulativegasused, transactions.accesslist, transactions.maxfeeperblobgas, transactions.blobgasused, transactions.blobgasprice, transactions.blobhashes, transactions.logsbloom").Find(&tx).Error; err != nil {
 		return nil
 	}
 
@@ -385,10 +400,10 @@ func GetContractCreationTransactionsFromAccountByBlockNumberRange(db *gorm.DB, a
 
 // GetContractCreationTransactionsFromAccountByBlockTimeRange - Fetch all contract creation tx(s) from given account
 // with in specific block time span range
-func GetContractCreationTransactionsFromAccountByBlockTimeRange(db *gorm.DB, account common.Address, from uint64, to uint64) *data.Transactions {
+func GetContractCreationTransactionsFromAccountByBlockTimeRange(db *gorm.DB, chainID uint64, account common.Address, from uint64, to uint64) *data.Transactions {
 	var tx []*data.Transaction
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.from = ? and transactions.contract <> '' and blocks.time >= ? and blocks.time <= ?", account.Hex(), from, to).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash").Find(&tx).Error; err != nil {
+	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.chain_id = ? and transactions.from = ? and transactions.contract <> '' and blocks.time >= ? and blocks.time <= ?", chainID, account.Hex(), from, to).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash, transactions.type, transactions.chainid, transactions.chain_id, transactions.maxfeepergas, transactions.maxpriorityfeepergas, transactions.effectivegasprice, transactions.gasused, transactions.cumulativegasused, transactions.accesslist, transactions.maxfeeperblobgas, transactions.blobgasused, transactions.blobgasprice, transactions.blobhashes, transactions.logsbloom").Find(&tx).Error; err != nil {
 		return nil
 	}
 
@@ -398,10 +413,10 @@ func GetContractCreationTransactionsFromAccountByBlockTimeRange(db *gorm.DB, acc
 }
 
 // GetTransactionFromAccountWithNonce - Given tx sender address & account nonce, finds out tx, satisfying condition
-func GetTransactionFromAccountWithNonce(db *gorm.DB, account common.Address, nonce uint64) *data.Transaction {
+func GetTransactionFromAccountWithNonce(db *gorm.DB, chainID uint64, account common.Address, nonce uint64) *data.Transaction {
 	var tx data.Transaction
 
-	if err := db.Model(&Transactions{}).Where("transactions.from = ? and transactions.nonce = ?", account.Hex(), nonce).First(&tx).Error; err != nil {
+	if err := db.Model(&Transactions{}).Where("transactions.chain_id = ? and transactions.from = ? and transactions.nonce = ?", chainID, account.Hex(), nonce).First(&tx).Error; err != nil {
 		return nil
 	}
 
@@ -410,11 +425,11 @@ func GetTransactionFromAccountWithNonce(db *gorm.DB, account common.Address, non
 
 // GetEventsFromContractByBlockNumberRange - Given block number range & contract address, extracts out all
 // events emitted by this contract during block span
-func GetEventsFromContractByBlockNumberRange(db *gorm.DB, contract common.Address, from uint64, to uint64) *data.Events {
+func GetEventsFromContractByBlockNumberRange(db *gorm.DB, chainID uint64, contract common.Address, from uint64, to uint64) *data.Events {
 
 	var events []*data.Event
 
-	if err := db.Model(&Events{}).Joins("left join blocks on events.blockhash = blocks.hash").Where("events.origin = ? and blocks.number >= ? and blocks.number <= ?", contract.Hex(), from, to).Select("events.origin, events.index, events.topics, events.data, events.txhash, events.blockhash").Find(&events).Error; err != nil {
+	if err := db.Model(&Events{}).Joins("left join blocks on events.blockhash = blocks.hash").Where("events.chain_id = ? and events.origin = ? and blocks.number >= ? and blocks.number <= ?", chainID, contract.Hex(), from, to).Select("events.origin, events.index, events.topics, events.data, events.txhash, events.blockhash, events.chain_id").Find(&events).Error; err != nil {
 		return nil
 	}
 
@@ -426,11 +441,11 @@ func GetEventsFromContractByBlockNumberRange(db *gorm.DB, contract common.Addres
 
 // GetEventsFromContractByBlockTimeRange - Given block time range & contract address, extracts out all
 // events emitted by this contract during time span
-func GetEventsFromContractByBlockTimeRange(db *gorm.DB, contract common.Address, from uint64, to uint64) *data.Events {
+func GetEventsFromContractByBlockTimeRange(db *gorm.DB, chainID uint64, contract common.Address, from uint64, to uint64) *data.Events {
 
 	var events []*data.Event
 
-	if err := db.Model(&Events{}).Joins("left join blocks on events.blockhash = blocks.hash").Where("events.origin = ? and blocks.time >= ? and blocks.time <= ?", contract.Hex(), from, to).Select("events.origin, events.index, events.topics, events.data, events.txhash, events.blockhash").Find(&events).Error; err != nil {
+	if err := db.Model(&Events{}).Joins("left join blocks on events.blockhash = blocks.hash").Where("events.chain_id = ? and events.origin = ? and blocks.time >= ? and blocks.time <= ?", chainID, contract.Hex(), from, to).Select("events.origin, events.index, events.topics, events.data, events.txhash, events.blockhash, events.chain_id").Find(&events).Error; err != nil {
 		return nil
 	}
 
@@ -441,10 +456,10 @@ func GetEventsFromContractByBlockTimeRange(db *gorm.DB, contract common.Address,
 }
 
 // GetEventsByBlockHash - Given block hash retrieves all events from all tx present in that block
-func GetEventsByBlockHash(db *gorm.DB, blockHash common.Hash) *data.Events {
+func GetEventsByBlockHash(db *gorm.DB, chainID uint64, blockHash common.Hash) *data.Events {
 	var events []*data.Event
 
-	if err := db.Model(&Events{}).Where("events.blockhash = ?", blockHash.Hex()).Find(&events).Error; err != nil {
+	if err := db.Model(&Events{}).Where("events.chain_id = ? and events.blockhash = ?", chainID, blockHash.Hex()).Find(&events).Error; err != nil {
 		return nil
 	}
 
@@ -454,10 +469,10 @@ func GetEventsByBlockHash(db *gorm.DB, blockHash common.Hash) *data.Events {
 }
 
 // GetEventsByTransactionHash - Given tx hash, returns all events emitted during contract interaction ( i.e. tx execution )
-func GetEventsByTransactionHash(db *gorm.DB, txHash common.Hash) *data.Events {
+func GetEventsByTransactionHash(db *gorm.DB, chainID uint64, txHash common.Hash) *data.Events {
 	var events []*data.Event
 
-	if err := db.Model(&Events{}).Where("events.txhash = ?", txHash.Hex()).Find(&events).Error; err != nil {
+	if err := db.Model(&Events{}).Where("events.chain_id = ? and events.txhash = ?", chainID, txHash.Hex()).Find(&events).Error; err != nil {
 		return nil
 	}
 
@@ -523,14 +538,14 @@ func EventTopicsAsString(topics map[uint8]string) string {
 
 // GetEventsFromContractWithTopicsByBlockNumberRange - Given block number range, contract address & topics of event log, extracts out all
 // events emitted by this contract during block span with topic signatures matching
-func GetEventsFromContractWithTopicsByBlockNumberRange(db *gorm.DB, contract common.Address, from uint64, to uint64, topics map[uint8]string) *data.Events {
+func GetEventsFromContractWithTopicsByBlockNumberRange(db *gorm.DB, chainID uint64, contract common.Address, from uint64, to uint64, topics map[uint8]string) *data.Events {
 
 	var events []*data.Event
 
 	if err := db.Raw(fmt.Sprintf(
-		"select e.origin, e.index, e.topics, e.data, e.txhash, e.blockhash from events as e "+
-			"left join blocks as b on e.blockhash = b.hash where e.origin = '%s' and b.number >= %d and b.number <= %d and '{%s}' <@ e.topics",
-		contract.Hex(), from, to, EventTopicsAsString(topics))).Scan(&events).Error; err != nil {
+		"select e.origin, e.index, e.topics, e.data, e.txhash, e.blockhash, e.chain_id from events as e "+
+			"left join blocks as b on e.blockhash = b.hash where e.chain_id = %d and e.origin = '%s' and b.number >= %d and b.number <= %d and '{%s}' <@ e.topics",
+		chainID, contract.Hex(), from, to, EventTopicsAsString(topics))).Scan(&events).Error; err != nil {
 		return nil
 	}
 
@@ -544,14 +559,14 @@ func GetEventsFromContractWithTopicsByBlockNumberRange(db *gorm.DB, contract com
 
 // GetEventsFromContractWithTopicsByBlockTimeRange - Given time range, contract address & topics of event log, extracts out all
 // events emitted by this contract during block span with topic signatures matching
-func GetEventsFromContractWithTopicsByBlockTimeRange(db *gorm.DB, contract common.Address, from uint64, to uint64, topics map[uint8]string) *data.Events {
+func GetEventsFromContractWithTopicsByBlockTimeRange(db *gorm.DB, chainID uint64, contract common.Address, from uint64, to uint64, topics map[uint8]string) *data.Events {
 
 	var events []*data.Event
 
 	if err := db.Raw(fmt.Sprintf(
-		"select e.origin, e.index, e.topics, e.data, e.txhash, e.blockhash from events as e "+
-			"left join blocks as b on e.blockhash = b.hash where e.origin = '%s' and b.time >= %d and b.time <= %d and '{%s}' <@ e.topics",
-		contract.Hex(), from, to, EventTopicsAsString(topics))).Scan(&events).Error; err != nil {
+		"select e.origin, e.index, e.topics, e.data, e.txhash, e.blockhash, e.chain_id from events as e "+
+			"left join blocks as b on e.blockhash = b.hash where e.chain_id = %d and e.origin = '%s' and b.time >= %d and b.time <= %d and '{%s}' <@ e.topics",
+		chainID, contract.Hex(), from, to, EventTopicsAsString(topics))).Scan(&events).Error; err != nil {
 		return nil
 	}
 
@@ -564,14 +579,14 @@ func GetEventsFromContractWithTopicsByBlockTimeRange(db *gorm.DB, contract commo
 }
 
 // GetLastXEventsFromContract - Finds out last `x` events emitted by contract
-func GetLastXEventsFromContract(db *gorm.DB, contract common.Address, x int) *data.Events {
+func GetLastXEventsFromContract(db *gorm.DB, chainID uint64, contract common.Address, x int) *data.Events {
 
 	var events []*data.Event
 
 	if err := db.Raw(fmt.Sprintf(
-		"select e.origin, e.index, e.topics, e.data, e.txhash, e.blockhash from events as e "+
-			"left join blocks as b on e.blockhash = b.hash where e.origin = '%s' order by b.number desc limit %d",
-		contract.Hex(), x)).Scan(&events).Error; err != nil {
+		"select e.origin, e.index, e.topics, e.data, e.txhash, e.blockhash, e.chain_id from events as e "+
+			"left join blocks as b on e.blockhash = b.hash where e.chain_id = %d and e.origin = '%s' order by b.number desc limit %d",
+		chainID, contract.Hex(), x)).Scan(&events).Error; err != nil {
 		return nil
 	}
 
@@ -587,11 +602,11 @@ func GetLastXEventsFromContract(db *gorm.DB, contract common.Address, x int) *da
 
 // GetEventByBlockHashAndLogIndex - Given block hash and log index in block
 // return respective event log, if any exists
-func GetEventByBlockHashAndLogIndex(db *gorm.DB, hash common.Hash, index uint) *data.Event {
+func GetEventByBlockHashAndLogIndex(db *gorm.DB, chainID uint64, hash common.Hash, index uint) *data.Event {
 
 	var event data.Event
 
-	if err := db.Model(&Events{}).Where("blockhash = ? and index = ?", hash.Hex(), index).First(&event).Error; err != nil {
+	if err := db.Model(&Events{}).Where("chain_id = ? and blockhash = ? and index = ?", chainID, hash.Hex(), index).First(&event).Error; err != nil {
 		return nil
 	}
 
@@ -601,9 +616,9 @@ func GetEventByBlockHashAndLogIndex(db *gorm.DB, hash common.Hash, index uint) *
 
 // GetEventByBlockNumberAndLogIndex - Given block number and log index in block
 // return respective event log, if any exists
-func GetEventByBlockNumberAndLogIndex(db *gorm.DB, number uint64, index uint) *data.Event {
+func GetEventByBlockNumberAndLogIndex(db *gorm.DB, chainID uint64, number uint64, index uint) *data.Event {
 
-	block := GetBlockByNumber(db, number)
+	block := GetBlockByNumber(db, chainID, number)
 	// seems bad block number or may be the service
 	// hasn't synced upto this point or missed
 	// this block some how
@@ -613,7 +628,7 @@ func GetEventByBlockNumberAndLogIndex(db *gorm.DB, number uint64, index uint) *d
 
 	var event data.Event
 
-	if err := db.Model(&Events{}).Where("blockhash = ? and index = ?", block.Hash, index).First(&event).Error; err != nil {
+	if err := db.Model(&Events{}).Where("chain_id = ? and blockhash = ? and index = ?", chainID, block.Hash, index).First(&event).Error; err != nil {
 		return nil
 	}
 
@@ -0,0 +1,133 @@
+package db
+
+import (
+	"time"
+
+	q "github.com/denniswon/validationcloud/app/queue"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// QueueStore - Persists state of blocks living in block processor queue
+// of one chain, in database
+type QueueStore struct {
+	DB      *gorm.DB
+	ChainID uint64
+}
+
+// Load - Returns state of all blocks of chain, persisted in database
+func (s *QueueStore) Load() (map[uint64]*q.Block, error) {
+
+	var queued []*QueuedBlocks
+
+	if err := s.DB.Where("chain_id = ?", s.ChainID).Find(&queued).Error; err != nil {
+		return nil, err
+	}
+
+	blocks := make(map[uint64]*q.Block, len(queued))
+
+	for _, v := range queued {
+
+		blocks[v.Number] = &q.Block{
+			Published:       v.Published,
+			UnconfirmedDone: v.UnconfirmedDone,
+			ConfirmedDone:   v.ConfirmedDone,
+			Attempts:        v.Attempts,
+			LastAttempted:   time.Unix(0, v.LastAttempted).UTC(),
+			Delay:           time.Duration(v.Delay) * time.Second,
+			DeadLettered:    v.DeadLettered,
+			Discarded:       v.Discarded,
+			LastError:       v.LastError,
+		}
+
+	}
+
+	return blocks, nil
+
+}
+
+// Save - Upserts state of given blocks, in batches
+func (s *QueueStore) Save(blocks map[uint64]*q.Block) error {
+
+	if len(blocks) == 0 {
+		return nil
+	}
+
+	queued := make([]*QueuedBlocks, 0, len(blocks))
+
+	for k, v := range blocks {
+
+		queued = append(queued, &QueuedBlocks{
+			Chain:           s.ChainID,
+			Number:          k,
+			Published:       v.Published,
+			UnconfirmedDone: v.UnconfirmedDone,
+			ConfirmedDone:   v.ConfirmedDone,
+			Attempts:        v.Attempts,
+			LastAttempted:   v.LastAttempted.UnixNano(),
+			Delay:           uint64(v.Delay.Seconds()),
+			DeadLettered:    v.DeadLettered,
+			Discarded:       v.Discarded,
+			LastError:       v.LastError,
+		})
+
+	}
+
+	return s.DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "chain_id"}, {Name: "number"}},
+		UpdateAll: true,
+	}).CreateInBatches(queued, 1000).Error
+
+}
+
+// Remove - Deletes state of given blocks, which are not in queue anymore
+func (s *QueueStore) Remove(numbers []uint64) error {
+
+	if len(numbers) == 0 {
+		return nil
+	}
+
+	return s.DB.Where("chain_id = ? and number in ?", s.ChainID, numbers).Delete(&QueuedBlocks{}).Error
+
+}
+
+// GetDeadLetters - Blocks of chain, given up on by indexer, as persisted along with
+// block processor queue, in ascending order of block number
+func GetDeadLetters(_db *gorm.DB, chainID uint64) ([]*q.DeadLetter, error) {
+
+	var queued []*QueuedBlocks
+
+	if err := _db.Where("chain_id = ? and deadlettered = true", chainID).Order("number asc").Find(&queued).Error; err != nil {
+		return nil, err
+	}
+
+	deadLetters := make([]*q.DeadLetter, 0, len(queued))
+
+	for _, v := range queued {
+
+		deadLetters = append(deadLetters, &q.DeadLetter{
+			Number:        v.Number,
+			Attempts:      v.Attempts,
+			LastError:     v.LastError,
+			LastAttempted: time.Unix(0, v.LastAttempted).UTC(),
+		})
+
+	}
+
+	return deadLetters, nil
+
+}
+
+// GetDeadLetterCount - Number of blocks of chain, given up on by indexer, as
+// persisted along with block processor queue
+func GetDeadLetterCount(_db *gorm.DB, chainID uint64) uint64 {
+
+	var count int64
+
+	if err := _db.Model(&QueuedBlocks{}).Where("chain_id = ? and deadlettered = true", chainID).Count(&count).Error; err != nil {
+		return 0
+	}
+
+	return uint64(count)
+
+}
@@ -0,0 +1,295 @@
+package db
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/denniswon/validationcloud/app/data"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// RemoveBlocksInRange - Removes all blocks of given chain, having number in [from, to] range ( except the one
+// identified by `keep` hash, if any ), while cascading all dependent entries
+// ( i.e. in transactions/ events table )
+//
+// These are the blocks which got orphaned due to chain reorganization,
+// which is why removed blocks are returned back to caller, in ascending order,
+// along with their tx(s) & event(s), while they're also held in same DB transaction,
+// until retracted from pubsub topics
+func RemoveBlocksInRange(dbWTx *gorm.DB, chainID uint64, from uint64, to uint64, keep string) ([]*PackedBlock, error) {
+
+	return removeBlocks(dbWTx, "chain_id = ? and number >= ? and number <= ? and hash <> ?", chainID, from, to, keep)
+
+}
+
+// RemoveBlocksAbove - Removes all blocks of given chain, having number > given one ( except the one
+// identified by `keep` hash, if any ), while cascading all dependent entries, returns removed
+// blocks in ascending order, same as `RemoveBlocksInRange`
+func RemoveBlocksAbove(dbWTx *gorm.DB, chainID uint64, number uint64, keep string) ([]*PackedBlock, error) {
+
+	return removeBlocks(dbWTx, "chain_id = ? and number > ? and hash <> ?", chainID, number, keep)
+
+}
+
+// removeBlocks - Removes blocks matching given condition, along with all dependent
+// entries, returns them packed with their tx(s) & event(s), in ascending order
+func removeBlocks(dbWTx *gorm.DB, query string, args ...interface{}) ([]*PackedBlock, error) {
+
+	var blocks []*Blocks
+
+	if err := dbWTx.Where(query, args...).Order("number asc").Find(&blocks).Error; err != nil {
+		return nil, err
+	}
+
+	if len(blocks) == 0 {
+		return []*PackedBlock{}, nil
+	}
+
+	packedBlocks, err := GetPackedBlocks(dbWTx, blocks)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := PutRetractions(dbWTx, packedBlocks); err != nil {
+		return nil, err
+	}
+
+	hashes := make([]string, len(blocks))
+	for k, v := range blocks {
+		hashes[k] = v.Hash
+	}
+
+	if err := dbWTx.Where("hash in ?", hashes).Delete(&Blocks{}).Error; err != nil {
+		return nil, err
+	}
+
+	// Contracts aren't cascaded, as those need to survive pruning
+	if err := dbWTx.Where("chain_id = ? and blockhash in ?", blocks[0].Chain, hashes).Delete(&Contracts{}).Error; err != nil {
+		return nil, err
+	}
+
+	return packedBlocks, nil
+
+}
+
+// PutRetractions - Holds orphaned blocks, along with all data belonging to them, until
+// their retraction gets published, ones already being held are left as they're
+func PutRetractions(dbWTx *gorm.DB, orphaned []*PackedBlock) error {
+
+	if len(orphaned) == 0 {
+		return nil
+	}
+
+	retractions := make([]*Retractions, 0, len(orphaned))
+
+	for _, v := range orphaned {
+
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+
+		retractions = append(retractions, &Retractions{
+			Chain:  v.Block.Chain,
+			Hash:   v.Block.Hash,
+			Number: v.Block.Number,
+			Block:  encoded,
+		})
+
+	}
+
+	return dbWTx.Clauses(clause.OnConflict{DoNothing: true}).Create(retractions).Error
+
+}
+
+// GetRetractions - Orphaned blocks of chain, yet to be retracted, in descending
+// order of block number, so that latest one gets retracted first
+func GetRetractions(_db *gorm.DB, chainID uint64) ([]*PackedBlock, error) {
+
+	var retractions []*Retractions
+
+	if err := _db.Where("chain_id = ?", chainID).Order("number desc").Find(&retractions).Error; err != nil {
+		return nil, err
+	}
+
+	blocks := make([]*PackedBlock, 0, len(retractions))
+
+	for _, v := range retractions {
+
+		var block PackedBlock
+		if err := json.Unmarshal(v.Block, &block); err != nil {
+			return nil, err
+		}
+
+		blocks = append(blocks, &block)
+
+	}
+
+	return blocks, nil
+
+}
+
+// RemoveRetraction - Forgets orphaned block, once its retraction gets published
+func RemoveRetraction(_db *gorm.DB, chainID uint64, hash string) error {
+
+	return _db.Where("chain_id = ? and hash = ?", chainID, hash).Delete(&Retractions{}).Error
+
+}
+
+// GetPackedBlocks - Given blocks already present in DB, loads all tx(s), event(s) & withdrawal(s)
+// belonging to them & packs them together, preserving order of blocks
+func GetPackedBlocks(_db *gorm.DB, blocks []*Blocks) ([]*PackedBlock, error) {
+
+	hashes := make([]string, len(blocks))
+	for k, v := range blocks {
+		hashes[k] = v.Hash
+	}
+
+	var txs []*Transactions
+
+	if err := _db.Where("blockhash in ?", hashes).Find(&txs).Error; err != nil {
+		return nil, err
+	}
+
+	var events []*Events
+
+	if err := _db.Where("blockhash in ?", hashes).Order("index asc").Find(&events).Error; err != nil {
+		return nil, err
+	}
+
+	var withdrawals []*Withdrawals
+
+	if err := _db.Where("blockhash in ?", hashes).Order("index asc").Find(&withdrawals).Error; err != nil {
+		return nil, err
+	}
+
+	packedBlocks := make([]*PackedBlock, len(blocks))
+	// Block hash to packed block mapping, used for putting tx(s) into their blocks
+	packedBlockByHash := make(map[string]*PackedBlock, len(blocks))
+
+	for k, v := range blocks {
+
+		packedBlocks[k] = &PackedBlock{Block: v, Transactions: make([]*PackedTransaction, 0), Withdrawals: make([]*Withdrawals, 0)}
+		packedBlockByHash[v.Hash] = packedBlocks[k]
+
+	}
+
+	// Tx hash to packed tx mapping, used for putting event(s) into their tx(s)
+	packedTxByHash := make(map[string]*PackedTransaction, len(txs))
+
+	for _, v := range txs {
+
+		packedTx := &PackedTransaction{Tx: v, Events: make([]*Events, 0)}
+		packedTxByHash[v.Hash] = packedTx
+
+		if block, ok := packedBlockByHash[v.BlockHash]; ok {
+			block.Transactions = append(block.Transactions, packedTx)
+		}
+
+	}
+
+	for _, v := range events {
+
+		if tx, ok := packedTxByHash[v.TransactionHash]; ok {
+			tx.Events = append(tx.Events, v)
+		}
+
+	}
+
+	for _, v := range withdrawals {
+
+		if block, ok := packedBlockByHash[v.BlockHash]; ok {
+			block.Withdrawals = append(block.Withdrawals, v)
+		}
+
+	}
+
+	return packedBlocks, nil
+
+}
+
+// PutReorg - Persisting record of chain reorganization, given orphaned blocks
+// & hash of common ancestor block, from where new branch starts
+func PutReorg(dbWTx *gorm.DB, orphaned []*PackedBlock, ancestorHash string, newHead string) (*Reorgs, error) {
+
+	hashes := make([]string, len(orphaned))
+	for k, v := range orphaned {
+		hashes[k] = v.Block.Hash
+	}
+
+	reorg := &Reorgs{
+		Chain:          orphaned[0].Block.Chain,
+		Number:         orphaned[0].Block.Number,
+		AncestorHash:   ancestorHash,
+		NewHead:        newHead,
+		Depth:          uint64(len(orphaned)),
+		OrphanedHashes: hashes,
+		DetectedAt:     uint64(time.Now().UTC().Unix()),
+	}
+
+	if err := dbWTx.Create(reorg).Error; err != nil {
+		return nil, err
+	}
+
+	return reorg, nil
+
+}
+
+// Rollback - Given common ancestor block of canonical chain & new chain head, removes all
+// blocks above ancestor, except new head, which got orphaned due to chain reorganization
+// & records that reorg happened
+//
+// Orphaned blocks aren't limited to ones below new head, because new branch can be
+// shorter than the one it replaced
+//
+// Whole rollback is wrapped inside single database transaction, so either all orphaned
+// block/ tx/ event entries are removed or none
+//
+// If nothing got orphaned, returns nil
+func Rollback(dbWOTx *gorm.DB, chainID uint64, ancestor uint64, ancestorHash string, headHash string) (*Reorgs, []*PackedBlock, error) {
+
+	var reorg *Reorgs
+	var orphaned []*PackedBlock
+
+	// -- Starting DB transaction
+	err := dbWOTx.Transaction(func(dbWTx *gorm.DB) error {
+
+		var err error
+
+		orphaned, err = RemoveBlocksAbove(dbWTx, chainID, ancestor, headHash)
+		if err != nil {
+			return err
+		}
+
+		if len(orphaned) == 0 {
+			return nil
+		}
+
+		reorg, err = PutReorg(dbWTx, orphaned, ancestorHash, headHash)
+		return err
+
+	})
+	// -- Ending DB transaction
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return reorg, orphaned, nil
+
+}
+
+// GetReorgsByBlockNumberRange - Given block number range, returns all chain reorganizations
+// of given chain, which started with in that range i.e. first orphaned block number falls in range
+func GetReorgsByBlockNumberRange(db *gorm.DB, chainID uint64, from uint64, to uint64) *data.Reorgs {
+	var reorgs []*data.Reorg
+
+	if res := db.Model(&Reorgs{}).Where("chain_id = ? and number >= ? and number <= ?", chainID, from, to).Order("number asc").Find(&reorgs); res.Error != nil {
+		return nil
+	}
+
+	return &data.Reorgs{
+		Reorgs: reorgs,
+	}
+}
@@ -0,0 +1,118 @@
+package db
+
+import (
+	"errors"
+
+	"github.com/denniswon/validationcloud/app/data"
+	"github.com/ethereum/go-ethereum/common"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// UpsertTokenTransfer - Persisting decoded token transfer, if it's already present, it's
+// updated with latest data
+func UpsertTokenTransfer(dbWTx *gorm.DB, transfer *TokenTransfers) error {
+
+	if transfer == nil {
+		return errors.New("empty token transfer received while attempting to persist")
+	}
+
+	return dbWTx.Clauses(clause.OnConflict{UpdateAll: true}).Create(transfer).Error
+
+}
+
+// tokenTransfersInBlockNumberRange - Token transfers joined with blocks they're emitted in,
+// where block number falls in given range, ordered by their emission
+func tokenTransfersInBlockNumberRange(db *gorm.DB, chainID uint64, from uint64, to uint64) *gorm.DB {
+	return db.Model(&TokenTransfers{}).Joins("left join blocks on token_transfers.blockhash = blocks.hash").Where("token_transfers.chain_id = ? and blocks.number >= ? and blocks.number <= ?", chainID, from, to).Select("token_transfers.*").Order("blocks.number asc, token_transfers.logindex asc, token_transfers.batchindex asc")
+}
+
+// tokenTransfersInBlockTimeRange - Token transfers joined with blocks they're emitted in,
+// where block time falls in given range, ordered by their emission
+func tokenTransfersInBlockTimeRange(db *gorm.DB, chainID uint64, from uint64, to uint64) *gorm.DB {
+	return db.Model(&TokenTransfers{}).Joins("left join blocks on token_transfers.blockhash = blocks.hash").Where("token_transfers.chain_id = ? and blocks.time >= ? and blocks.time <= ?", chainID, from, to).Select("token_transfers.*").Order("blocks.number asc, token_transfers.logindex asc, token_transfers.batchindex asc")
+}
+
+// GetTokenTransfersByBlockNumberRange - Given block number range, returns all token
+// transfers made in that range
+func GetTokenTransfersByBlockNumberRange(db *gorm.DB, chainID uint64, from uint64, to uint64) *data.TokenTransfers {
+	var transfers []*data.TokenTransfer
+
+	if err := tokenTransfersInBlockNumberRange(db, chainID, from, to).Find(&transfers).Error; err != nil {
+		return nil
+	}
+
+	return &data.TokenTransfers{
+		TokenTransfers: transfers,
+	}
+}
+
+// GetTokenTransfersByBlockTimeRange - Given block time range, returns all token
+// transfers made in that time span
+func GetTokenTransfersByBlockTimeRange(db *gorm.DB, chainID uint64, from uint64, to uint64) *data.TokenTransfers {
+	var transfers []*data.TokenTransfer
+
+	if err := tokenTransfersInBlockTimeRange(db, chainID, from, to).Find(&transfers).Error; err != nil {
+		return nil
+	}
+
+	return &data.TokenTransfers{
+		TokenTransfers: transfers,
+	}
+}
+
+// GetTokenTransfersOfTokenByBlockNumberRange - Given token contract address & block number range,
+// returns all transfers of that token made in that range
+func GetTokenTransfersOfTokenByBlockNumberRange(db *gorm.DB, chainID uint64, token common.Address, from uint64, to uint64) *data.TokenTransfers {
+	var transfers []*data.TokenTransfer
+
+	if err := tokenTransfersInBlockNumberRange(db, chainID, from, to).Where("token_transfers.token = ?", token.Hex()).Find(&transfers).Error; err != nil {
+		return nil
+	}
+
+	return &data.TokenTransfers{
+		TokenTransfers: transfers,
+	}
+}
+
+// GetTokenTransfersOfTokenByBlockTimeRange - Given token contract address & block time range,
+// returns all transfers of that token made in that time span
+func GetTokenTransfersOfTokenByBlockTimeRange(db *gorm.DB, chainID uint64, token common.Address, from uint64, to uint64) *data.TokenTransfers {
+	var transfers []*data.TokenTransfer
+
+	if err := tokenTransfersInBlockTimeRange(db, chainID, from, to).Where("token_transfers.token = ?", token.Hex()).Find(&transfers).Error; err != nil {
+		return nil
+	}
+
+	return &data.TokenTransfers{
+		TokenTransfers: transfers,
+	}
+}
+
+// GetTokenTransfersOfHolderByBlockNumberRange - Given holder address & block number range,
+// returns all token transfers either sent from or received by holder in that range
+func GetTokenTransfersOfHolderByBlockNumberRange(db *gorm.DB, chainID uint64, holder common.Address, from uint64, to uint64) *data.TokenTransfers {
+	var transfers []*data.TokenTransfer
+
+	if err := tokenTransfersInBlockNumberRange(db, chainID, from, to).Where("(token_transfers.from = ? or token_transfers.to = ?)", holder.Hex(), holder.Hex()).Find(&transfers).Error; err != nil {
+		return nil
+	}
+
+	return &data.TokenTransfers{
+		TokenTransfers: transfers,
+	}
+}
+
+// GetTokenTransfersOfHolderByBlockTimeRange - Given holder address & block time range,
+// returns all token transfers either sent from or received by holder in that time span
+func GetTokenTransfersOfHolderByBlockTimeRange(db *gorm.DB, chainID uint64, holder common.Address, from uint64, to uint64) *data.TokenTransfers {
+	var transfers []*data.TokenTransfer
+
+	if err := tokenTransfersInBlockTimeRange(db, chainID, from, to).Where("(token_transfers.from = ? or token_transfers.to = ?)", holder.Hex(), holder.Hex()).Find(&transfers).Error; err != nil {
+		return nil
+	}
+
+	return &data.TokenTransfers{
+		TokenTransfers: transfers,
+	}
+}
@@ -0,0 +1,64 @@
+package db
+
+import (
+	"errors"
+
+	"github.com/denniswon/validationcloud/app/data"
+	"github.com/ethereum/go-ethereum/common"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// UpsertTrace - Persisting call trace, if same call of same tx is already
+// present, it's updated with latest data
+func UpsertTrace(dbWTx *gorm.DB, trace *Traces) error {
+
+	if trace == nil {
+		return errors.New("empty trace received while attempting to persist")
+	}
+
+	return dbWTx.Clauses(clause.OnConflict{UpdateAll: true}).Create(trace).Error
+
+}
+
+// GetTracesByTransactionHash - Given tx hash, returns all calls made during its execution,
+// ordered by their position in call tree
+func GetTracesByTransactionHash(db *gorm.DB, chainID uint64, hash common.Hash) *data.Traces {
+	var traces []*data.Trace
+
+	if err := db.Model(&Traces{}).Where("traces.chain_id = ? and traces.txhash = ?", chainID, hash.Hex()).Order("traces.traceaddress asc").Find(&traces).Error; err != nil {
+		return nil
+	}
+
+	return &data.Traces{
+		Traces: traces,
+	}
+}
+
+// GetTracesByAccountByBlockNumberRange - Given account & block number range, returns all calls
+// either made by or made to account, during execution of tx(s) in that range
+func GetTracesByAccountByBlockNumberRange(db *gorm.DB, chainID uint64, account common.Address, from uint64, to uint64) *data.Traces {
+	var traces []*data.Trace
+
+	if err := db.Model(&Traces{}).Joins("left join blocks on traces.blockhash = blocks.hash").Where("traces.chain_id = ? and (traces.from = ? or traces.to = ?) and blocks.number >= ? and blocks.number <= ?", chainID, account.Hex(), account.Hex(), from, to).Select("traces.*").Order("blocks.number asc, traces.txhash asc, traces.traceaddress asc").Find(&traces).Error; err != nil {
+		return nil
+	}
+
+	return &data.Traces{
+		Traces: traces,
+	}
+}
+
+// GetTracesByAccountByBlockTimeRange - Given account & block time range, returns all calls
+// either made by or made to account, during execution of tx(s) in that time span
+func GetTracesByAccountByBlockTimeRange(db *gorm.DB, chainID uint64, account common.Address, from uint64, to uint64) *data.Traces {
+	var traces []*data.Trace
+
+	if err := db.Model(&Traces{}).Joins("left join blocks on traces.blockhash = blocks.hash").Where("traces.chain_id = ? and (traces.from = ? or traces.to = ?) and blocks.time >= ? and blocks.time <= ?", chainID, account.Hex(), account.Hex(), from, to).Select("traces.*").Order("blocks.number asc, traces.txhash asc, traces.traceaddress asc").Find(&traces).Error; err != nil {
+		return nil
+	}
+
+	return &data.Traces{
+		Traces: traces,
+	}
+}
@@ -0,0 +1,50 @@
+package db
+
+import (
+	"errors"
+
+	"github.com/denniswon/validationcloud/app/data"
+	"github.com/ethereum/go-ethereum/common"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// UpsertUncle - Persisting uncle header, if it's already present at same
+// position of including block, it's updated with latest data
+func UpsertUncle(dbWTx *gorm.DB, uncle *Uncles) error {
+
+	if uncle == nil {
+		return errors.New("empty uncle received while attempting to persist")
+	}
+
+	return dbWTx.Clauses(clause.OnConflict{UpdateAll: true}).Create(uncle).Error
+
+}
+
+// GetUnclesByBlockHash - Given hash of including block, returns all uncles
+// referenced by it, in order
+func GetUnclesByBlockHash(db *gorm.DB, chainID uint64, hash common.Hash) *data.Uncles {
+	var uncles []*data.Uncle
+
+	if err := db.Model(&Uncles{}).Where("chain_id = ? and blockhash = ?", chainID, hash.Hex()).Order("position asc").Find(&uncles).Error; err != nil {
+		return nil
+	}
+
+	return &data.Uncles{
+		Uncles: uncles,
+	}
+}
+
+// GetUnclesByBlockNumber - Given number of including block, returns all uncles
+// referenced by it, in order
+func GetUnclesByBlockNumber(db *gorm.DB, chainID uint64, number uint64) *data.Uncles {
+	var uncles []*data.Uncle
+
+	if err := db.Model(&Uncles{}).Where("chain_id = ? and blockhash = (?)", chainID, db.Model(&Blocks{}).Where("chain_id = ? and number = ?", chainID, number).Select("hash")).Order("position asc").Find(&uncles).Error; err != nil {
+		return nil
+	}
+
+	return &data.Uncles{
+		Uncles: uncles,
+	}
+}
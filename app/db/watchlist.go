@@ -0,0 +1,63 @@
+package db
+
+import (
+	"errors"
+
+	"github.com/denniswon/validationcloud/app/data"
+	"github.com/ethereum/go-ethereum/common"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// UpsertWatchedAddress - Putting address in watchlist, if it's already
+// there, its label gets updated
+func UpsertWatchedAddress(db *gorm.DB, watched *Watchlist) error {
+
+	if watched == nil {
+		return errors.New("empty address received while attempting to persist")
+	}
+
+	return db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "address"}},
+		DoUpdates: clause.AssignmentColumns([]string{"label"}),
+	}).Create(watched).Error
+
+}
+
+// DeleteWatchedAddress - Removes address from watchlist, returns whether
+// anything got removed or not
+func DeleteWatchedAddress(db *gorm.DB, address common.Address) (bool, error) {
+
+	result := db.Where("address = ?", address.Hex()).Delete(&Watchlist{})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected != 0, nil
+
+}
+
+// GetWatchedAddress - Given address, returns its watchlist entry, nil if
+// it's not being watched
+func GetWatchedAddress(db *gorm.DB, address common.Address) *data.WatchedAddress {
+	var watched data.WatchedAddress
+
+	if err := db.Model(&Watchlist{}).Where("address = ?", address.Hex()).First(&watched).Error; err != nil {
+		return nil
+	}
+
+	return &watched
+}
+
+// GetWatchlist - Returns all addresses being watched, nil if failed to read
+func GetWatchlist(db *gorm.DB) *data.Watchlist {
+	var addresses []*data.WatchedAddress
+
+	if err := db.Model(&Watchlist{}).Order("addedat asc").Find(&addresses).Error; err != nil {
+		return nil
+	}
+
+	return &data.Watchlist{
+		Addresses: addresses,
+	}
+}
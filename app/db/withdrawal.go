@@ -0,0 +1,78 @@
+package db
+
+import (
+	"errors"
+
+	"github.com/denniswon/validationcloud/app/data"
+	"github.com/ethereum/go-ethereum/common"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// UpsertWithdrawal - Persisting withdrawal, if it's already present in same
+// block, it's updated with latest data
+func UpsertWithdrawal(dbWTx *gorm.DB, withdrawal *Withdrawals) error {
+
+	if withdrawal == nil {
+		return errors.New("empty withdrawal received while attempting to persist")
+	}
+
+	return dbWTx.Clauses(clause.OnConflict{UpdateAll: true}).Create(withdrawal).Error
+
+}
+
+// GetWithdrawalsByBlockHash - Given block hash, returns all withdrawals
+// processed in that block
+func GetWithdrawalsByBlockHash(db *gorm.DB, chainID uint64, hash common.Hash) *data.Withdrawals {
+	var withdrawals []*data.Withdrawal
+
+	if err := db.Model(&Withdrawals{}).Where("chain_id = ? and blockhash = ?", chainID, hash.Hex()).Order("index asc").Find(&withdrawals).Error; err != nil {
+		return nil
+	}
+
+	return &data.Withdrawals{
+		Withdrawals: withdrawals,
+	}
+}
+
+// GetWithdrawalsByBlockNumber - Given block number, returns all withdrawals
+// processed in that block
+func GetWithdrawalsByBlockNumber(db *gorm.DB, chainID uint64, number uint64) *data.Withdrawals {
+	var withdrawals []*data.Withdrawal
+
+	if err := db.Model(&Withdrawals{}).Where("chain_id = ? and blockhash = (?)", chainID, db.Model(&Blocks{}).Where("chain_id = ? and number = ?", chainID, number).Select("hash")).Order("index asc").Find(&withdrawals).Error; err != nil {
+		return nil
+	}
+
+	return &data.Withdrawals{
+		Withdrawals: withdrawals,
+	}
+}
+
+// GetWithdrawalsToAddressByBlockNumberRange - Given recipient address & block number range,
+// returns all withdrawals credited to address in that range
+func GetWithdrawalsToAddressByBlockNumberRange(db *gorm.DB, chainID uint64, address common.Address, from uint64, to uint64) *data.Withdrawals {
+	var withdrawals []*data.Withdrawal
+
+	if err := db.Model(&Withdrawals{}).Joins("left join blocks on withdrawals.blockhash = blocks.hash").Where("withdrawals.chain_id = ? and withdrawals.address = ? and blocks.number >= ? and blocks.number <= ?", chainID, address.Hex(), from, to).Select("withdrawals.*").Order("withdrawals.index asc").Find(&withdrawals).Error; err != nil {
+		return nil
+	}
+
+	return &data.Withdrawals{
+		Withdrawals: withdrawals,
+	}
+}
+
+// GetWithdrawalsToAddressByBlockTimeRange - Given recipient address & block time range,
+// returns all withdrawals credited to address in that time span
+func GetWithdrawalsToAddressByBlockTimeRange(db *gorm.DB, chainID uint64, address common.Address, from uint64, to uint64) *data.Withdrawals {
+	var withdrawals []*data.Withdrawal
+
+	if err := db.Model(&Withdrawals{}).Joins("left join blocks on withdrawals.blockhash = blocks.hash").Where("withdrawals.chain_id = ? and withdrawals.address = ? and blocks.time >= ? and blocks.time <= ?", chainID, address.Hex(), from, to).Select("withdrawals.*").Order("withdrawals.index asc").Find(&withdrawals).Error; err != nil {
+		return nil
+	}
+
+	return &data.Withdrawals{
+		Withdrawals: withdrawals,
+	}
+}
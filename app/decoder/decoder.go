@@ -0,0 +1,245 @@
+package decoder
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	d "github.com/denniswon/validationcloud/app/data"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Parse - Parses JSON encoded contract ABI, which must define at least
+// one method/ event, otherwise there's nothing to decode with it
+func Parse(raw []byte) (*abi.ABI, error) {
+
+	contract, err := abi.JSON(bytes.NewReader(raw))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(contract.Methods) == 0 && len(contract.Events) == 0 {
+		return nil, errors.New("no method or event defined")
+	}
+
+	return &contract, nil
+
+}
+
+// DecodeCalldata - Given tx input data, finds out invoked method using
+// its selector & decodes arguments passed to it
+func DecodeCalldata(contract *abi.ABI, input []byte) (*d.Decoded, error) {
+
+	if len(input) < 4 {
+		return nil, errors.New("no method selector")
+	}
+
+	method, err := contract.MethodById(input[:4])
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := method.Inputs.Unpack(input[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	args := make([]*d.DecodedArgument, len(method.Inputs))
+
+	for k, v := range method.Inputs {
+		args[k] = argument(k, v, values[k])
+	}
+
+	return &d.Decoded{
+		Name:      method.RawName,
+		Signature: method.Sig,
+		Arguments: args,
+	}, nil
+
+}
+
+// DecodeLog - Given topics & data of event log, finds out emitted event
+// using its signature in first topic & decodes indexed arguments from
+// topics & rest from data
+//
+// Indexed arguments of dynamic type are only available as hash of value,
+// so those are put as is
+func DecodeLog(contract *abi.ABI, topics []string, data []byte) (*d.Decoded, error) {
+
+	if len(topics) == 0 {
+		return nil, errors.New("no event signature")
+	}
+
+	event, err := contract.EventByID(common.HexToHash(topics[0]))
+	if err != nil {
+		return nil, err
+	}
+
+	// Anonymous events don't put signature in first topic, so
+	// any match is only coincidental
+	if event.Anonymous {
+		return nil, errors.New("anonymous event")
+	}
+
+	indexed := 0
+	for _, v := range event.Inputs {
+		if v.Indexed {
+			indexed++
+		}
+	}
+
+	if len(topics) != indexed+1 {
+		return nil, fmt.Errorf("expected %d topics, found %d", indexed+1, len(topics))
+	}
+
+	values, err := event.Inputs.NonIndexed().Unpack(data)
+	if err != nil {
+		return nil, err
+	}
+
+	args := make([]*d.DecodedArgument, len(event.Inputs))
+	topic, value := 1, 0
+
+	for k, v := range event.Inputs {
+
+		if !v.Indexed {
+			args[k] = argument(k, v, values[value])
+			value++
+			continue
+		}
+
+		_value, err := indexedValue(v, common.HexToHash(topics[topic]))
+		if err != nil {
+			return nil, err
+		}
+
+		args[k] = argument(k, v, _value)
+		topic++
+
+	}
+
+	return &d.Decoded{
+		Name:      event.RawName,
+		Signature: event.Sig,
+		Arguments: args,
+	}, nil
+
+}
+
+// indexedValue - Value of indexed event argument, as found in topic
+func indexedValue(arg abi.Argument, topic common.Hash) (interface{}, error) {
+
+	switch arg.Type.T {
+
+	case abi.StringTy, abi.BytesTy, abi.SliceTy, abi.ArrayTy, abi.TupleTy:
+		return topic, nil
+
+	}
+
+	values, err := abi.Arguments{{Type: arg.Type}}.Unpack(topic.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return values[0], nil
+
+}
+
+// argument - Decoded argument, unnamed ones are named after their position
+func argument(position int, arg abi.Argument, value interface{}) *d.DecodedArgument {
+
+	name := arg.Name
+	if name == "" {
+		name = fmt.Sprintf("arg%d", position)
+	}
+
+	return &d.DecodedArgument{
+		Name:  name,
+		Type:  arg.Type.String(),
+		Value: normalize(arg.Type, value),
+	}
+
+}
+
+// normalize - Converts value decoded by ABI unpacker into JSON friendly form,
+// numbers become decimal strings, so that none gets truncated by clients,
+// bytes & hashes become hex strings, tuples become objects
+func normalize(_type abi.Type, value interface{}) interface{} {
+
+	if hash, ok := value.(common.Hash); ok {
+		return hash.Hex()
+	}
+
+	switch _type.T {
+
+	case abi.IntTy, abi.UintTy:
+		if v, ok := value.(*big.Int); ok {
+			return v.String()
+		}
+
+		return fmt.Sprintf("%d", value)
+
+	case abi.AddressTy:
+		if v, ok := value.(common.Address); ok {
+			return v.Hex()
+		}
+
+	case abi.BytesTy:
+		if v, ok := value.([]byte); ok {
+			return hexutil.Encode(v)
+		}
+
+	case abi.FixedBytesTy, abi.FunctionTy:
+		v := reflect.ValueOf(value)
+		if v.Kind() != reflect.Array {
+			break
+		}
+
+		buffer := make([]byte, v.Len())
+		reflect.Copy(reflect.ValueOf(buffer), v)
+
+		return hexutil.Encode(buffer)
+
+	case abi.SliceTy, abi.ArrayTy:
+		v := reflect.ValueOf(value)
+		if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+			break
+		}
+
+		elements := make([]interface{}, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			elements[i] = normalize(*_type.Elem, v.Index(i).Interface())
+		}
+
+		return elements
+
+	case abi.TupleTy:
+		v := reflect.Indirect(reflect.ValueOf(value))
+		if v.Kind() != reflect.Struct || v.NumField() != len(_type.TupleElems) {
+			break
+		}
+
+		fields := make(map[string]interface{}, len(_type.TupleElems))
+		for i, elem := range _type.TupleElems {
+
+			name := _type.TupleRawNames[i]
+			if name == "" {
+				name = strings.ToLower(_type.TupleType.Field(i).Name)
+			}
+
+			fields[name] = normalize(*elem, v.Field(i).Interface())
+
+		}
+
+		return fields
+
+	}
+
+	return value
+
+}
@@ -0,0 +1,155 @@
+package decoder
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const testABI = `[
+	{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"batch","inputs":[{"name":"","type":"uint8[]"},{"name":"tag","type":"bytes4"},{"name":"order","type":"tuple","components":[{"name":"maker","type":"address"},{"name":"note","type":"string"}]}]},
+	{"type":"event","name":"Transfer","inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}]},
+	{"type":"event","name":"Named","inputs":[{"name":"name","type":"string","indexed":true},{"name":"owner","type":"address","indexed":false}]}
+]`
+
+// encoded - Decoded argument values, JSON encoded, for comparing in one go
+func encoded(t *testing.T, v interface{}) string {
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("failed to encode : %s", err.Error())
+	}
+
+	return string(data)
+
+}
+
+func TestParse(t *testing.T) {
+
+	if _, err := Parse([]byte(testABI)); err != nil {
+		t.Fatalf("failed to parse ABI : %s", err.Error())
+	}
+
+	for _, v := range []string{"", "{}", "[]", `[{"type":"function","name":"x","inputs":[{"type":"foo"}]}]`} {
+		if _, err := Parse([]byte(v)); err == nil {
+			t.Errorf("expected ABI %q to be rejected", v)
+		}
+	}
+
+}
+
+func TestDecodeCalldata(t *testing.T) {
+
+	contract, err := Parse([]byte(testABI))
+	if err != nil {
+		t.Fatalf("failed to parse ABI : %s", err.Error())
+	}
+
+	to := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+
+	input, err := contract.Pack("transfer", to, big.NewInt(1000))
+	if err != nil {
+		t.Fatalf("failed to encode calldata : %s", err.Error())
+	}
+
+	decoded, err := DecodeCalldata(contract, input)
+	if err != nil {
+		t.Fatalf("failed to decode calldata : %s", err.Error())
+	}
+
+	if decoded.Name != "transfer" || decoded.Signature != "transfer(address,uint256)" {
+		t.Fatalf("decoded wrong method %s", decoded.Signature)
+	}
+
+	if got, expected := encoded(t, decoded.Arguments), `[{"name":"to","type":"address","value":"`+to.Hex()+`"},{"name":"amount","type":"uint256","value":"1000"}]`; got != expected {
+		t.Fatalf("expected arguments %s, got %s", expected, got)
+	}
+
+	order := struct {
+		Maker common.Address
+		Note  string
+	}{to, "gm"}
+
+	input, err = contract.Pack("batch", []uint8{1, 2}, [4]byte{0xde, 0xad, 0xbe, 0xef}, order)
+	if err != nil {
+		t.Fatalf("failed to encode calldata : %s", err.Error())
+	}
+
+	decoded, err = DecodeCalldata(contract, input)
+	if err != nil {
+		t.Fatalf("failed to decode calldata : %s", err.Error())
+	}
+
+	if got, expected := encoded(t, decoded.Arguments), `[{"name":"arg0","type":"uint8[]","value":["1","2"]},{"name":"tag","type":"bytes4","value":"0xdeadbeef"},{"name":"order","type":"(address,string)","value":{"maker":"`+to.Hex()+`","note":"gm"}}]`; got != expected {
+		t.Fatalf("expected arguments %s, got %s", expected, got)
+	}
+
+	if _, err := DecodeCalldata(contract, []byte{0x12, 0x34, 0x56, 0x78}); err == nil {
+		t.Fatalf("expected unknown method to be rejected")
+	}
+
+	if _, err := DecodeCalldata(contract, input[:10]); err == nil {
+		t.Fatalf("expected truncated calldata to be rejected")
+	}
+
+}
+
+func TestDecodeLog(t *testing.T) {
+
+	contract, err := Parse([]byte(testABI))
+	if err != nil {
+		t.Fatalf("failed to parse ABI : %s", err.Error())
+	}
+
+	from := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	to := common.HexToAddress("0x00000000000000000000000000000000000000bb")
+
+	data, err := contract.Events["Transfer"].Inputs.NonIndexed().Pack(big.NewInt(42))
+	if err != nil {
+		t.Fatalf("failed to encode event data : %s", err.Error())
+	}
+
+	topics := []string{
+		contract.Events["Transfer"].ID.Hex(),
+		common.BytesToHash(from.Bytes()).Hex(),
+		common.BytesToHash(to.Bytes()).Hex(),
+	}
+
+	decoded, err := DecodeLog(contract, topics, data)
+	if err != nil {
+		t.Fatalf("failed to decode event : %s", err.Error())
+	}
+
+	if decoded.Name != "Transfer" || decoded.Signature != "Transfer(address,address,uint256)" {
+		t.Fatalf("decoded wrong event %s", decoded.Signature)
+	}
+
+	if got, expected := encoded(t, decoded.Arguments), `[{"name":"from","type":"address","value":"`+from.Hex()+`"},{"name":"to","type":"address","value":"`+to.Hex()+`"},{"name":"value","type":"uint256","value":"42"}]`; got != expected {
+		t.Fatalf("expected arguments %s, got %s", expected, got)
+	}
+
+	if _, err := DecodeLog(contract, topics[:2], data); err == nil {
+		t.Fatalf("expected event with missing topic to be rejected")
+	}
+
+	// Indexed dynamic value is only available as its hash
+	data, err = contract.Events["Named"].Inputs.NonIndexed().Pack(from)
+	if err != nil {
+		t.Fatalf("failed to encode event data : %s", err.Error())
+	}
+
+	hash := common.HexToHash("0x1234")
+
+	decoded, err = DecodeLog(contract, []string{contract.Events["Named"].ID.Hex(), hash.Hex()}, data)
+	if err != nil {
+		t.Fatalf("failed to decode event : %s", err.Error())
+	}
+
+	if got, expected := encoded(t, decoded.Arguments), `[{"name":"name","type":"string","value":"`+hash.Hex()+`"},{"name":"owner","type":"address","value":"`+from.Hex()+`"}]`; got != expected {
+		t.Fatalf("expected arguments %s, got %s", expected, got)
+	}
+
+}
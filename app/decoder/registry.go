@@ -0,0 +1,184 @@
+package decoder
+
+import (
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	d "github.com/denniswon/validationcloud/app/data"
+	"github.com/denniswon/validationcloud/app/db"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"gorm.io/gorm"
+)
+
+// cacheTTL - For how long parsed ABI of contract ( or absence of it ) is remembered,
+// before looking it up in DB again, so that ABI(s) registered via some other
+// instance of service also get picked up
+const cacheTTL = time.Minute
+
+// cached - ABI of contract, as found in DB, nil if none registered
+type cached struct {
+	contract  *abi.ABI
+	fetchedAt time.Time
+}
+
+var (
+	cache     = make(map[common.Address]*cached)
+	cacheLock sync.RWMutex
+)
+
+// remember - Puts parsed ABI of contract in cache
+func remember(address common.Address, contract *abi.ABI) {
+
+	cacheLock.Lock()
+	defer cacheLock.Unlock()
+
+	cache[address] = &cached{contract: contract, fetchedAt: time.Now()}
+
+}
+
+// Register - Validates & persists ABI of contract, replacing one registered
+// previously, if any
+func Register(_db *gorm.DB, address common.Address, raw []byte) error {
+
+	contract, err := Parse(raw)
+	if err != nil {
+		return err
+	}
+
+	if err := db.UpsertABI(_db, &db.ABIs{
+		Address:   address.Hex(),
+		ABI:       raw,
+		UpdatedAt: uint64(time.Now().UTC().Unix()),
+	}); err != nil {
+		return err
+	}
+
+	remember(address, contract)
+	return nil
+
+}
+
+// Unregister - Removes ABI registered for contract, returns whether
+// anything got removed or not
+func Unregister(_db *gorm.DB, address common.Address) (bool, error) {
+
+	removed, err := db.DeleteABI(_db, address)
+	if err != nil {
+		return false, err
+	}
+
+	remember(address, nil)
+	return removed, nil
+
+}
+
+// Lookup - Finds out ABI registered for contract, first in cache, then in DB,
+// returns nil if none registered
+func Lookup(_db *gorm.DB, address common.Address) *abi.ABI {
+
+	cacheLock.RLock()
+	entry, ok := cache[address]
+	cacheLock.RUnlock()
+
+	if ok && time.Since(entry.fetchedAt) < cacheTTL {
+		return entry.contract
+	}
+
+	if _db == nil {
+		return nil
+	}
+
+	var contract *abi.ABI
+
+	if registered := db.GetABI(_db, address); registered != nil {
+
+		_contract, err := Parse(registered.ABI)
+		if err != nil {
+			log.Printf("[!] Failed to parse ABI registered for %s : %s\n", address.Hex(), err.Error())
+		}
+
+		contract = _contract
+
+	}
+
+	remember(address, contract)
+	return contract
+
+}
+
+// DecodeTransaction - Decodes calldata of tx, if ABI of invoked contract
+// is registered, otherwise returns nil
+func DecodeTransaction(_db *gorm.DB, tx *d.Transaction) *d.Decoded {
+
+	if tx == nil || len(tx.Data) < 4 || !strings.HasPrefix(tx.To, "0x") || strings.HasPrefix(tx.Contract, "0x") {
+		return nil
+	}
+
+	contract := Lookup(_db, common.HexToAddress(tx.To))
+	if contract == nil {
+		return nil
+	}
+
+	decoded, err := DecodeCalldata(contract, tx.Data)
+	if err != nil {
+		return nil
+	}
+
+	return decoded
+
+}
+
+// DecodeEvent - Decodes event log, if ABI of emitting contract is registered,
+// otherwise returns nil
+func DecodeEvent(_db *gorm.DB, event *d.Event) *d.Decoded {
+
+	if event == nil || len(event.Topics) == 0 {
+		return nil
+	}
+
+	contract := Lookup(_db, common.HexToAddress(event.Origin))
+	if contract == nil {
+		return nil
+	}
+
+	decoded, err := DecodeLog(contract, event.Topics, event.Data)
+	if err != nil {
+		return nil
+	}
+
+	return decoded
+
+}
+
+// Decorate - Attaches decoded form/ guessed signature to each tx/ event,
+// being delivered to client
+func Decorate(_db *gorm.DB, v interface{}) {
+
+	switch v := v.(type) {
+
+	case *d.Transaction:
+		AnnotateTransaction(_db, v)
+
+	case *d.Transactions:
+		if v != nil {
+			for _, tx := range v.Transactions {
+				AnnotateTransaction(_db, tx)
+			}
+		}
+
+	case *d.Event:
+		AnnotateEvent(_db, v)
+
+	case *d.Events:
+		if v != nil {
+			for _, event := range v.Events {
+				AnnotateEvent(_db, event)
+			}
+		}
+
+	}
+
+}
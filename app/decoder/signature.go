@@ -0,0 +1,524 @@
+package decoder
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	d "github.com/denniswon/validationcloud/app/data"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"gorm.io/gorm"
+)
+
+//go:embed signatures.txt
+var embeddedSignatures []byte
+
+// SignatureDB - Text signatures of methods & events, keyed by 4-byte method selector
+// & 32-byte event topic respectively, multiple signatures can collide on same key
+type SignatureDB struct {
+	methods map[[4]byte][]string
+	events  map[common.Hash][]string
+	lock    sync.RWMutex
+}
+
+// NewSignatureDB - Empty signature database
+func NewSignatureDB() *SignatureDB {
+	return &SignatureDB{
+		methods: make(map[[4]byte][]string),
+		events:  make(map[common.Hash][]string),
+	}
+}
+
+// signatures - Signature database used for annotating tx(s) & event(s), seeded
+// with signatures shipped along with binary
+var signatures = NewSignatureDB()
+
+func init() {
+
+	if _, err := signatures.Import(bytes.NewReader(embeddedSignatures)); err != nil {
+		log.Fatalf("[!] Failed to load embedded signatures : %s\n", err.Error())
+	}
+
+}
+
+// insert - Puts signature under key, unless it's already there, returns
+// whether it was inserted or not
+func insert(list []string, signature string) ([]string, bool) {
+
+	for _, v := range list {
+		if v == signature {
+			return list, false
+		}
+	}
+
+	return append(list, signature), true
+
+}
+
+// Add - Adds text signature to database, deriving both method selector &
+// event topic from it, returns whether anything new got added
+func (s *SignatureDB) Add(signature string) (bool, error) {
+
+	signature, err := canonical(signature)
+	if err != nil {
+		return false, err
+	}
+
+	hash := crypto.Keccak256Hash([]byte(signature))
+
+	var selector [4]byte
+	copy(selector[:], hash[:4])
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var method, event bool
+
+	s.methods[selector], method = insert(s.methods[selector], signature)
+	s.events[hash], event = insert(s.events[hash], signature)
+
+	return method || event, nil
+
+}
+
+// AddWithKey - Adds text signature under explicitly given 4-byte method selector
+// or 32-byte event topic, returns whether it got added
+func (s *SignatureDB) AddWithKey(key []byte, signature string) (bool, error) {
+
+	signature, err := canonical(signature)
+	if err != nil {
+		return false, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var added bool
+
+	switch len(key) {
+
+	case 4:
+		var selector [4]byte
+		copy(selector[:], key)
+
+		s.methods[selector], added = insert(s.methods[selector], signature)
+
+	case 32:
+		topic := common.BytesToHash(key)
+
+		s.events[topic], added = insert(s.events[topic], signature)
+
+	default:
+		return false, fmt.Errorf("expected 4-byte selector or 32-byte topic, found %d bytes", len(key))
+
+	}
+
+	return added, nil
+
+}
+
+// Import - Reads signatures line by line & adds them to database, returns how many
+// new ones got added
+//
+// Each line is either text signature or text signature prefixed with hex encoded
+// selector/ topic, separated by whitespace, empty lines & lines starting with `#` are skipped
+func (s *SignatureDB) Import(reader io.Reader) (int, error) {
+
+	scanner := bufio.NewScanner(reader)
+
+	var count, line int
+
+	for scanner.Scan() {
+
+		line++
+
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		var added bool
+		var err error
+
+		if fields := strings.Fields(text); len(fields) > 1 && strings.HasPrefix(fields[0], "0x") {
+
+			key, _err := hexutil.Decode(fields[0])
+			if _err != nil {
+				return count, fmt.Errorf("line %d : bad selector/ topic : %s", line, _err.Error())
+			}
+
+			added, err = s.AddWithKey(key, strings.Join(fields[1:], ""))
+
+		} else {
+			added, err = s.Add(text)
+		}
+
+		if err != nil {
+			return count, fmt.Errorf("line %d : %s", line, err.Error())
+		}
+
+		if added {
+			count++
+		}
+
+	}
+
+	return count, scanner.Err()
+
+}
+
+// Methods - Text signatures of methods having given selector, sorted
+func (s *SignatureDB) Methods(selector []byte) []string {
+
+	if len(selector) < 4 {
+		return nil
+	}
+
+	var key [4]byte
+	copy(key[:], selector)
+
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return sorted(s.methods[key])
+
+}
+
+// Events - Text signatures of events having given topic, sorted
+func (s *SignatureDB) Events(topic common.Hash) []string {
+
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return sorted(s.events[topic])
+
+}
+
+// sorted - Sorted copy of signatures
+func sorted(list []string) []string {
+
+	if len(list) == 0 {
+		return nil
+	}
+
+	_list := make([]string, len(list))
+	copy(_list, list)
+	sort.Strings(_list)
+
+	return _list
+
+}
+
+// ImportSignatures - Imports signatures into signature database, used for annotating
+// tx(s) & event(s), returns how many new ones got added
+func ImportSignatures(reader io.Reader) (int, error) {
+	return signatures.Import(reader)
+}
+
+// ImportSignatureFile - Imports signatures from local file into signature database
+func ImportSignatureFile(file string) (int, error) {
+
+	fd, err := os.Open(file)
+	if err != nil {
+		return 0, err
+	}
+
+	defer fd.Close()
+
+	return signatures.Import(fd)
+
+}
+
+// GuessMethod - Looks up candidate signatures of method invoked by tx input,
+// when multiple ones collide, keeps only those which input can be decoded with
+func (s *SignatureDB) GuessMethod(input []byte) *d.Signature {
+
+	candidates := s.Methods(input)
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	if len(candidates) > 1 {
+
+		matching := make([]string, 0, len(candidates))
+
+		for _, v := range candidates {
+			if decodable(v, input[4:]) {
+				matching = append(matching, v)
+			}
+		}
+
+		// Input doesn't conform to any of them, so keeping all
+		if len(matching) != 0 {
+			candidates = matching
+		}
+
+	}
+
+	return signatureOf(candidates)
+
+}
+
+// GuessEvent - Looks up candidate signatures of event, using its first topic
+func (s *SignatureDB) GuessEvent(topics []string) *d.Signature {
+
+	if len(topics) == 0 {
+		return nil
+	}
+
+	candidates := s.Events(common.HexToHash(topics[0]))
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	return signatureOf(candidates)
+
+}
+
+// signatureOf - Given all candidate signatures, name is set when all of them agree
+// on it, while signature is set only when it's unambiguous
+func signatureOf(candidates []string) *d.Signature {
+
+	name := candidates[0][:strings.Index(candidates[0], "(")]
+
+	for _, v := range candidates[1:] {
+		if v[:strings.Index(v, "(")] != name {
+			name = ""
+			break
+		}
+	}
+
+	signature := &d.Signature{
+		Name:       name,
+		Ambiguous:  len(candidates) > 1,
+		Candidates: candidates,
+	}
+
+	if !signature.Ambiguous {
+		signature.Signature = candidates[0]
+	}
+
+	return signature
+
+}
+
+// decodable - Checks whether method arguments can be decoded from input, using
+// argument types in signature, while consuming whole input
+func decodable(signature string, input []byte) bool {
+
+	args, err := argumentsOf(signature)
+	if err != nil {
+		return false
+	}
+
+	values, err := args.Unpack(input)
+	if err != nil {
+		return false
+	}
+
+	encoded, err := args.Pack(values...)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(encoded, input)
+
+}
+
+// GuessTransaction - Best-effort guess of method invoked by tx, using signature database
+func GuessTransaction(tx *d.Transaction) *d.Signature {
+
+	if tx == nil || len(tx.Data) < 4 || strings.HasPrefix(tx.Contract, "0x") {
+		return nil
+	}
+
+	return signatures.GuessMethod(tx.Data)
+
+}
+
+// GuessEvent - Best-effort guess of emitted event, using signature database
+func GuessEvent(event *d.Event) *d.Signature {
+
+	if event == nil {
+		return nil
+	}
+
+	return signatures.GuessEvent(event.Topics)
+
+}
+
+// AnnotateTransaction - Decodes tx calldata using registered ABI, if any, otherwise
+// attempts to guess invoked method using signature database
+func AnnotateTransaction(_db *gorm.DB, tx *d.Transaction) {
+
+	if tx == nil {
+		return
+	}
+
+	tx.Decoded = DecodeTransaction(_db, tx)
+	if tx.Decoded == nil {
+		tx.Signature = GuessTransaction(tx)
+	}
+
+}
+
+// AnnotateEvent - Decodes event log using registered ABI, if any, otherwise
+// attempts to guess emitted event using signature database
+func AnnotateEvent(_db *gorm.DB, event *d.Event) {
+
+	if event == nil {
+		return
+	}
+
+	event.Decoded = DecodeEvent(_db, event)
+	if event.Decoded == nil {
+		event.Signature = GuessEvent(event)
+	}
+
+}
+
+// canonical - Validates text signature & strips whitespaces out of it
+func canonical(signature string) (string, error) {
+
+	signature = strings.Join(strings.Fields(signature), "")
+
+	if _, err := argumentsOf(signature); err != nil {
+		return "", err
+	}
+
+	return signature, nil
+
+}
+
+// argumentsOf - Parses argument types out of text signature, where
+// tuples are put in parenthesis
+func argumentsOf(signature string) (abi.Arguments, error) {
+
+	open := strings.Index(signature, "(")
+	if open <= 0 || !strings.HasSuffix(signature, ")") {
+		return nil, fmt.Errorf("bad signature %q", signature)
+	}
+
+	types, err := splitTypes(signature[open+1 : len(signature)-1])
+	if err != nil {
+		return nil, fmt.Errorf("bad signature %q : %s", signature, err.Error())
+	}
+
+	args := make(abi.Arguments, len(types))
+
+	for k, v := range types {
+
+		marshalling, err := marshallingOf(v)
+		if err != nil {
+			return nil, fmt.Errorf("bad signature %q : %s", signature, err.Error())
+		}
+
+		_type, err := abi.NewType(marshalling.Type, "", marshalling.Components)
+		if err != nil {
+			return nil, fmt.Errorf("bad signature %q : %s", signature, err.Error())
+		}
+
+		args[k] = abi.Argument{Name: fmt.Sprintf("arg%d", k), Type: _type}
+
+	}
+
+	return args, nil
+
+}
+
+// marshallingOf - Argument type, in form ABI type constructor accepts, tuple
+// components get named after their position
+func marshallingOf(_type string) (abi.ArgumentMarshaling, error) {
+
+	if !strings.HasPrefix(_type, "(") {
+		return abi.ArgumentMarshaling{Type: _type}, nil
+	}
+
+	closing := strings.LastIndex(_type, ")")
+
+	types, err := splitTypes(_type[1:closing])
+	if err != nil {
+		return abi.ArgumentMarshaling{}, err
+	}
+
+	components := make([]abi.ArgumentMarshaling, len(types))
+
+	for k, v := range types {
+
+		component, err := marshallingOf(v)
+		if err != nil {
+			return abi.ArgumentMarshaling{}, err
+		}
+
+		component.Name = fmt.Sprintf("field%d", k)
+		components[k] = component
+
+	}
+
+	return abi.ArgumentMarshaling{
+		Type:       "tuple" + _type[closing+1:],
+		Components: components,
+	}, nil
+
+}
+
+// splitTypes - Splits comma separated argument types, while keeping
+// tuple types intact
+func splitTypes(list string) ([]string, error) {
+
+	if list == "" {
+		return nil, nil
+	}
+
+	var types []string
+	var depth, start int
+
+	for k, v := range list {
+
+		switch v {
+
+		case '(':
+			depth++
+
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, errors.New("unbalanced parenthesis")
+			}
+
+		case ',':
+			if depth == 0 {
+				types = append(types, list[start:k])
+				start = k + 1
+			}
+
+		}
+
+	}
+
+	if depth != 0 {
+		return nil, errors.New("unbalanced parenthesis")
+	}
+
+	types = append(types, list[start:])
+
+	for _, v := range types {
+		if v == "" {
+			return nil, errors.New("empty argument type")
+		}
+	}
+
+	return types, nil
+
+}
@@ -0,0 +1,108 @@
+package decoder
+
+import (
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+func TestEmbeddedSignatures(t *testing.T) {
+
+	signature := signatures.GuessMethod(hexutil.MustDecode("0xa9059cbb"))
+	if signature == nil || signature.Signature != "transfer(address,uint256)" || signature.Ambiguous {
+		t.Fatalf("expected `transfer` to be found, got %+v", signature)
+	}
+
+	signature = signatures.GuessEvent([]string{"0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"})
+	if signature == nil || signature.Signature != "Transfer(address,address,uint256)" {
+		t.Fatalf("expected `Transfer` event to be found, got %+v", signature)
+	}
+
+	if signatures.GuessMethod(hexutil.MustDecode("0xdeadbeef")) != nil {
+		t.Fatalf("expected unknown selector to be left alone")
+	}
+
+}
+
+func TestSignatureCollision(t *testing.T) {
+
+	db := NewSignatureDB()
+	selector := hexutil.MustDecode("0x12345678")
+
+	count, err := db.Import(strings.NewReader(`
+		# colliding ones
+		0x12345678 foo(uint256)
+		0x12345678 bar(address, bytes)
+		0x12345678 foo(int256)
+		0x12345678 foo(uint256)
+	`))
+	if err != nil {
+		t.Fatalf("failed to import signatures : %s", err.Error())
+	}
+
+	if count != 3 {
+		t.Fatalf("expected 3 signatures imported, got %d", count)
+	}
+
+	args, _ := argumentsOf("bar(address,bytes)")
+	input, err := args.Pack(common.HexToAddress("0x1"), []byte{1, 2, 3})
+	if err != nil {
+		t.Fatalf("failed to encode input : %s", err.Error())
+	}
+
+	// Only one of them can decode input
+	signature := db.GuessMethod(append(selector, input...))
+	if signature.Ambiguous || signature.Signature != "bar(address,bytes)" || signature.Name != "bar" {
+		t.Fatalf("expected collision to be resolved, got %+v", signature)
+	}
+
+	// Input conforming to both `foo`(s)
+	args, _ = argumentsOf("foo(uint256)")
+	input, _ = args.Pack(big.NewInt(1))
+
+	signature = db.GuessMethod(append(selector, input...))
+	if !signature.Ambiguous || signature.Signature != "" || signature.Name != "foo" {
+		t.Fatalf("expected ambiguous `foo`, got %+v", signature)
+	}
+
+	if !reflect.DeepEqual(signature.Candidates, []string{"foo(int256)", "foo(uint256)"}) {
+		t.Fatalf("unexpected candidates %v", signature.Candidates)
+	}
+
+	// Input conforming to none, all are kept
+	signature = db.GuessMethod(append(selector, 1))
+	if !signature.Ambiguous || signature.Name != "" || len(signature.Candidates) != 3 {
+		t.Fatalf("expected all candidates to be kept, got %+v", signature)
+	}
+
+}
+
+func TestImportSignatures(t *testing.T) {
+
+	for _, v := range []string{"foo", "foo(uint256", "(uint256)", "foo(uint256,)", "foo(unknown)", "0x1234 foo()", "0xzz foo()"} {
+		if _, err := NewSignatureDB().Import(strings.NewReader(v)); err == nil {
+			t.Errorf("expected %q to be rejected", v)
+		}
+	}
+
+	db := NewSignatureDB()
+
+	if _, err := db.Import(strings.NewReader("swap((address,uint24)[],bytes32[2])")); err != nil {
+		t.Fatalf("failed to import signature with tuple : %s", err.Error())
+	}
+
+	signature := db.GuessEvent([]string{common.Hash{}.Hex()})
+	if signature != nil {
+		t.Fatalf("expected unknown topic to be left alone")
+	}
+
+	args, err := argumentsOf("swap((address,uint24)[],bytes32[2])")
+	if err != nil || len(args) != 2 || args[0].Type.String() != "(address,uint24)[]" || args[1].Type.String() != "bytes32[2]" {
+		t.Fatalf("failed to parse argument types of signature")
+	}
+
+}
@@ -3,6 +3,7 @@ package pubsub
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"log"
 	"sync"
 	"time"
@@ -16,6 +17,7 @@ import (
 // and client connected using websocket needs to be delivered this piece of data
 type BlockConsumer struct {
 	Client     *redis.Client
+	Topic      string
 	Requests   map[string]*SubscriptionRequest
 	Connection *websocket.Conn
 	PubSub     *redis.PubSub
@@ -26,7 +28,7 @@ type BlockConsumer struct {
 
 // Subscribe - Subscribe to `block` channel
 func (b *BlockConsumer) Subscribe() {
-	b.PubSub = b.Client.Subscribe(context.Background(), "block")
+	b.PubSub = b.Client.Subscribe(context.Background(), b.Topic)
 }
 
 // Listen - Listener function, which keeps looping in infinite loop
@@ -52,7 +54,7 @@ func (b *BlockConsumer) Listen() {
 
 			b.SendData(&SubscriptionResponse{
 				Code:    1,
-				Message: "Subscribed to `block`",
+				Message: fmt.Sprintf("Subscribed to `%s`", b.Topic),
 			})
 
 		case *redis.Message:
@@ -106,6 +108,13 @@ func (b *BlockConsumer) Send(msg string) {
 		TransactionRootHash string  `json:"txRootHash"`
 		ReceiptRootHash     string  `json:"receiptRootHash"`
 		ExtraData           string  `json:"extraData"`
+		BaseFee             string  `json:"baseFee"`
+		BlobGasUsed         uint64  `json:"blobGasUsed"`
+		ExcessBlobGas       uint64  `json:"excessBlobGas"`
+		WithdrawalsRootHash string  `json:"withdrawalsRoot"`
+		Chain               uint64  `json:"chain"`
+		Finality            string  `json:"finality,omitempty"`
+		Removed             bool    `json:"removed,omitempty"`
 	}
 
 	_msg := []byte(msg)
@@ -134,7 +143,7 @@ func (b *BlockConsumer) SendData(data interface{}) bool {
 	defer b.ConnLock.Unlock()
 
 	if err := b.Connection.WriteJSON(data); err != nil {
-		log.Printf("[!] Failed to deliver `block` data to client : %s\n", err.Error())
+		log.Printf("[!] Failed to deliver `%s` data to client : %s\n", b.Topic, err.Error())
 		return false
 	}
 
@@ -146,18 +155,18 @@ func (b *BlockConsumer) SendData(data interface{}) bool {
 func (b *BlockConsumer) Unsubscribe() {
 
 	if b.PubSub == nil {
-		log.Printf("[!] Bad attempt to unsubscribe from `block` topic\n")
+		log.Printf("[!] Bad attempt to unsubscribe from `%s` topic\n", b.Topic)
 		return
 	}
 
-	if err := b.PubSub.Unsubscribe(context.Background(), "block"); err != nil {
-		log.Printf("[!] Failed to unsubscribe from `block` topic : %s\n", err.Error())
+	if err := b.PubSub.Unsubscribe(context.Background(), b.Topic); err != nil {
+		log.Printf("[!] Failed to unsubscribe from `%s` topic : %s\n", b.Topic, err.Error())
 		return
 	}
 
 	resp := &SubscriptionResponse{
 		Code:    1,
-		Message: "Unsubscribed from `block`",
+		Message: fmt.Sprintf("Unsubscribed from `%s`", b.Topic),
 	}
 
 	// -- Critical section of code begins
@@ -169,7 +178,7 @@ func (b *BlockConsumer) Unsubscribe() {
 
 	if err := b.Connection.WriteJSON(resp); err != nil {
 
-		log.Printf("[!] Failed to deliver `block` unsubscription confirmation to client : %s\n", err.Error())
+		log.Printf("[!] Failed to deliver `%s` unsubscription confirmation to client : %s\n", b.Topic, err.Error())
 		return
 
 	}
@@ -8,7 +8,7 @@ import (
 	"gorm.io/gorm"
 )
 
-// Consumer - Block, transaction & event consumers need to implement these methods
+// Consumer - Block, transaction, event & withdrawal consumers need to implement these methods
 type Consumer interface {
 	Subscribe()
 	Listen()
@@ -20,9 +20,10 @@ type Consumer interface {
 // NewBlockConsumer - Creating one new block data consumer, which will subscribe to block
 // topic & listen for data being published on this channel, which will eventually be
 // delivered to client application over websocket connection
-func NewBlockConsumer(client *redis.Client, requests map[string]*SubscriptionRequest, conn *websocket.Conn, db *gorm.DB, connLock *sync.Mutex, topicLock *sync.RWMutex) *BlockConsumer {
+func NewBlockConsumer(client *redis.Client, topic string, requests map[string]*SubscriptionRequest, conn *websocket.Conn, db *gorm.DB, connLock *sync.Mutex, topicLock *sync.RWMutex) *BlockConsumer {
 	consumer := BlockConsumer{
 		Client:     client,
+		Topic:      topic,
 		Requests:   requests,
 		Connection: conn,
 		DB:         db,
@@ -40,9 +41,10 @@ func NewBlockConsumer(client *redis.Client, requests map[string]*SubscriptionReq
 // topic & listen for data being published on this channel & check whether received data
 // is what, client is interested in or not, which will eventually be
 // delivered to client application over websocket connection
-func NewTransactionConsumer(client *redis.Client, requests map[string]*SubscriptionRequest, conn *websocket.Conn, db *gorm.DB, connLock *sync.Mutex, topicLock *sync.RWMutex) *TransactionConsumer {
+func NewTransactionConsumer(client *redis.Client, topic string, requests map[string]*SubscriptionRequest, conn *websocket.Conn, db *gorm.DB, connLock *sync.Mutex, topicLock *sync.RWMutex) *TransactionConsumer {
 	consumer := TransactionConsumer{
 		Client:     client,
+		Topic:      topic,
 		Requests:   requests,
 		Connection: conn,
 		DB:         db,
@@ -60,9 +62,31 @@ func NewTransactionConsumer(client *redis.Client, requests map[string]*Subscript
 // topic & listen for data being published on this channel & check whether received data
 // is what, client is interested in or not, which will eventually be
 // delivered to client application over websocket connection
-func NewEventConsumer(client *redis.Client, requests map[string]*SubscriptionRequest, conn *websocket.Conn, db *gorm.DB, connLock *sync.Mutex, topicLock *sync.RWMutex) *EventConsumer {
+func NewEventConsumer(client *redis.Client, topic string, requests map[string]*SubscriptionRequest, conn *websocket.Conn, db *gorm.DB, connLock *sync.Mutex, topicLock *sync.RWMutex) *EventConsumer {
 	consumer := EventConsumer{
 		Client:     client,
+		Topic:      topic,
+		Requests:   requests,
+		Connection: conn,
+		DB:         db,
+		ConnLock:   connLock,
+		TopicLock:  topicLock,
+	}
+
+	consumer.Subscribe()
+	go consumer.Listen()
+
+	return &consumer
+}
+
+// NewWithdrawalConsumer - Creating one new withdrawal data consumer, which will subscribe to withdrawal
+// topic & listen for data being published on this channel & check whether received data
+// is what, client is interested in or not, which will eventually be
+// delivered to client application over websocket connection
+func NewWithdrawalConsumer(client *redis.Client, topic string, requests map[string]*SubscriptionRequest, conn *websocket.Conn, db *gorm.DB, connLock *sync.Mutex, topicLock *sync.RWMutex) *WithdrawalConsumer {
+	consumer := WithdrawalConsumer{
+		Client:     client,
+		Topic:      topic,
 		Requests:   requests,
 		Connection: conn,
 		DB:         db,
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/denniswon/validationcloud/app/data"
 	"github.com/go-redis/redis/v8"
 	"github.com/gorilla/websocket"
 	"gorm.io/gorm"
@@ -16,8 +17,8 @@ import (
 // over same websocket connection, one new pubsub subscription
 // may not be created
 //
-// For each client there could be possibly at max 3 pubsub subscriptions
-// i.e. block, transaction, event, which are considered to be top level
+// For each client there could be possibly at max 4 pubsub subscriptions
+// i.e. block, transaction, event, withdrawal, which are considered to be top level
 // topics
 //
 // For each of them there could be multiple subtopics but not explicit
@@ -25,9 +26,13 @@ import (
 //
 // This is being done for reducing redundant pressure on pubsub
 // broker i.e. Redis here 🥳
+//
+// Topics of each chain being indexed are kept apart, so both of associative
+// arrays are keyed by chain scoped pubsub channel i.e. `<chainID>/block`
 type SubscriptionManager struct {
 	Topics     map[string]map[string]*SubscriptionRequest
 	Consumers  map[string]Consumer
+	Chains     data.Chains
 	Client     *redis.Client
 	Connection *websocket.Conn
 	DB         *gorm.DB
@@ -43,33 +48,37 @@ func (s *SubscriptionManager) Subscribe(req *SubscriptionRequest) {
 	s.TopicLock.Lock()
 	defer s.TopicLock.Unlock()
 
-	_, ok := s.Topics[req.Topic()]
+	channel := req.Channel()
+
+	_, ok := s.Topics[channel]
 	if !ok {
 
 		tmp := make(map[string]*SubscriptionRequest)
 		tmp[req.Name] = req
 
-		s.Topics[req.Topic()] = tmp
+		s.Topics[channel] = tmp
 
 		switch req.Topic() {
 
 		case "block":
-			s.Consumers[req.Topic()] = NewBlockConsumer(s.Client, tmp, s.Connection, s.DB, s.ConnLock, s.TopicLock)
+			s.Consumers[channel] = NewBlockConsumer(s.Client, channel, tmp, s.Connection, s.DB, s.ConnLock, s.TopicLock)
 		case "transaction":
-			s.Consumers[req.Topic()] = NewTransactionConsumer(s.Client, tmp, s.Connection, s.DB, s.ConnLock, s.TopicLock)
+			s.Consumers[channel] = NewTransactionConsumer(s.Client, channel, tmp, s.Connection, s.DB, s.ConnLock, s.TopicLock)
 		case "event":
-			s.Consumers[req.Topic()] = NewEventConsumer(s.Client, tmp, s.Connection, s.DB, s.ConnLock, s.TopicLock)
+			s.Consumers[channel] = NewEventConsumer(s.Client, channel, tmp, s.Connection, s.DB, s.ConnLock, s.TopicLock)
+		case "withdrawal":
+			s.Consumers[channel] = NewWithdrawalConsumer(s.Client, channel, tmp, s.Connection, s.DB, s.ConnLock, s.TopicLock)
 		}
 
 		return
 
 	}
 
-	s.Topics[req.Topic()][req.Name] = req
-	s.Consumers[req.Topic()].SendData(
+	s.Topics[channel][req.Name] = req
+	s.Consumers[channel].SendData(
 		&SubscriptionResponse{
 			Code:    1,
-			Message: fmt.Sprintf("Subscribed to `%s`", req.Topic()),
+			Message: fmt.Sprintf("Subscribed to `%s`", channel),
 		})
 
 }
@@ -88,26 +97,28 @@ func (s *SubscriptionManager) Unsubscribe(req *SubscriptionRequest) {
 	s.TopicLock.Lock()
 	defer s.TopicLock.Unlock()
 
-	_, ok := s.Topics[req.Topic()]
+	channel := req.Channel()
+
+	_, ok := s.Topics[channel]
 	if !ok {
 		return
 	}
 
-	delete(s.Topics[req.Topic()], req.Name)
+	delete(s.Topics[channel], req.Name)
 
-	if len(s.Topics[req.Topic()]) > 0 {
+	if len(s.Topics[channel]) > 0 {
 
-		s.Consumers[req.Topic()].SendData(
+		s.Consumers[channel].SendData(
 			&SubscriptionResponse{
 				Code:    1,
-				Message: fmt.Sprintf("Unsubscribed from `%s`", req.Topic()),
+				Message: fmt.Sprintf("Unsubscribed from `%s`", channel),
 			})
 		return
 
 	}
 
-	s.Consumers[req.Topic()].Unsubscribe()
-	delete(s.Topics, req.Topic())
-	delete(s.Consumers, req.Topic())
+	s.Consumers[channel].Unsubscribe()
+	delete(s.Topics, channel)
+	delete(s.Consumers, channel)
 
 }
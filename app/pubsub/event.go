@@ -4,11 +4,13 @@ import (
 	"context"
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"log"
 	"sync"
 	"time"
 
 	d "github.com/denniswon/validationcloud/app/data"
+	"github.com/denniswon/validationcloud/app/decoder"
 	"github.com/lib/pq"
 	"gorm.io/gorm"
 
@@ -22,6 +24,7 @@ import (
 // has really requested notification for this event or not
 type EventConsumer struct {
 	Client     *redis.Client
+	Topic      string
 	Requests   map[string]*SubscriptionRequest
 	Connection *websocket.Conn
 	PubSub     *redis.PubSub
@@ -33,7 +36,7 @@ type EventConsumer struct {
 // Subscribe - Event consumer is subscribing to `event` topic,
 // where all event related data to be published
 func (e *EventConsumer) Subscribe() {
-	e.PubSub = e.Client.Subscribe(context.Background(), "event")
+	e.PubSub = e.Client.Subscribe(context.Background(), e.Topic)
 }
 
 // Listen - Polling for new data published in `event` topic periodically
@@ -60,7 +63,7 @@ func (e *EventConsumer) Listen() {
 
 			e.SendData(&SubscriptionResponse{
 				Code:    1,
-				Message: "Subscribed to `event`",
+				Message: fmt.Sprintf("Subscribed to `%s`", e.Topic),
 			})
 
 		case *redis.Message:
@@ -83,6 +86,9 @@ func (e *EventConsumer) Send(msg string) {
 		Data            string         `json:"data"`
 		TransactionHash string         `json:"txHash"`
 		BlockHash       string         `json:"blockHash"`
+		Chain           uint64         `json:"chain"`
+		Decoded         *d.Decoded     `json:"decoded,omitempty"`
+		Removed         bool           `json:"removed,omitempty"`
 	}
 
 	_msg := []byte(msg)
@@ -111,6 +117,8 @@ func (e *EventConsumer) Send(msg string) {
 		Data:            data,
 		TransactionHash: event.TransactionHash,
 		BlockHash:       event.BlockHash,
+		Chain:           event.Chain,
+		Removed:         event.Removed,
 	}
 
 	var request *SubscriptionRequest
@@ -136,6 +144,7 @@ func (e *EventConsumer) Send(msg string) {
 		return
 	}
 
+	event.Decoded = decoder.DecodeEvent(e.DB, _event)
 	e.SendData(&event)
 
 }
@@ -154,7 +163,7 @@ func (e *EventConsumer) SendData(data interface{}) bool {
 	defer e.ConnLock.Unlock()
 
 	if err := e.Connection.WriteJSON(data); err != nil {
-		log.Printf("[!] Failed to deliver `event` data to client : %s\n", err.Error())
+		log.Printf("[!] Failed to deliver `%s` data to client : %s\n", e.Topic, err.Error())
 		return false
 	}
 
@@ -168,18 +177,18 @@ func (e *EventConsumer) SendData(data interface{}) bool {
 func (e *EventConsumer) Unsubscribe() {
 
 	if e.PubSub == nil {
-		log.Printf("[!] Bad attempt to unsubscribe from `event` topic\n")
+		log.Printf("[!] Bad attempt to unsubscribe from `%s` topic\n", e.Topic)
 		return
 	}
 
-	if err := e.PubSub.Unsubscribe(context.Background(), "event"); err != nil {
-		log.Printf("[!] Failed to unsubscribe from `event` topic : %s\n", err.Error())
+	if err := e.PubSub.Unsubscribe(context.Background(), e.Topic); err != nil {
+		log.Printf("[!] Failed to unsubscribe from `%s` topic : %s\n", e.Topic, err.Error())
 		return
 	}
 
 	resp := &SubscriptionResponse{
 		Code:    1,
-		Message: "Unsubscribed from `event`",
+		Message: fmt.Sprintf("Unsubscribed from `%s`", e.Topic),
 	}
 
 	// -- Critical section of code begins
@@ -191,7 +200,7 @@ func (e *EventConsumer) Unsubscribe() {
 
 	if err := e.Connection.WriteJSON(resp); err != nil {
 
-		log.Printf("[!] Failed to deliver `event` unsubscription confirmation to client : %s\n", err.Error())
+		log.Printf("[!] Failed to deliver `%s` unsubscription confirmation to client : %s\n", e.Topic, err.Error())
 		return
 
 	}
@@ -11,14 +11,18 @@ import (
 
 // SubscriptionRequest - Real time data subscription/ unsubscription request
 // needs to be sent in this form, from client application
+//
+// Name can be prefixed with name or chain ID of blockchain being indexed i.e.
+// `<chain>/block`, when not prefixed, first configured chain is subscribed to
 type SubscriptionRequest struct {
-	Name   string `json:"name"`
-	Type   string `json:"type"`
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	ChainID uint64 `json:"-"`
 }
 
 // GetRegex - Returns regex to be used for validating subscription request
 func (s *SubscriptionRequest) GetRegex() *regexp.Regexp {
-	pattern, err := regexp.Compile("^(block|(transaction(/(0x[a-zA-Z0-9]{40}|\\*)(/(0x[a-zA-Z0-9]{40}|\\*))?)?)|(event(/(0x[a-zA-Z0-9]{40}|\\*)(/(0x[a-zA-Z0-9]{64}|\\*)(/(0x[a-zA-Z0-9]{64}|\\*)(/(0x[a-zA-Z0-9]{64}|\\*)(/(0x[a-zA-Z0-9]{64}|\\*))?)?)?)?)?))$")
+	pattern, err := regexp.Compile("^(?:([a-zA-Z0-9_-]+)/)?(block|(transaction(/(0x[a-zA-Z0-9]{40}|\\*)(/(0x[a-zA-Z0-9]{40}|\\*))?)?)|(event(/(0x[a-zA-Z0-9]{40}|\\*)(/(0x[a-zA-Z0-9]{64}|\\*)(/(0x[a-zA-Z0-9]{64}|\\*)(/(0x[a-zA-Z0-9]{64}|\\*)(/(0x[a-zA-Z0-9]{64}|\\*))?)?)?)?)?)|(withdrawal(/(0x[a-zA-Z0-9]{40}|\\*))?))$")
 	if err != nil {
 		log.Printf("[!] Failed to parse regex pattern : %s\n", err.Error())
 		return nil
@@ -27,29 +31,61 @@ func (s *SubscriptionRequest) GetRegex() *regexp.Regexp {
 	return pattern
 }
 
+// Chain - Name or chain ID of blockchain, this client is subscribing to, as
+// prefixed to topic name, empty if not prefixed
+func (s *SubscriptionRequest) Chain() string {
+	pattern := s.GetRegex()
+	if pattern == nil {
+		return ""
+	}
+
+	matches := pattern.FindStringSubmatch(s.Name)
+	if matches == nil {
+		return ""
+	}
+
+	return matches[1]
+}
+
 // Topic - Get main topic name to which this client is subscribing to
-// i.e. {block, transaction, event}
+// i.e. {block, transaction, event, withdrawal}
 func (s *SubscriptionRequest) Topic() string {
-	if strings.HasPrefix(s.Name, "block") {
+	name := s.Name
+	if chain := s.Chain(); chain != "" {
+		name = strings.TrimPrefix(name, chain+"/")
+	}
+
+	if strings.HasPrefix(name, "block") {
 		return "block"
 	}
 
-	if strings.HasPrefix(s.Name, "transaction") {
+	if strings.HasPrefix(name, "transaction") {
 		return "transaction"
 	}
 
-	if strings.HasPrefix(s.Name, "event") {
+	if strings.HasPrefix(name, "event") {
 		return "event"
 	}
 
+	if strings.HasPrefix(name, "withdrawal") {
+		return "withdrawal"
+	}
+
 	return ""
 }
 
+// Channel - Pubsub channel, data of main topic gets published on, for
+// chain this client is subscribing to
+func (s *SubscriptionRequest) Channel() string {
+	return data.TopicOf(s.ChainID, s.Topic())
+}
+
 // GetLogEventFilters - Extracts contract address & topic signatures
 // from subscription request, which are to be used
 // for matching against published log event data
 //
-// Pattern looks like : `event/<address>/<topic0>/<topic1>/<topic2>/<topic3>`
+// Pattern looks like : `<chain>/event/<address>/<topic0>/<topic1>/<topic2>/<topic3>`, where
+// chain prefix is optional
 //
 // address : Contract address
 // topic{0,1,2,3} : topic signature
@@ -60,7 +96,7 @@ func (s *SubscriptionRequest) GetLogEventFilters() []string {
 	}
 
 	matches := pattern.FindStringSubmatch(s.Name)
-	return []string{matches[9], matches[11], matches[13], matches[15], matches[17]}
+	return []string{matches[10], matches[12], matches[14], matches[16], matches[18]}
 }
 
 // DoesMatchWithPublishedEventData  - All event channel listeners are going to get
@@ -133,7 +169,7 @@ func (s *SubscriptionRequest) GetTransactionFilters() []string {
 	}
 
 	matches := pattern.FindStringSubmatch(s.Name)
-	return []string{matches[4], matches[6]}
+	return []string{matches[5], matches[7]}
 }
 
 // CheckSimilarity - Performing case insensitive matching between two
@@ -198,6 +234,40 @@ func (s *SubscriptionRequest) DoesMatchWithPublishedTransactionData(tx *data.Tra
 	return status
 }
 
+// GetWithdrawalFilters - Extracts recipient address present in withdrawal subscription request
+//
+// this could possibly be empty/ * / 0x...
+func (s *SubscriptionRequest) GetWithdrawalFilters() []string {
+	pattern := s.GetRegex()
+	if pattern == nil {
+		return nil
+	}
+
+	matches := pattern.FindStringSubmatch(s.Name)
+	return []string{matches[21]}
+}
+
+// DoesMatchWithPublishedWithdrawalData - All `withdrawal` topic listeners are going to get
+// notified for each withdrawal processed in block, but only those credited to address, client
+// has subscribed to, to be delivered
+func (s *SubscriptionRequest) DoesMatchWithPublishedWithdrawalData(withdrawal *data.Withdrawal) bool {
+
+	filters := s.GetWithdrawalFilters()
+	if filters == nil {
+		return false
+	}
+
+	switch filters[0] {
+	// match with any recipient address
+	case "", "*":
+		return true
+	// match with provided recipient address
+	default:
+		return CheckSimilarity(filters[0], withdrawal.Address)
+	}
+
+}
+
 // IsValidTopic - Checks whether topic to which client application is trying to
 // subscribe to is valid one or not
 func (s *SubscriptionRequest) IsValidTopic() bool {
@@ -224,12 +294,12 @@ func (s *SubscriptionRequest) Validate(pubsubManager *SubscriptionManager) bool
 		pubsubManager.TopicLock.RLock()
 		defer pubsubManager.TopicLock.RUnlock()
 
-		_, ok := pubsubManager.Topics[s.Topic()]
+		_, ok := pubsubManager.Topics[s.Channel()]
 		if !ok {
 			return false
 		}
 
-		_v, ok := pubsubManager.Topics[s.Topic()][s.Name]
+		_v, ok := pubsubManager.Topics[s.Channel()][s.Name]
 		if !ok {
 			return false
 		}
@@ -239,6 +309,14 @@ func (s *SubscriptionRequest) Validate(pubsubManager *SubscriptionManager) bool
 	}
 	// ---
 
+	// Subscribing to chain which is not being indexed, isn't allowed
+	chain := pubsubManager.Chains.Find(s.Chain())
+	if chain == nil {
+		return false
+	}
+
+	s.ChainID = chain.ID
+
 	var validated bool
 
 	switch s.Type {
@@ -0,0 +1,97 @@
+package pubsub
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/denniswon/validationcloud/app/data"
+)
+
+func TestDoesMatchWithPublishedWithdrawalData(t *testing.T) {
+
+	withdrawal := &data.Withdrawal{Address: "0x4774fEd3f2838f504006BE53155cA9cbDDEe9f0c"}
+
+	cases := map[string]bool{
+		"withdrawal":   true,
+		"withdrawal/*": true,
+		"withdrawal/0x4774fed3f2838f504006be53155ca9cbddee9f0c": true,
+		"withdrawal/0xc9D50e0a571aDd06C7D5f1452DcE2F523FB711a1": false,
+	}
+
+	for name, expected := range cases {
+
+		req := &SubscriptionRequest{Name: name, Type: "subscribe"}
+
+		if !req.IsValidTopic() || req.Topic() != "withdrawal" {
+			t.Fatalf("expected %s to be valid withdrawal topic", name)
+		}
+
+		if matched := req.DoesMatchWithPublishedWithdrawalData(withdrawal); matched != expected {
+			t.Errorf("%s : expected match %v, got %v", name, expected, matched)
+		}
+
+	}
+
+	// Filters of other topics stay where they were
+	req := &SubscriptionRequest{Name: "transaction/0xc9D50e0a571aDd06C7D5f1452DcE2F523FB711a1/*"}
+	if filters := req.GetTransactionFilters(); filters[0] != "0xc9D50e0a571aDd06C7D5f1452DcE2F523FB711a1" || filters[1] != "*" {
+		t.Fatalf("bad transaction filters %v", filters)
+	}
+
+	if (&SubscriptionRequest{Name: "withdrawal/0x1"}).IsValidTopic() {
+		t.Fatalf("expected withdrawal topic with bad address to be invalid")
+	}
+
+}
+
+func TestSubscriptionRequestChain(t *testing.T) {
+
+	manager := &SubscriptionManager{
+		Topics:    make(map[string]map[string]*SubscriptionRequest),
+		Chains:    data.Chains{{ID: 1, Name: "ethereum"}, {ID: 137, Name: "polygon"}},
+		TopicLock: &sync.RWMutex{},
+	}
+
+	for _, v := range []struct {
+		name    string
+		valid   bool
+		topic   string
+		channel string
+	}{
+		{"block", true, "block", "1/block"},
+		{"polygon/block", true, "block", "137/block"},
+		{"Polygon/transaction/*/0xc9D50e0a571aDd06C7D5f1452DcE2F523FB711a1", true, "transaction", "137/transaction"},
+		{"137/event/*", true, "event", "137/event"},
+		{"ethereum/withdrawal", true, "withdrawal", "1/withdrawal"},
+		{"arbitrum/block", false, "", ""},
+		{"42161/block", false, "", ""},
+	} {
+
+		req := &SubscriptionRequest{Name: v.name, Type: "subscribe"}
+
+		if valid := req.Validate(manager); valid != v.valid {
+			t.Fatalf("%s : expected validity %v, got %v", v.name, v.valid, valid)
+		}
+
+		if !v.valid {
+			continue
+		}
+
+		if req.Topic() != v.topic || req.Channel() != v.channel {
+			t.Fatalf("%s : expected %s on %s, got %s on %s", v.name, v.topic, v.channel, req.Topic(), req.Channel())
+		}
+
+	}
+
+	// Filters are extracted from what follows chain prefix
+	req := &SubscriptionRequest{Name: "polygon/transaction/0xc9D50e0a571aDd06C7D5f1452DcE2F523FB711a1/*"}
+	if filters := req.GetTransactionFilters(); filters[0] != "0xc9D50e0a571aDd06C7D5f1452DcE2F523FB711a1" || filters[1] != "*" {
+		t.Fatalf("bad transaction filters %v", filters)
+	}
+
+	req = &SubscriptionRequest{Name: "1/withdrawal/0x4774fEd3f2838f504006BE53155cA9cbDDEe9f0c"}
+	if filters := req.GetWithdrawalFilters(); filters[0] != "0x4774fEd3f2838f504006BE53155cA9cbDDEe9f0c" {
+		t.Fatalf("bad withdrawal filters %v", filters)
+	}
+
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"log"
 	"sync"
 	"time"
 
 	d "github.com/denniswon/validationcloud/app/data"
+	"github.com/denniswon/validationcloud/app/decoder"
 	"github.com/go-redis/redis/v8"
 	"github.com/gorilla/websocket"
 	"gorm.io/gorm"
@@ -21,6 +23,7 @@ import (
 // If yes, also deliver data to client application, connected over websocket
 type TransactionConsumer struct {
 	Client     *redis.Client
+	Topic      string
 	Requests   map[string]*SubscriptionRequest
 	Connection *websocket.Conn
 	PubSub     *redis.PubSub
@@ -31,7 +34,7 @@ type TransactionConsumer struct {
 
 // Subscribe - Subscribe to `transaction` topic, under which all transaction related data to be published
 func (t *TransactionConsumer) Subscribe() {
-	t.PubSub = t.Client.Subscribe(context.Background(), "transaction")
+	t.PubSub = t.Client.Subscribe(context.Background(), t.Topic)
 }
 
 // Listen - Listener function, which keeps looping in infinite loop
@@ -57,7 +60,7 @@ func (t *TransactionConsumer) Listen() {
 
 			t.SendData(&SubscriptionResponse{
 				Code:    1,
-				Message: "Subscribed to `transaction`",
+				Message: fmt.Sprintf("Subscribed to `%s`", t.Topic),
 			})
 
 		case *redis.Message:
@@ -80,18 +83,34 @@ func (t *TransactionConsumer) Send(msg string) {
 	// Now as it's first decoded as string, then it'll converted to byte array
 	// if it's not a empty string
 	var transaction struct {
-		Hash      string `json:"hash"`
-		From      string `json:"from"`
-		To        string `json:"to"`
-		Contract  string `json:"contract"`
-		Value     string `json:"value"`
-		Data      string `json:"data"`
-		Gas       uint64 `json:"gas"`
-		GasPrice  string `json:"gasPrice"`
-		Cost      string `json:"cost"`
-		Nonce     uint64 `json:"nonce"`
-		State     uint64 `json:"state"`
-		BlockHash string `json:"blockHash"`
+		Hash                 string          `json:"hash"`
+		From                 string          `json:"from"`
+		To                   string          `json:"to"`
+		Contract             string          `json:"contract"`
+		Value                string          `json:"value"`
+		Data                 string          `json:"data"`
+		Gas                  uint64          `json:"gas"`
+		GasPrice             string          `json:"gasPrice"`
+		Cost                 string          `json:"cost"`
+		Nonce                uint64          `json:"nonce"`
+		State                uint64          `json:"state"`
+		BlockHash            string          `json:"blockHash"`
+		Type                 uint8           `json:"type"`
+		ChainID              string          `json:"chainId,omitempty"`
+		Chain                uint64          `json:"chain"`
+		MaxFeePerGas         string          `json:"maxFeePerGas,omitempty"`
+		MaxPriorityFeePerGas string          `json:"maxPriorityFeePerGas,omitempty"`
+		EffectiveGasPrice    string          `json:"effectiveGasPrice"`
+		GasUsed              uint64          `json:"gasUsed"`
+		CumulativeGasUsed    uint64          `json:"cumulativeGasUsed"`
+		AccessList           json.RawMessage `json:"accessList,omitempty"`
+		MaxFeePerBlobGas     string          `json:"maxFeePerBlobGas,omitempty"`
+		BlobGasUsed          uint64          `json:"blobGasUsed,omitempty"`
+		BlobGasPrice         string          `json:"blobGasPrice,omitempty"`
+		BlobHashes           []string        `json:"blobHashes,omitempty"`
+		LogsBloom            string          `json:"logsBloom"`
+		Decoded              *d.Decoded      `json:"decoded,omitempty"`
+		Removed              bool            `json:"removed,omitempty"`
 	}
 
 	_msg := []byte(msg)
@@ -129,6 +148,8 @@ func (t *TransactionConsumer) Send(msg string) {
 		Nonce:     transaction.Nonce,
 		State:     transaction.State,
 		BlockHash: transaction.BlockHash,
+		Chain:     transaction.Chain,
+		Removed:   transaction.Removed,
 	}
 
 	var request *SubscriptionRequest
@@ -155,6 +176,7 @@ func (t *TransactionConsumer) Send(msg string) {
 		return
 	}
 
+	transaction.Decoded = decoder.DecodeTransaction(t.DB, tx)
 	t.SendData(&transaction)
 
 }
@@ -173,7 +195,7 @@ func (t *TransactionConsumer) SendData(data interface{}) bool {
 	defer t.ConnLock.Unlock()
 
 	if err := t.Connection.WriteJSON(data); err != nil {
-		log.Printf("[!] Failed to deliver `transaction` data to client : %s\n", err.Error())
+		log.Printf("[!] Failed to deliver `%s` data to client : %s\n", t.Topic, err.Error())
 		return false
 	}
 
@@ -185,18 +207,18 @@ func (t *TransactionConsumer) SendData(data interface{}) bool {
 func (t *TransactionConsumer) Unsubscribe() {
 
 	if t.PubSub == nil {
-		log.Printf("[!] Bad attempt to unsubscribe from `transaction` topic\n")
+		log.Printf("[!] Bad attempt to unsubscribe from `%s` topic\n", t.Topic)
 		return
 	}
 
-	if err := t.PubSub.Unsubscribe(context.Background(), "transaction"); err != nil {
-		log.Printf("[!] Failed to unsubscribe from `transaction` topic : %s\n", err.Error())
+	if err := t.PubSub.Unsubscribe(context.Background(), t.Topic); err != nil {
+		log.Printf("[!] Failed to unsubscribe from `%s` topic : %s\n", t.Topic, err.Error())
 		return
 	}
 
 	resp := &SubscriptionResponse{
 		Code:    1,
-		Message: "Unsubscribed from `transaction`",
+		Message: fmt.Sprintf("Unsubscribed from `%s`", t.Topic),
 	}
 
 	// -- Critical section of code begins
@@ -208,7 +230,7 @@ func (t *TransactionConsumer) Unsubscribe() {
 
 	if err := t.Connection.WriteJSON(resp); err != nil {
 
-		log.Printf("[!] Failed to deliver `transaction` unsubscription confirmation to client : %s\n", err.Error())
+		log.Printf("[!] Failed to deliver `%s` unsubscription confirmation to client : %s\n", t.Topic, err.Error())
 		return
 
 	}
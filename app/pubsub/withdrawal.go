@@ -0,0 +1,166 @@
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	d "github.com/denniswon/validationcloud/app/data"
+	"github.com/go-redis/redis/v8"
+	"github.com/gorilla/websocket"
+	"gorm.io/gorm"
+)
+
+// WithdrawalConsumer - Withdrawal consumer info holder struct, to be used
+// for handling reception of published data & checking whether this client has really
+// subscribed for this data or not
+//
+// If yes, also deliver data to client application, connected over websocket
+type WithdrawalConsumer struct {
+	Client     *redis.Client
+	Topic      string
+	Requests   map[string]*SubscriptionRequest
+	Connection *websocket.Conn
+	PubSub     *redis.PubSub
+	DB         *gorm.DB
+	ConnLock   *sync.Mutex
+	TopicLock  *sync.RWMutex
+}
+
+// Subscribe - Subscribe to `withdrawal` topic, under which all withdrawals processed in blocks to be published
+func (w *WithdrawalConsumer) Subscribe() {
+	w.PubSub = w.Client.Subscribe(context.Background(), w.Topic)
+}
+
+// Listen - Listener function, which keeps looping in infinite loop
+// and reads data from subcribed channel, which also gets delivered to client application
+func (w *WithdrawalConsumer) Listen() {
+
+	for {
+
+		msg, err := w.PubSub.ReceiveTimeout(context.Background(), time.Second)
+		if err != nil {
+			continue
+		}
+
+		switch m := msg.(type) {
+
+		case *redis.Subscription:
+
+			// Pubsub broker informed we've been unsubscribed from
+			// this topic
+			if m.Kind == "unsubscribe" {
+				return
+			}
+
+			w.SendData(&SubscriptionResponse{
+				Code:    1,
+				Message: fmt.Sprintf("Subscribed to `%s`", w.Topic),
+			})
+
+		case *redis.Message:
+			w.Send(m.Payload)
+
+		}
+
+	}
+
+}
+
+// Send - Tries to deliver subscribed withdrawal data to client application
+// connected over websocket
+func (w *WithdrawalConsumer) Send(msg string) {
+
+	var withdrawal d.Withdrawal
+
+	if err := json.Unmarshal([]byte(msg), &withdrawal); err != nil {
+		log.Printf("[!] Failed to decode published withdrawal data to JSON : %s\n", err.Error())
+		return
+	}
+
+	var request *SubscriptionRequest
+
+	// -- Shared memory being read from concurrently
+	// running thread of execution, with lock
+	w.TopicLock.RLock()
+
+	for _, v := range w.Requests {
+
+		if v.DoesMatchWithPublishedWithdrawalData(&withdrawal) {
+			request = v
+			break
+		}
+
+	}
+
+	w.TopicLock.RUnlock()
+	// -- Lock released, shared memory reading done
+
+	// Can't proceed with this anymore, because failed to find
+	// respective subscription request
+	if request == nil {
+		return
+	}
+
+	w.SendData(&withdrawal)
+
+}
+
+// SendData - Sending message to client application, connected over websocket
+//
+// If failed, we're going to remove subscription & close websocket
+// connection ( connection might be already closed though )
+func (w *WithdrawalConsumer) SendData(data interface{}) bool {
+
+	// -- Critical section of code begins
+	//
+	// Attempting to write to a network resource,
+	// shared among multiple go routines
+	w.ConnLock.Lock()
+	defer w.ConnLock.Unlock()
+
+	if err := w.Connection.WriteJSON(data); err != nil {
+		log.Printf("[!] Failed to deliver `%s` data to client : %s\n", w.Topic, err.Error())
+		return false
+	}
+
+	return true
+
+}
+
+// Unsubscribe - Unsubscribe from withdrawals pubsub topic, which client has subscribed to
+func (w *WithdrawalConsumer) Unsubscribe() {
+
+	if w.PubSub == nil {
+		log.Printf("[!] Bad attempt to unsubscribe from `%s` topic\n", w.Topic)
+		return
+	}
+
+	if err := w.PubSub.Unsubscribe(context.Background(), w.Topic); err != nil {
+		log.Printf("[!] Failed to unsubscribe from `%s` topic : %s\n", w.Topic, err.Error())
+		return
+	}
+
+	resp := &SubscriptionResponse{
+		Code:    1,
+		Message: fmt.Sprintf("Unsubscribed from `%s`", w.Topic),
+	}
+
+	// -- Critical section of code begins
+	//
+	// Attempting to write to a network resource,
+	// shared among multiple go routines
+	w.ConnLock.Lock()
+	defer w.ConnLock.Unlock()
+
+	if err := w.Connection.WriteJSON(resp); err != nil {
+
+		log.Printf("[!] Failed to deliver `%s` unsubscription confirmation to client : %s\n", w.Topic, err.Error())
+		return
+
+	}
+
+}
@@ -0,0 +1,180 @@
+package queue
+
+import (
+	"log"
+	"sort"
+	"time"
+
+	"github.com/denniswon/validationcloud/app/config"
+	"github.com/gookit/color"
+)
+
+// Failure - Reason why processing of block failed, to be recorded against it
+type Failure struct {
+	BlockNumber  uint64
+	Error        string
+	ResponseChan chan bool
+}
+
+// DeadLetter - Block which is not retried anymore, as it has failed too many times
+type DeadLetter struct {
+	Number        uint64    `json:"number"`
+	Attempts      uint64    `json:"attempts"`
+	LastError     string    `json:"lastError"`
+	LastAttempted time.Time `json:"lastAttempted"`
+}
+
+// DeadLetters - Clients can query which blocks are dead-lettered
+type DeadLetters struct {
+	ResponseChan chan []*DeadLetter
+}
+
+// Exhausted - Whether block has failed as many times as configured `MaxAttempts`,
+// so that it's not to be retried anymore
+func (b *Block) Exhausted() bool {
+
+	max := config.GetMaxAttempts()
+	return max != 0 && b.Attempts >= max
+
+}
+
+// Errored - Records why processing of block failed, to be shown when it gets dead-lettered
+func (b *BlockProcessorQueue) Errored(block uint64, err error) bool {
+
+	resp := make(chan bool)
+	req := Failure{
+		BlockNumber:  block,
+		Error:        err.Error(),
+		ResponseChan: resp,
+	}
+
+	b.ErroredChan <- req
+	return <-resp
+
+}
+
+// DeadLetters - Blocks which are given up on, in ascending order of block number
+func (b *BlockProcessorQueue) DeadLetters() []*DeadLetter {
+
+	resp := make(chan []*DeadLetter)
+	req := DeadLetters{ResponseChan: resp}
+
+	b.DeadLettersChan <- req
+	return <-resp
+
+}
+
+// Requeue - Puts dead-lettered or discarded block back to waiting, with its
+// attempts reset, so that it gets retried soon
+func (b *BlockProcessorQueue) Requeue(block uint64) bool {
+
+	resp := make(chan bool)
+	req := Request{
+		BlockNumber:  block,
+		ResponseChan: resp,
+	}
+
+	b.RequeueChan <- req
+	return <-resp
+
+}
+
+// Discard - Gives up on dead-lettered block for good, it's kept in queue as discarded,
+// so that missing block finder doesn't put it into queue again, until it's requeued
+func (b *BlockProcessorQueue) Discard(block uint64) bool {
+
+	resp := make(chan bool)
+	req := Request{
+		BlockNumber:  block,
+		ResponseChan: resp,
+	}
+
+	b.DiscardChan <- req
+	return <-resp
+
+}
+
+// reportDeadLettered - Lets operator know block has been given up on
+func (b *BlockProcessorQueue) reportDeadLettered(num uint64, block *Block) {
+
+	if !block.DeadLettered {
+		return
+	}
+
+	log.Print(color.Red.Sprintf("[!] Dead-lettered block %d after %d attempt(s) : %s", num, block.Attempts, block.LastError))
+
+}
+
+// deadLetters - Snapshot of dead-lettered blocks, ordered by block number
+func (b *BlockProcessorQueue) deadLetters() []*DeadLetter {
+
+	letters := make([]*DeadLetter, 0, b.counts[stateDeadLettered])
+
+	// Rarely are there any, so it's fine to look at all blocks
+	if b.counts[stateDeadLettered] == 0 {
+		return letters
+	}
+
+	for k, v := range b.Blocks {
+
+		if !v.DeadLettered {
+			continue
+		}
+
+		letters = append(letters, &DeadLetter{
+			Number:        k,
+			Attempts:      v.Attempts,
+			LastError:     v.LastError,
+			LastAttempted: v.LastAttempted,
+		})
+
+	}
+
+	sort.Slice(letters, func(i, j int) bool {
+		return letters[i].Number < letters[j].Number
+	})
+
+	return letters
+
+}
+
+// requeue - Gives dead-lettered or discarded block another round of attempts,
+// picking up where it left off i.e. either waiting to be processed or confirmed
+func (b *BlockProcessorQueue) requeue(num uint64) bool {
+
+	block, ok := b.Blocks[num]
+	if !ok || !(block.DeadLettered || block.Discarded) {
+		return false
+	}
+
+	b.change(num, block, func() {
+		block.DeadLettered = false
+		block.Discarded = false
+		block.Attempts = 0
+		block.LastError = ""
+
+		block.ResetDelay()
+		block.SetLastAttempted()
+	})
+
+	return true
+
+}
+
+// discard - Marks dead-lettered block as discarded, it's not listed as dead letter
+// anymore, while it still occupies its place in queue, persisted as such
+func (b *BlockProcessorQueue) discard(num uint64) bool {
+
+	block, ok := b.Blocks[num]
+	if !ok || !block.DeadLettered {
+		return false
+	}
+
+	b.change(num, block, func() {
+		block.DeadLettered = false
+		block.Discarded = true
+	})
+
+	return true
+
+}
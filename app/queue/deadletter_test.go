@@ -0,0 +1,150 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+func TestDeadLetter(t *testing.T) {
+
+	t.Cleanup(viper.Reset)
+
+	viper.Set("MaxAttempts", "1")
+
+	queue := newStartedQueue(t, 0, nil)
+
+	queue.Put(1)
+	queue.Errored(1, errors.New("receipt not found"))
+	queue.UnconfirmedFailed(1)
+
+	letters := queue.DeadLetters()
+	if len(letters) != 1 || letters[0].Number != 1 || letters[0].Attempts != 1 || letters[0].LastError != "receipt not found" {
+		t.Fatalf("expected block 1 to be dead-lettered, got %+v", letters)
+	}
+
+	if stat := queue.Stat(); stat.DeadLettered != 1 || stat.UnconfirmedWaiting != 0 {
+		t.Fatalf("expected 1 dead-lettered block, got %+v", stat)
+	}
+
+	// Not to be picked up again, until operator asks so
+	if queue.Put(1) {
+		t.Fatal("expected dead-lettered block to not be put again")
+	}
+
+	if !queue.Requeue(1) || queue.Requeue(1) {
+		t.Fatal("expected dead-lettered block to be requeued only once")
+	}
+
+	if stat := queue.Stat(); stat.DeadLettered != 0 || stat.UnconfirmedWaiting != 1 {
+		t.Fatalf("expected requeued block to be waiting, got %+v", stat)
+	}
+
+	// Block failing to be confirmed gets dead-lettered too, while requeueing
+	// it resumes confirmation
+	queue.Put(2)
+	queue.UnconfirmedDone(2)
+	queue.ConfirmedFailed(2)
+
+	if queue.Discard(1) {
+		t.Fatal("expected block, not dead-lettered, to not be discarded")
+	}
+
+	if !queue.Requeue(2) {
+		t.Fatal("expected block failing confirmation to be dead-lettered")
+	}
+
+	if stat := queue.Stat(); stat.ConfirmedWaiting != 1 {
+		t.Fatalf("expected requeued block to be waiting for confirmation, got %+v", stat)
+	}
+
+	queue.ConfirmedFailed(2)
+
+	if !queue.Discard(2) || len(queue.DeadLetters()) != 0 {
+		t.Fatal("expected dead-lettered block to be discarded")
+	}
+
+	if stat := queue.Stat(); stat.Discarded != 1 || stat.DeadLettered != 0 {
+		t.Fatalf("expected 1 discarded block, got %+v", stat)
+	}
+
+	// Missing block finder isn't to fetch it again
+	if queue.Put(2) || queue.Discard(2) {
+		t.Fatal("expected discarded block to stay discarded")
+	}
+
+	if !queue.Requeue(2) {
+		t.Fatal("expected discarded block to be requeued")
+	}
+
+	// Retrying forever
+	viper.Set("MaxAttempts", "0")
+
+	queue.UnconfirmedFailed(2)
+
+	if stat := queue.Stat(); stat.DeadLettered != 0 {
+		t.Fatalf("expected nothing to be dead-lettered, got %+v", stat)
+	}
+
+}
+
+func TestDiscardRestored(t *testing.T) {
+
+	t.Cleanup(viper.Reset)
+
+	viper.Set("MaxAttempts", "1")
+
+	store := &memoryStore{blocks: make(map[uint64]Block)}
+
+	queue := New(0, false)
+	if err := queue.Restore(store); err != nil {
+		t.Fatalf("failed to restore queue : %s", err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go queue.Start(ctx)
+
+	queue.Put(1)
+	queue.UnconfirmedFailed(1)
+	queue.Discard(1)
+
+	// Persisted every second, so waiting for discarded state to be written
+	deadline := time.Now().Add(3 * time.Second)
+	for {
+
+		store.lock.Lock()
+		discarded := store.blocks[1]
+		store.lock.Unlock()
+
+		if discarded.Discarded && !discarded.DeadLettered {
+			break
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("expected block to be persisted as discarded, got %+v", discarded)
+		}
+
+		time.Sleep(10 * time.Millisecond)
+
+	}
+
+	cancel()
+
+	restarted := New(0, false)
+	if err := restarted.Restore(store); err != nil {
+		t.Fatalf("failed to restore queue : %s", err.Error())
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	go restarted.Start(ctx)
+
+	if restarted.Put(1) || len(restarted.DeadLetters()) != 0 {
+		t.Fatal("expected block to stay discarded across restarts")
+	}
+
+}
@@ -0,0 +1,94 @@
+package queue
+
+import (
+	"container/heap"
+	"time"
+)
+
+// entry - Block scheduled to be attempted at given time
+//
+// Entries are never removed from heap in place, rather when block gets rescheduled
+// or leaves queue, its older entries become stale & get discarded when popped
+type entry struct {
+	number uint64
+	at     time.Time
+	seq    uint64
+}
+
+// byTime - Min-heap of entries, ordered by when those can be attempted i.e.
+// oldest first, ties broken by lower block number
+type byTime []*entry
+
+func (h byTime) Len() int { return len(h) }
+
+func (h byTime) Less(i, j int) bool {
+
+	if h[i].at.Equal(h[j].at) {
+		return h[i].number < h[j].number
+	}
+
+	return h[i].at.Before(h[j].at)
+
+}
+
+func (h byTime) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *byTime) Push(x interface{}) { *h = append(*h, x.(*entry)) }
+
+func (h *byTime) Pop() interface{} {
+
+	old := *h
+	n := len(old)
+
+	e := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+
+	return e
+
+}
+
+// byNumber - Min-heap of entries, ordered by block number
+type byNumber []*entry
+
+func (h byNumber) Len() int { return len(h) }
+
+func (h byNumber) Less(i, j int) bool { return h[i].number < h[j].number }
+
+func (h byNumber) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *byNumber) Push(x interface{}) { *h = append(*h, x.(*entry)) }
+
+func (h *byNumber) Pop() interface{} {
+
+	old := *h
+	n := len(old)
+
+	e := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+
+	return e
+
+}
+
+// peek - Top of heap, nil if it's empty
+func peek(h heap.Interface) *entry {
+
+	switch v := h.(type) {
+
+	case *byTime:
+		if len(*v) != 0 {
+			return (*v)[0]
+		}
+
+	case *byNumber:
+		if len(*v) != 0 {
+			return (*v)[0]
+		}
+
+	}
+
+	return nil
+
+}
@@ -1,6 +1,7 @@
 package queue
 
 import (
+	"container/heap"
 	"context"
 	"math"
 	"time"
@@ -23,8 +24,13 @@ type Block struct {
 	UnconfirmedDone     bool // 3. Done with processing
 	ConfirmedProgress   bool // 4. Attempting confirm whether chain reorg happened or not
 	ConfirmedDone       bool // 5. Done with bringing latest changes
+	Attempts            uint64
 	LastAttempted       time.Time
 	Delay               time.Duration
+	DeadLettered        bool
+	Discarded           bool
+	LastError           string
+	seq                 uint64 // Which of scheduled entries is current one
 }
 
 // SetDelay - Set delay at next fibonacci number in series, interpreted as seconds
@@ -49,7 +55,54 @@ func (b *Block) SetLastAttempted() {
 //
 // Yes, if waiting phase has elapsed
 func (b *Block) CanAttempt() bool {
-	return time.Now().UTC().After(b.LastAttempted.Add(b.Delay))
+	return time.Now().UTC().After(b.NextAttempt())
+}
+
+// NextAttempt - When this block can be attempted to be processed next time
+func (b *Block) NextAttempt() time.Time {
+	return b.LastAttempted.Add(b.Delay)
+}
+
+// States, block can be in, while living in queue
+const (
+	stateUnconfirmedProgress = iota
+	stateUnconfirmedWaiting
+	stateConfirmedProgress
+	stateConfirmedWaiting
+	stateDone
+	stateDeadLettered
+	stateDiscarded
+)
+
+// state - Which one of processing phases this block is in
+func (b *Block) state() int {
+
+	if b.Discarded {
+		return stateDiscarded
+	}
+
+	if b.DeadLettered {
+		return stateDeadLettered
+	}
+
+	if b.UnconfirmedProgress {
+		return stateUnconfirmedProgress
+	}
+
+	if !b.UnconfirmedDone {
+		return stateUnconfirmedWaiting
+	}
+
+	if b.ConfirmedProgress {
+		return stateConfirmedProgress
+	}
+
+	if !b.ConfirmedDone {
+		return stateConfirmedWaiting
+	}
+
+	return stateDone
+
 }
 
 // Request - Any request to be placed into queue's channels in this form
@@ -82,17 +135,42 @@ type StatResponse struct {
 	UnconfirmedWaiting  uint64
 	ConfirmedProgress   uint64
 	ConfirmedWaiting    uint64
+	DeadLettered        uint64
+	Discarded           uint64
 	Total               uint64
 }
 
 // BlockProcessorQueue - concurrent safe queue to be interacted with before attempting to process any block
+//
+// Blocks waiting to be processed are kept in min-heaps, so that next one can be picked
+// without scanning all of them, which is what makes it usable during large backfills
+//
+// - Unconfirmed blocks are ordered by when they can be attempted i.e. oldest first,
+// while blocks near head of chain are kept in separate heap, which gets priority
+// over backfill
+// - Confirmable blocks are ordered by when they can be attempted, until their waiting
+// phase elapses, then by block number, as lower blocks reach finality first
+//
+// Number of blocks in each state is counted as they move, instead of on demand
 type BlockProcessorQueue struct {
 	Blocks                map[uint64]*Block
+	Store                 Store
+	dirty                 map[uint64]bool
+	unconfirmedHead       byTime
+	unconfirmedBackfill   byTime
+	confirmedWaiting      byTime
+	confirmedReady        byNumber
+	done                  []uint64
+	counts                [stateDiscarded + 1]uint64
+	seq                   uint64
 	StartedWith           uint64
+	FinalityTags          bool
 	TotalInserted         uint64
 	LatestBlock           uint64
+	FinalizedBlock        uint64
 	Total                 uint64
 	PutChan               chan Request
+	EnqueueChan           chan Request
 	CanPublishChan        chan Request
 	PublishedChan         chan Request
 	InsertedChan          chan Request
@@ -100,22 +178,40 @@ type BlockProcessorQueue struct {
 	UnconfirmedDoneChan   chan Request
 	ConfirmedFailedChan   chan Request
 	ConfirmedDoneChan     chan Request
+	ReorgedChan           chan Request
+	ErroredChan           chan Failure
+	DeadLettersChan       chan DeadLetters
+	RequeueChan           chan Request
+	DiscardChan           chan Request
 	StatChan              chan Stat
 	LatestChan            chan Update
+	FinalizedChan         chan Update
 	UnconfirmedNextChan   chan Next
 	ConfirmedNextChan     chan Next
 }
 
 // New - Getting new instance of queue, to be invoked during setting up application
-func New(startingWith uint64) *BlockProcessorQueue {
+//
+// Finality of blocks is decided by node's block tags, when asked to, otherwise by
+// number of confirmations, as chain is configured
+func New(startingWith uint64, finalityTags bool) *BlockProcessorQueue {
 
 	return &BlockProcessorQueue{
 		Blocks:                make(map[uint64]*Block),
+		dirty:                 make(map[uint64]bool),
+		unconfirmedHead:       make(byTime, 0),
+		unconfirmedBackfill:   make(byTime, 0),
+		confirmedWaiting:      make(byTime, 0),
+		confirmedReady:        make(byNumber, 0),
+		done:                  make([]uint64, 0),
 		StartedWith:           startingWith,
+		FinalityTags:          finalityTags,
 		TotalInserted:         0,
 		LatestBlock:           0,
+		FinalizedBlock:        0,
 		Total:                 0,
 		PutChan:               make(chan Request, 128),
+		EnqueueChan:           make(chan Request, 128),
 		CanPublishChan:        make(chan Request, 128),
 		PublishedChan:         make(chan Request, 128),
 		InsertedChan:          make(chan Request, 128),
@@ -123,8 +219,14 @@ func New(startingWith uint64) *BlockProcessorQueue {
 		UnconfirmedDoneChan:   make(chan Request, 128),
 		ConfirmedFailedChan:   make(chan Request, 128),
 		ConfirmedDoneChan:     make(chan Request, 128),
+		ReorgedChan:           make(chan Request, 128),
+		ErroredChan:           make(chan Failure, 128),
+		DeadLettersChan:       make(chan DeadLetters, 1),
+		RequeueChan:           make(chan Request, 1),
+		DiscardChan:           make(chan Request, 1),
 		StatChan:              make(chan Stat, 1),
 		LatestChan:            make(chan Update, 1),
+		FinalizedChan:         make(chan Update, 1),
 		UnconfirmedNextChan:   make(chan Next, 1),
 		ConfirmedNextChan:     make(chan Next, 1),
 	}
@@ -152,6 +254,23 @@ func (b *BlockProcessorQueue) Put(block uint64) bool {
 
 }
 
+// Enqueue - Puts block into queue, waiting to be picked up by retry manager,
+// when no one is going to process it right away e.g. heads skipped by subscriber
+//
+// If this block is already put into queue, it's left as it is
+func (b *BlockProcessorQueue) Enqueue(block uint64) bool {
+
+	resp := make(chan bool)
+	req := Request{
+		BlockNumber:  block,
+		ResponseChan: resp,
+	}
+
+	b.EnqueueChan <- req
+	return <-resp
+
+}
+
 // CanPublish - Before any client attempts to publish any block
 // on Pub/Sub topic, they're supposed to be invoking this method
 // to check whether they're eligible of publishing or not
@@ -258,6 +377,22 @@ func (b *BlockProcessorQueue) ConfirmedDone(block uint64) bool {
 
 }
 
+// Reorged - Block got orphaned due to chain reorganization, so all of its
+// processing history to be forgotten, which will let it be put into queue again
+// & processed freshly, when it's seen next time
+func (b *BlockProcessorQueue) Reorged(block uint64) bool {
+
+	resp := make(chan bool)
+	req := Request{
+		BlockNumber:  block,
+		ResponseChan: resp,
+	}
+
+	b.ReorgedChan <- req
+	return <-resp
+
+}
+
 // Stat - Client's are supposed to be invoking this abstracted method
 // for checking queue status
 func (b *BlockProcessorQueue) Stat() StatResponse {
@@ -282,6 +417,18 @@ func (b *BlockProcessorQueue) Latest(num uint64) bool {
 
 }
 
+// Finalized - Finality tracker will update queue manager
+// that finalized head, as per node, is updated
+func (b *BlockProcessorQueue) Finalized(num uint64) bool {
+
+	resp := make(chan bool)
+	udt := Update{BlockNumber: num, ResponseChan: resp}
+
+	b.FinalizedChan <- udt
+	return <-resp
+
+}
+
 // UnconfirmedNext - Next block that can be processed, present in unconfirmed block queue
 func (b *BlockProcessorQueue) UnconfirmedNext() (uint64, bool) {
 
@@ -317,8 +464,15 @@ func (b *BlockProcessorQueue) ConfirmedNext() (uint64, bool) {
 // CanBeConfirmed - Checking whether given block number has reached
 // finality as per given user set preference, then it can be attempted
 // to be checked again & finally entered into storage
+//
+// When node's block tags are used for deciding finality, block
+// needs to be at or below finalized head
 func (b *BlockProcessorQueue) CanBeConfirmed(num uint64) bool {
 
+	if b.FinalityTags {
+		return b.FinalizedBlock != 0 && b.FinalizedBlock >= num
+	}
+
 	if b.LatestBlock < config.GetBlockConfirmations() {
 		return false
 	}
@@ -327,17 +481,220 @@ func (b *BlockProcessorQueue) CanBeConfirmed(num uint64) bool {
 
 }
 
+// isHead - Whether block is near head of chain i.e. within max reorg depth of latest
+// block seen, such blocks are processed before backfilled ones
+func (b *BlockProcessorQueue) isHead(num uint64) bool {
+	return b.LatestBlock != 0 && num+config.GetMaxReorgDepth() >= b.LatestBlock
+}
+
+// schedule - Puts block into heap, it's supposed to be waiting in, as per its
+// current state, any previously scheduled entry of it becomes stale
+func (b *BlockProcessorQueue) schedule(num uint64, block *Block) {
+
+	b.seq++
+	block.seq = b.seq
+
+	switch block.state() {
+
+	case stateUnconfirmedWaiting:
+
+		e := &entry{number: num, at: block.NextAttempt(), seq: block.seq}
+
+		if b.isHead(num) {
+			heap.Push(&b.unconfirmedHead, e)
+			break
+		}
+
+		heap.Push(&b.unconfirmedBackfill, e)
+
+	case stateConfirmedWaiting:
+		heap.Push(&b.confirmedWaiting, &entry{number: num, at: block.NextAttempt(), seq: block.seq})
+
+	case stateDone:
+		b.done = append(b.done, num)
+
+	}
+
+}
+
+// current - Whether scheduled entry still represents block, which is in given state
+func (b *BlockProcessorQueue) current(e *entry, state int) bool {
+
+	block, ok := b.Blocks[e.number]
+	return ok && block.seq == e.seq && block.state() == state
+
+}
+
+// add - Puts new block into queue
+func (b *BlockProcessorQueue) add(num uint64, block *Block) {
+
+	b.Blocks[num] = block
+	b.counts[block.state()]++
+
+	b.schedule(num, block)
+	b.touch(num)
+
+}
+
+// change - Applies change to block living in queue, while keeping state counters
+// in sync & rescheduling it, if its state changes
+func (b *BlockProcessorQueue) change(num uint64, block *Block, fn func()) {
+
+	before := block.state()
+	fn()
+	after := block.state()
+
+	b.counts[before]--
+	b.counts[after]++
+
+	if before != after {
+		b.schedule(num, block)
+	}
+
+	b.touch(num)
+
+}
+
+// remove - Takes block out of queue, its scheduled entries, if any, become stale
+func (b *BlockProcessorQueue) remove(num uint64) {
+
+	block, ok := b.Blocks[num]
+	if !ok {
+		return
+	}
+
+	b.counts[block.state()]--
+	delete(b.Blocks, num)
+
+	b.touch(num)
+
+}
+
+// nextUnconfirmed - Picks block, which can be attempted to be processed now, head
+// blocks first, then backfilled ones, oldest first in both cases
+func (b *BlockProcessorQueue) nextUnconfirmed(now time.Time) (uint64, bool) {
+
+	for _, h := range []*byTime{&b.unconfirmedHead, &b.unconfirmedBackfill} {
+
+		for {
+
+			e := peek(h)
+			if e == nil {
+				break
+			}
+
+			if !b.current(e, stateUnconfirmedWaiting) {
+				heap.Pop(h)
+				continue
+			}
+
+			// Everything else in this heap needs to wait even longer
+			if !now.After(e.at) {
+				break
+			}
+
+			heap.Pop(h)
+			return e.number, true
+
+		}
+
+	}
+
+	return 0, false
+
+}
+
+// nextConfirmed - Picks lowest block, which has reached finality & whose waiting
+// phase has elapsed
+func (b *BlockProcessorQueue) nextConfirmed(now time.Time) (uint64, bool) {
+
+	for {
+
+		e := peek(&b.confirmedWaiting)
+		if e == nil {
+			break
+		}
+
+		if !b.current(e, stateConfirmedWaiting) {
+			heap.Pop(&b.confirmedWaiting)
+			continue
+		}
+
+		if !now.After(e.at) {
+			break
+		}
+
+		heap.Pop(&b.confirmedWaiting)
+		heap.Push(&b.confirmedReady, e)
+
+	}
+
+	for {
+
+		e := peek(&b.confirmedReady)
+		if e == nil {
+			break
+		}
+
+		if !b.current(e, stateConfirmedWaiting) {
+			heap.Pop(&b.confirmedReady)
+			continue
+		}
+
+		// Blocks reach finality in order, so if lowest one hasn't,
+		// none of others has
+		if !b.CanBeConfirmed(e.number) {
+			break
+		}
+
+		heap.Pop(&b.confirmedReady)
+		return e.number, true
+
+	}
+
+	return 0, false
+
+}
+
 // Start - You're supposed to be starting this method as an
 // independent go routine, with will listen on multiple channels
 // & respond back over provided channel ( by client )
+//
+// When store is attached, changes made to blocks get persisted every second,
+// by separate writer go routine
 func (b *BlockProcessorQueue) Start(ctx context.Context) {
 
+	var w *writer
+	if b.Store != nil {
+		w = newWriter(b.Store)
+	}
+
+	persistTicker := time.NewTicker(time.Second)
+	defer persistTicker.Stop()
+
+	// Ticking irrespective of how busy queue is, so that confirmed blocks
+	// get cleaned up even when requests keep coming in
+	cleanupTicker := time.NewTicker(time.Duration(100) * time.Millisecond)
+	defer cleanupTicker.Stop()
+
 	for {
 		select {
 
 		case <-ctx.Done():
+
+			// Best effort attempt to not lose anything changed lately
+			b.persist(w)
+
+			if w != nil {
+				w.stop()
+			}
+
 			return
 
+		case <-persistTicker.C:
+
+			b.persist(w)
+
 		case req := <-b.PutChan:
 
 			// Once a block is inserted into processing queue, don't overwrite its history with some new request
@@ -348,11 +705,29 @@ func (b *BlockProcessorQueue) Start(ctx context.Context) {
 
 			}
 
-			b.Blocks[req.BlockNumber] = &Block{
+			b.add(req.BlockNumber, &Block{
 				UnconfirmedProgress: true,
 				LastAttempted:       time.Now().UTC(),
 				Delay:               time.Duration(1) * time.Second,
+			})
+
+			req.ResponseChan <- true
+
+		case req := <-b.EnqueueChan:
+
+			if _, ok := b.Blocks[req.BlockNumber]; ok {
+
+				req.ResponseChan <- false
+				break
+
 			}
+
+			// Not in progress, so that it can be picked up as soon as asked for
+			b.add(req.BlockNumber, &Block{
+				LastAttempted: time.Now().UTC(),
+				Delay:         time.Duration(1) * time.Second,
+			})
+
 			req.ResponseChan <- true
 
 		case req := <-b.CanPublishChan:
@@ -377,7 +752,10 @@ func (b *BlockProcessorQueue) Start(ctx context.Context) {
 				break
 			}
 
-			block.Published = true
+			b.change(req.BlockNumber, block, func() {
+				block.Published = true
+			})
+
 			req.ResponseChan <- true
 
 		case req := <-b.InsertedChan:
@@ -399,9 +777,15 @@ func (b *BlockProcessorQueue) Start(ctx context.Context) {
 				break
 			}
 
-			block.UnconfirmedProgress = false
-			block.SetDelay()
+			b.change(req.BlockNumber, block, func() {
+				block.UnconfirmedProgress = false
+				block.Attempts++
+				block.SetDelay()
+
+				block.DeadLettered = block.Exhausted()
+			})
 
+			b.reportDeadLettered(req.BlockNumber, block)
 			req.ResponseChan <- true
 
 		case req := <-b.UnconfirmedDoneChan:
@@ -412,13 +796,15 @@ func (b *BlockProcessorQueue) Start(ctx context.Context) {
 				break
 			}
 
-			block.UnconfirmedProgress = false
-			block.UnconfirmedDone = true
+			b.change(req.BlockNumber, block, func() {
+				block.UnconfirmedProgress = false
+				block.UnconfirmedDone = true
 
-			block.ConfirmedDone = b.CanBeConfirmed(req.BlockNumber)
+				block.ConfirmedDone = b.CanBeConfirmed(req.BlockNumber)
 
-			block.ResetDelay()
-			block.SetLastAttempted()
+				block.ResetDelay()
+				block.SetLastAttempted()
+			})
 
 			req.ResponseChan <- true
 
@@ -430,9 +816,15 @@ func (b *BlockProcessorQueue) Start(ctx context.Context) {
 				break
 			}
 
-			block.ConfirmedProgress = false
-			block.SetDelay()
+			b.change(req.BlockNumber, block, func() {
+				block.ConfirmedProgress = false
+				block.Attempts++
+				block.SetDelay()
 
+				block.DeadLettered = block.Exhausted()
+			})
+
+			b.reportDeadLettered(req.BlockNumber, block)
 			req.ResponseChan <- true
 
 		case req := <-b.ConfirmedDoneChan:
@@ -443,36 +835,50 @@ func (b *BlockProcessorQueue) Start(ctx context.Context) {
 				break
 			}
 
-			block.ConfirmedProgress = false
-			block.ConfirmedDone = true
+			b.change(req.BlockNumber, block, func() {
+				block.ConfirmedProgress = false
+				block.ConfirmedDone = true
+			})
 
 			req.ResponseChan <- true
 
-		case nxt := <-b.UnconfirmedNextChan:
+		case req := <-b.ReorgedChan:
 
-			// This is the block number which should be processed by requester client
-			var selected uint64
-			var found bool
+			if _, ok := b.Blocks[req.BlockNumber]; !ok {
+				req.ResponseChan <- false
+				break
+			}
 
-			for k := range b.Blocks {
+			b.remove(req.BlockNumber)
+			req.ResponseChan <- true
 
-				if b.Blocks[k].ConfirmedDone || b.Blocks[k].ConfirmedProgress {
-					continue
-				}
+		case req := <-b.ErroredChan:
 
-				if b.Blocks[k].UnconfirmedDone || b.Blocks[k].UnconfirmedProgress {
-					continue
-				}
+			block, ok := b.Blocks[req.BlockNumber]
+			if !ok {
+				req.ResponseChan <- false
+				break
+			}
 
-				if b.Blocks[k].CanAttempt() {
-					selected = k
-					found = true
+			b.change(req.BlockNumber, block, func() {
+				block.LastError = req.Error
+			})
 
-					break
-				}
+			req.ResponseChan <- true
 
-			}
+		case req := <-b.DeadLettersChan:
+			req.ResponseChan <- b.deadLetters()
+
+		case req := <-b.RequeueChan:
+			req.ResponseChan <- b.requeue(req.BlockNumber)
+
+		case req := <-b.DiscardChan:
+			req.ResponseChan <- b.discard(req.BlockNumber)
 
+		case nxt := <-b.UnconfirmedNextChan:
+
+			// This is the block number which should be processed by requester client
+			selected, found := b.nextUnconfirmed(time.Now().UTC())
 			if !found {
 
 				// As we've failed to find any block which can be processed now
@@ -488,8 +894,11 @@ func (b *BlockProcessorQueue) Start(ctx context.Context) {
 			}
 
 			// Updated when last this block was attempted to be processed
-			b.Blocks[selected].SetLastAttempted()
-			b.Blocks[selected].UnconfirmedProgress = true
+			block := b.Blocks[selected]
+			b.change(selected, block, func() {
+				block.SetLastAttempted()
+				block.UnconfirmedProgress = true
+			})
 
 			// Asking client to proceed with processing of this block
 			nxt.ResponseChan <- struct {
@@ -502,28 +911,7 @@ func (b *BlockProcessorQueue) Start(ctx context.Context) {
 
 		case nxt := <-b.ConfirmedNextChan:
 
-			var selected uint64
-			var found bool
-
-			for k := range b.Blocks {
-
-				if b.Blocks[k].ConfirmedDone || b.Blocks[k].ConfirmedProgress {
-					continue
-				}
-
-				if !b.Blocks[k].UnconfirmedDone {
-					continue
-				}
-
-				if b.Blocks[k].CanAttempt() && b.CanBeConfirmed(k) {
-					selected = k
-					found = true
-
-					break
-				}
-
-			}
-
+			selected, found := b.nextConfirmed(time.Now().UTC())
 			if !found {
 
 				nxt.ResponseChan <- struct {
@@ -536,8 +924,11 @@ func (b *BlockProcessorQueue) Start(ctx context.Context) {
 
 			}
 
-			b.Blocks[selected].SetLastAttempted()
-			b.Blocks[selected].ConfirmedProgress = true
+			block := b.Blocks[selected]
+			b.change(selected, block, func() {
+				block.SetLastAttempted()
+				block.ConfirmedProgress = true
+			})
 
 			nxt.ResponseChan <- struct {
 				Status bool
@@ -551,35 +942,16 @@ func (b *BlockProcessorQueue) Start(ctx context.Context) {
 
 			// Returning back how many blocks currently living
 			// in block processor queue & in what state
-			var stat StatResponse
-
-			for k := range b.Blocks {
-
-				if b.Blocks[k].UnconfirmedProgress {
-					stat.UnconfirmedProgress++
-					continue
-				}
-
-				if b.Blocks[k].UnconfirmedProgress == b.Blocks[k].UnconfirmedDone {
-					stat.UnconfirmedWaiting++
-					continue
-				}
-
-				if b.Blocks[k].ConfirmedProgress {
-					stat.ConfirmedProgress++
-					continue
-				}
-
-				if b.Blocks[k].ConfirmedProgress == b.Blocks[k].ConfirmedDone {
-					stat.ConfirmedWaiting++
-					continue
-				}
-
+			req.ResponseChan <- StatResponse{
+				UnconfirmedProgress: b.counts[stateUnconfirmedProgress],
+				UnconfirmedWaiting:  b.counts[stateUnconfirmedWaiting],
+				ConfirmedProgress:   b.counts[stateConfirmedProgress],
+				ConfirmedWaiting:    b.counts[stateConfirmedWaiting],
+				DeadLettered:        b.counts[stateDeadLettered],
+				Discarded:           b.counts[stateDiscarded],
+				Total:               b.Total,
 			}
 
-			stat.Total = b.Total
-			req.ResponseChan <- stat
-
 		case udt := <-b.LatestChan:
 			// Latest block number seen by subscriber to
 			// sent to queue, to be used in when deciding whether some
@@ -587,18 +959,28 @@ func (b *BlockProcessorQueue) Start(ctx context.Context) {
 			b.LatestBlock = udt.BlockNumber
 			udt.ResponseChan <- true
 
-		case <-time.After(time.Duration(100) * time.Millisecond):
+		case udt := <-b.FinalizedChan:
+			// Finality head, as per node, never moves backward
+			if udt.BlockNumber > b.FinalizedBlock {
+				b.FinalizedBlock = udt.BlockNumber
+			}
+
+			udt.ResponseChan <- true
+
+		case <-cleanupTicker.C:
 
-			// Finding out which blocks are confirmed & we're good to clean those up
-			for k := range b.Blocks {
+			// Cleaning up blocks which are confirmed, only those are looked at
+			for _, k := range b.done {
 
-				if b.Blocks[k].ConfirmedDone {
-					delete(b.Blocks, k)
+				if block, ok := b.Blocks[k]; ok && block.state() == stateDone {
+					b.remove(k)
 					b.Total++ // Successfully processed #-of blocks
 				}
 
 			}
 
+			b.done = b.done[:0]
+
 		}
 	}
 
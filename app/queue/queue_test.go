@@ -0,0 +1,221 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/denniswon/validationcloud/app/config"
+)
+
+// newStartedQueue - Queue with given blocks already in it, along with its
+// processor running until test ends
+func newStartedQueue(tb testing.TB, latest uint64, blocks map[uint64]*Block) *BlockProcessorQueue {
+
+	queue := New(0, false)
+	queue.LatestBlock = latest
+
+	for k, v := range blocks {
+		queue.add(k, v)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	tb.Cleanup(cancel)
+
+	go queue.Start(ctx)
+	return queue
+
+}
+
+// waiting - Block, which has been waiting since given time, to be processed
+func waiting(since time.Duration) *Block {
+	return &Block{LastAttempted: time.Now().UTC().Add(-since), Delay: time.Second}
+}
+
+// confirmable - Block, which has been processed & waiting since given time,
+// to be confirmed
+func confirmable(since time.Duration) *Block {
+	return &Block{UnconfirmedDone: true, LastAttempted: time.Now().UTC().Add(-since), Delay: time.Second}
+}
+
+func TestUnconfirmedNext(t *testing.T) {
+
+	queue := newStartedQueue(t, 1000, map[uint64]*Block{
+		5:   waiting(10 * time.Second),
+		6:   waiting(3 * time.Second),
+		7:   waiting(5 * time.Second),
+		999: waiting(2 * time.Second),
+		// Not yet time to retry
+		998: {LastAttempted: time.Now().UTC(), Delay: time.Minute},
+	})
+
+	// Head of chain first, then backfill, oldest first
+	for _, expected := range []uint64{999, 5, 7, 6} {
+
+		if num, ok := queue.UnconfirmedNext(); !ok || num != expected {
+			t.Fatalf("expected block %d to be processed next, got %d", expected, num)
+		}
+
+	}
+
+	if num, ok := queue.UnconfirmedNext(); ok {
+		t.Fatalf("expected nothing to be processed, got %d", num)
+	}
+
+	// Failed one is retried only after backoff
+	queue.UnconfirmedFailed(999)
+
+	if num, ok := queue.UnconfirmedNext(); ok {
+		t.Fatalf("expected failed block to wait, got %d", num)
+	}
+
+	queue.Reorged(5)
+
+	stat := queue.Stat()
+	if stat.UnconfirmedProgress != 2 || stat.UnconfirmedWaiting != 2 {
+		t.Fatalf("expected 2 blocks in progress & 2 waiting, got %+v", stat)
+	}
+
+}
+
+func TestConfirmedNext(t *testing.T) {
+
+	queue := newStartedQueue(t, 0, map[uint64]*Block{
+		12: confirmable(10 * time.Second),
+		10: confirmable(2 * time.Second),
+		11: confirmable(5 * time.Second),
+		// Not yet time to retry
+		9: {UnconfirmedDone: true, LastAttempted: time.Now().UTC(), Delay: time.Minute},
+	})
+
+	if num, ok := queue.ConfirmedNext(); ok {
+		t.Fatalf("expected nothing to be confirmed before finality, got %d", num)
+	}
+
+	queue.Latest(11 + config.GetBlockConfirmations())
+
+	// Lowest first, as those reach finality first
+	for _, expected := range []uint64{10, 11} {
+
+		if num, ok := queue.ConfirmedNext(); !ok || num != expected {
+			t.Fatalf("expected block %d to be confirmed next, got %d", expected, num)
+		}
+
+	}
+
+	if num, ok := queue.ConfirmedNext(); ok {
+		t.Fatalf("expected block 12 to wait for finality, got %d", num)
+	}
+
+	queue.ConfirmedDone(10)
+	queue.ConfirmedFailed(11)
+
+	stat := queue.Stat()
+	if stat.ConfirmedProgress != 0 || stat.ConfirmedWaiting != 3 {
+		t.Fatalf("expected 3 blocks waiting for confirmation, got %+v", stat)
+	}
+
+	// Confirmed block gets cleaned up
+	deadline := time.Now().Add(3 * time.Second)
+	for queue.Stat().Total != 1 {
+
+		if time.Now().After(deadline) {
+			t.Fatal("expected confirmed block to be cleaned up")
+		}
+
+		time.Sleep(10 * time.Millisecond)
+
+	}
+
+}
+
+// backlog - Blocks waiting to be processed, as found during backfill
+func backlog(size uint64) map[uint64]*Block {
+
+	blocks := make(map[uint64]*Block, size)
+	for i := uint64(0); i < size; i++ {
+		blocks[i] = waiting(time.Duration(size-i) * time.Millisecond)
+	}
+
+	return blocks
+
+}
+
+func BenchmarkUnconfirmedNext(b *testing.B) {
+
+	for _, size := range []uint64{1_000, 10_000, 100_000} {
+
+		b.Run(fmt.Sprintf("backlog=%d", size), func(b *testing.B) {
+
+			// Enough for each iteration to find something to process
+			queue := newStartedQueue(b, 0, backlog(size+uint64(b.N)))
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+
+				num, ok := queue.UnconfirmedNext()
+				if !ok {
+					b.Fatal("expected block to be processed")
+				}
+
+				queue.UnconfirmedDone(num)
+
+			}
+
+		})
+
+	}
+
+}
+
+func BenchmarkConfirmedNext(b *testing.B) {
+
+	for _, size := range []uint64{1_000, 10_000, 100_000} {
+
+		b.Run(fmt.Sprintf("backlog=%d", size), func(b *testing.B) {
+
+			blocks := make(map[uint64]*Block, size+uint64(b.N))
+			for i := uint64(0); i < size+uint64(b.N); i++ {
+				blocks[i] = confirmable(time.Second)
+			}
+
+			queue := newStartedQueue(b, 0, blocks)
+			queue.Latest(size + uint64(b.N) + config.GetBlockConfirmations())
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+
+				num, ok := queue.ConfirmedNext()
+				if !ok {
+					b.Fatal("expected block to be confirmed")
+				}
+
+				queue.ConfirmedDone(num)
+
+			}
+
+		})
+
+	}
+
+}
+
+func BenchmarkStat(b *testing.B) {
+
+	for _, size := range []uint64{1_000, 10_000, 100_000} {
+
+		b.Run(fmt.Sprintf("backlog=%d", size), func(b *testing.B) {
+
+			queue := newStartedQueue(b, 0, backlog(size))
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				queue.Stat()
+			}
+
+		})
+
+	}
+
+}
@@ -0,0 +1,206 @@
+package queue
+
+import (
+	"log"
+	"sync"
+)
+
+// Store - Persistent storage for state of blocks living in queue, so that blocks
+// waiting for confirmation, along with their retry history, survive restarts
+type Store interface {
+	Load() (map[uint64]*Block, error)
+	Save(blocks map[uint64]*Block) error
+	Remove(numbers []uint64) error
+}
+
+// Restore - Loads state of blocks, persisted during previous run, into queue & attaches
+// store to it, so that all further changes get persisted, to be invoked before
+// starting queue
+//
+// Blocks which were being processed when service went down, are put back
+// to waiting, so that they get picked up again
+func (b *BlockProcessorQueue) Restore(store Store) error {
+
+	blocks, err := store.Load()
+	if err != nil {
+		return err
+	}
+
+	for k, v := range blocks {
+
+		// Already put into queue during this run
+		if _, ok := b.Blocks[k]; ok {
+			continue
+		}
+
+		v.UnconfirmedProgress = false
+		v.ConfirmedProgress = false
+
+		b.add(k, v)
+
+	}
+
+	b.Store = store
+	return nil
+
+}
+
+// touch - Marks block as changed, so that it gets persisted next time
+func (b *BlockProcessorQueue) touch(num uint64) {
+
+	if b.Store != nil {
+		b.dirty[num] = true
+	}
+
+}
+
+// persist - Hands state of blocks, changed since last time, over to writer, while
+// blocks which are not in queue anymore, are handed over to be removed from store
+//
+// Queue doesn't wait for store, writer is nudged even when nothing changed, so
+// that changes it failed to write earlier, get attempted again
+func (b *BlockProcessorQueue) persist(w *writer) {
+
+	if w == nil {
+		return
+	}
+
+	changes := make(map[uint64]*Block, len(b.dirty))
+
+	for k := range b.dirty {
+
+		block, ok := b.Blocks[k]
+		if !ok {
+			changes[k] = nil
+			continue
+		}
+
+		_block := *block
+		changes[k] = &_block
+
+	}
+
+	w.submit(changes)
+	b.dirty = make(map[uint64]bool)
+
+}
+
+// writer - Writes changes handed over by queue into store, in its own go routine,
+// changes made to same block, while previous write is in progress, are coalesced
+// into single one
+type writer struct {
+	store Store
+	lock  sync.Mutex
+	// Latest state of block, nil if it's to be removed from store
+	pending map[uint64]*Block
+	signal  chan struct{}
+	done    chan struct{}
+}
+
+// newWriter - Starts writer go routine for given store, to be stopped
+// using `stop`, once queue is done
+func newWriter(store Store) *writer {
+
+	w := &writer{
+		store:   store,
+		pending: make(map[uint64]*Block),
+		signal:  make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+
+	go w.run()
+
+	return w
+
+}
+
+// submit - Merges changes into pending ones, replacing older state of
+// same block, & lets writer know, without waiting for it
+func (w *writer) submit(changes map[uint64]*Block) {
+
+	w.lock.Lock()
+	for k, v := range changes {
+		w.pending[k] = v
+	}
+	w.lock.Unlock()
+
+	select {
+	case w.signal <- struct{}{}:
+	default:
+		// Writer is already going to pick it up
+	}
+
+}
+
+// stop - Waits for pending changes to be written, after which writer exits
+func (w *writer) stop() {
+
+	close(w.signal)
+	<-w.done
+
+}
+
+// run - Writes pending changes every time writer is signalled, with one
+// last best effort attempt when it's stopped
+func (w *writer) run() {
+
+	defer close(w.done)
+
+	for range w.signal {
+		w.write()
+	}
+
+	w.write()
+
+}
+
+// write - Writes all pending changes into store
+//
+// If it fails, same changes are attempted to be written next time,
+// unless block has changed again, meanwhile
+func (w *writer) write() {
+
+	w.lock.Lock()
+	pending := w.pending
+	w.pending = make(map[uint64]*Block)
+	w.lock.Unlock()
+
+	if len(pending) == 0 {
+		return
+	}
+
+	changed := make(map[uint64]*Block)
+	removed := make([]uint64, 0)
+
+	for k, v := range pending {
+
+		if v == nil {
+			removed = append(removed, k)
+			continue
+		}
+
+		changed[k] = v
+
+	}
+
+	err := w.store.Save(changed)
+	if err == nil {
+		err = w.store.Remove(removed)
+	}
+
+	if err == nil {
+		return
+	}
+
+	log.Printf("[!] Failed to persist block processor queue : %s\n", err.Error())
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	for k, v := range pending {
+		if _, ok := w.pending[k]; !ok {
+			w.pending[k] = v
+		}
+	}
+
+}
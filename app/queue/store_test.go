@@ -0,0 +1,256 @@
+package queue
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+// memoryStore - In-memory store, standing in for database
+type memoryStore struct {
+	lock   sync.Mutex
+	blocks map[uint64]Block
+}
+
+func (m *memoryStore) Load() (map[uint64]*Block, error) {
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	blocks := make(map[uint64]*Block)
+	for k, v := range m.blocks {
+		_v := v
+		blocks[k] = &_v
+	}
+
+	return blocks, nil
+
+}
+
+func (m *memoryStore) Save(blocks map[uint64]*Block) error {
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	for k, v := range blocks {
+		m.blocks[k] = *v
+	}
+
+	return nil
+
+}
+
+func (m *memoryStore) Remove(numbers []uint64) error {
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	for _, v := range numbers {
+		delete(m.blocks, v)
+	}
+
+	return nil
+
+}
+
+// get - State of block as persisted, waiting a while for it to be there
+func (m *memoryStore) get(t *testing.T, num uint64) Block {
+
+	deadline := time.Now().Add(3 * time.Second)
+
+	for time.Now().Before(deadline) {
+
+		m.lock.Lock()
+		block, ok := m.blocks[num]
+		m.lock.Unlock()
+
+		if ok {
+			return block
+		}
+
+		time.Sleep(10 * time.Millisecond)
+
+	}
+
+	t.Fatalf("block %d not persisted", num)
+	return Block{}
+
+}
+
+func TestRestore(t *testing.T) {
+
+	store := &memoryStore{blocks: make(map[uint64]Block)}
+
+	queue := New(0, false)
+	if err := queue.Restore(store); err != nil {
+		t.Fatalf("failed to restore queue : %s", err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go queue.Start(ctx)
+
+	// Processed, published & waiting for confirmations
+	queue.Put(10)
+	queue.Published(10)
+	queue.UnconfirmedDone(10)
+
+	// Failed once, waiting to be retried
+	queue.Put(11)
+	queue.UnconfirmedFailed(11)
+
+	// Being processed when service goes down
+	queue.Put(12)
+
+	if waiting := store.get(t, 10); !waiting.Published || !waiting.UnconfirmedDone || waiting.ConfirmedDone {
+		t.Fatalf("unexpected state of waiting block %+v", waiting)
+	}
+
+	if failed := store.get(t, 11); failed.Attempts != 1 || failed.Delay != 2*time.Second {
+		t.Fatalf("expected 1 attempt with 2s delay, got %d with %s", failed.Attempts, failed.Delay)
+	}
+
+	store.get(t, 12)
+	cancel()
+
+	restarted := New(0, false)
+	if err := restarted.Restore(store); err != nil {
+		t.Fatalf("failed to restore queue : %s", err.Error())
+	}
+
+	if len(restarted.Blocks) != 3 {
+		t.Fatalf("expected 3 blocks restored, got %d", len(restarted.Blocks))
+	}
+
+	if restarted.Blocks[12].UnconfirmedProgress {
+		t.Fatal("expected block being processed to be put back to waiting")
+	}
+
+	if restarted.Blocks[11].Attempts != 1 || restarted.Blocks[11].CanAttempt() {
+		t.Fatal("expected retry backoff of failed block to be retained")
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	go restarted.Start(ctx)
+
+	// Already in queue, so not to be processed afresh
+	if restarted.Put(10) {
+		t.Fatal("expected restored block to not be put again")
+	}
+
+	restarted.Latest(100)
+
+	if num, ok := restarted.ConfirmedNext(); !ok || num != 10 {
+		t.Fatalf("expected block 10 to be confirmed next, got %d", num)
+	}
+
+	restarted.ConfirmedDone(10)
+
+	// Confirmed block leaves queue, so it's removed from store too
+	deadline := time.Now().Add(3 * time.Second)
+	for {
+
+		store.lock.Lock()
+		_, ok := store.blocks[10]
+		store.lock.Unlock()
+
+		if !ok {
+			break
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatal("expected confirmed block to be removed from store")
+		}
+
+		time.Sleep(10 * time.Millisecond)
+
+	}
+
+}
+
+// slowStore - Store, writes into which block until released
+type slowStore struct {
+	memoryStore
+	saving  chan struct{}
+	release chan struct{}
+}
+
+func (s *slowStore) Save(blocks map[uint64]*Block) error {
+
+	select {
+	case s.saving <- struct{}{}:
+	default:
+	}
+
+	<-s.release
+
+	return s.memoryStore.Save(blocks)
+
+}
+
+func TestPersistWithSlowStore(t *testing.T) {
+
+	store := &slowStore{
+		memoryStore: memoryStore{blocks: make(map[uint64]Block)},
+		saving:      make(chan struct{}, 1),
+		release:     make(chan struct{}),
+	}
+
+	queue := New(0, false)
+	if err := queue.Restore(store); err != nil {
+		t.Fatalf("failed to restore queue : %s", err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	go queue.Start(ctx)
+
+	queue.Put(10)
+
+	select {
+	case <-store.saving:
+	case <-time.After(3 * time.Second):
+		t.Fatal("expected block to be written into store")
+	}
+
+	// Queue keeps serving, while write is in progress
+	done := make(chan struct{})
+	go func() {
+
+		queue.Published(10)
+		queue.UnconfirmedDone(10)
+		queue.Stat()
+
+		close(done)
+
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("queue blocked on store")
+	}
+
+	close(store.release)
+
+	// Changes made meanwhile, get written afterwards
+	deadline := time.Now().Add(3 * time.Second)
+	for {
+
+		block := store.get(t, 10)
+		if block.Published && block.UnconfirmedDone {
+			break
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("expected latest state of block to be written, got %+v", block)
+		}
+
+		time.Sleep(10 * time.Millisecond)
+
+	}
+
+}
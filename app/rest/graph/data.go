@@ -3,11 +3,13 @@ package graph
 import (
 	"context"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/denniswon/validationcloud/app/data"
+	"github.com/denniswon/validationcloud/app/decoder"
 	"github.com/denniswon/validationcloud/app/rest/graph/model"
 	"github.com/gin-gonic/gin"
 	"github.com/lib/pq"
@@ -15,6 +17,7 @@ import (
 )
 
 var db *gorm.DB
+var chains data.Chains
 
 // GetDatabaseConnection - Passing already connected database handle to this package,
 // so that it can be used for handling database queries for resolving graphQL queries
@@ -22,6 +25,37 @@ func GetDatabaseConnection(conn *gorm.DB) {
 	db = conn
 }
 
+// GetChains - Passing all chains being indexed to this package, so that queries
+// can be targeted to any of them & finality of blocks can be told
+func GetChains(_chains data.Chains) {
+	chains = _chains
+}
+
+// chainOf - Chain, graphQL query is targeting, either by its name or chain ID,
+// first configured one when not specified, nil if it's not being indexed
+func chainOf(key *string) *data.Chain {
+
+	if key == nil {
+		return chains.Find("")
+	}
+
+	return chains.Find(*key)
+
+}
+
+// finalityOf - Finality status of block, as per last known safe & finalized
+// heads of chain it belongs to
+func finalityOf(chainID uint64, number uint64) string {
+
+	chain := chains.ByID(chainID)
+	if chain == nil || chain.Status == nil {
+		return data.FinalityLatest
+	}
+
+	return chain.Status.FinalityOf(number)
+
+}
+
 func routerContextFromGraphQLContext(ctx context.Context) (*gin.Context, error) {
 
 	ginContext := ctx.Value("RouterContextInGraphQL")
@@ -65,6 +99,12 @@ func getGraphQLCompatibleBlock(ctx context.Context, block *data.Block) (*model.B
 		TxRootHash:      block.TransactionRootHash,
 		ReceiptRootHash: block.ReceiptRootHash,
 		ExtraData:       extraData,
+		BaseFee:         block.BaseFee,
+		BlobGasUsed:     fmt.Sprintf("%d", block.BlobGasUsed),
+		ExcessBlobGas:   fmt.Sprintf("%d", block.ExcessBlobGas),
+		WithdrawalsRoot: block.WithdrawalsRootHash,
+		Finality:        finalityOf(block.Chain, block.Number),
+		Chain:           fmt.Sprintf("%d", block.Chain),
 	}, nil
 
 }
@@ -95,42 +135,66 @@ func getGraphQLCompatibleTransaction(ctx context.Context, tx *data.Transaction,
 		return nil, errors.New("Found nothing")
 	}
 
+	decoder.AnnotateTransaction(db, tx)
+
 	data := ""
 	if _h := hex.EncodeToString(tx.Data); _h != "" {
 		data = fmt.Sprintf("0x%s", _h)
 	}
 
-	if !strings.HasPrefix(tx.Contract, "0x") {
-		return &model.Transaction{
-			Hash:      tx.Hash,
-			From:      tx.From,
-			To:        tx.To,
-			Contract:  "",
-			Value:     tx.Value,
-			Data:      data,
-			Gas:       fmt.Sprintf("%d", tx.Gas),
-			GasPrice:  tx.GasPrice,
-			Cost:      tx.Cost,
-			Nonce:     fmt.Sprintf("%d", tx.Nonce),
-			State:     fmt.Sprintf("%d", tx.State),
-			BlockHash: tx.BlockHash,
-		}, nil
-	}
-
-	return &model.Transaction{
-		Hash:      tx.Hash,
-		From:      tx.From,
-		To:        "",
-		Contract:  tx.Contract,
-		Value:     tx.Value,
-		Data:      data,
-		Gas:       fmt.Sprintf("%d", tx.Gas),
-		GasPrice:  tx.GasPrice,
-		Cost:      tx.Cost,
-		Nonce:     fmt.Sprintf("%d", tx.Nonce),
-		State:     fmt.Sprintf("%d", tx.State),
-		BlockHash: tx.BlockHash,
-	}, nil
+	logsBloom := ""
+	if _h := hex.EncodeToString(tx.LogsBloom); _h != "" {
+		logsBloom = fmt.Sprintf("0x%s", _h)
+	}
+
+	accessList := make([]*model.AccessTuple, 0)
+	if len(tx.AccessList) != 0 {
+		if err := json.Unmarshal(tx.AccessList, &accessList); err != nil {
+			return nil, errors.New("Failed to decode access list")
+		}
+	}
+
+	blobHashes := make([]string, len(tx.BlobHashes))
+	copy(blobHashes, tx.BlobHashes)
+
+	_tx := &model.Transaction{
+		Hash:                 tx.Hash,
+		From:                 tx.From,
+		To:                   tx.To,
+		Contract:             "",
+		Value:                tx.Value,
+		Data:                 data,
+		Gas:                  fmt.Sprintf("%d", tx.Gas),
+		GasPrice:             tx.GasPrice,
+		Cost:                 tx.Cost,
+		Nonce:                fmt.Sprintf("%d", tx.Nonce),
+		State:                fmt.Sprintf("%d", tx.State),
+		BlockHash:            tx.BlockHash,
+		Type:                 fmt.Sprintf("%d", tx.Type),
+		ChainID:              tx.ChainID,
+		MaxFeePerGas:         tx.MaxFeePerGas,
+		MaxPriorityFeePerGas: tx.MaxPriorityFeePerGas,
+		EffectiveGasPrice:    tx.EffectiveGasPrice,
+		GasUsed:              fmt.Sprintf("%d", tx.GasUsed),
+		CumulativeGasUsed:    fmt.Sprintf("%d", tx.CumulativeGasUsed),
+		AccessList:           accessList,
+		MaxFeePerBlobGas:     tx.MaxFeePerBlobGas,
+		BlobGasUsed:          fmt.Sprintf("%d", tx.BlobGasUsed),
+		BlobGasPrice:         tx.BlobGasPrice,
+		BlobHashes:           blobHashes,
+		LogsBloom:            logsBloom,
+		Chain:                fmt.Sprintf("%d", tx.Chain),
+		Decoded:              getGraphQLCompatibleDecoded(tx.Decoded),
+		Signature:            getGraphQLCompatibleSignature(tx.Signature),
+	}
+
+	// When tx creates contract
+	if strings.HasPrefix(tx.Contract, "0x") {
+		_tx.To = ""
+		_tx.Contract = tx.Contract
+	}
+
+	return _tx, nil
 }
 
 // Converting transaction array to graphQL compatible data structure
@@ -159,6 +223,8 @@ func getGraphQLCompatibleEvent(ctx context.Context, event *data.Event, bookKeepi
 		return nil, errors.New("Found nothing")
 	}
 
+	decoder.AnnotateEvent(db, event)
+
 	data := ""
 	if _h := hex.EncodeToString(event.Data); _h != "" && _h != strings.Repeat("0", 64) {
 		data = fmt.Sprintf("0x%s", _h)
@@ -171,6 +237,9 @@ func getGraphQLCompatibleEvent(ctx context.Context, event *data.Event, bookKeepi
 		Data:      data,
 		TxHash:    event.TransactionHash,
 		BlockHash: event.BlockHash,
+		Chain:     fmt.Sprintf("%d", event.Chain),
+		Decoded:   getGraphQLCompatibleDecoded(event.Decoded),
+		Signature: getGraphQLCompatibleSignature(event.Signature),
 	}, nil
 }
 
@@ -194,6 +263,261 @@ func getGraphQLCompatibleEvents(ctx context.Context, events *data.Events) ([]*mo
 	return _events, nil
 }
 
+// Converting trace data to graphQL compatible data structure
+func getGraphQLCompatibleTrace(ctx context.Context, trace *data.Trace) (*model.Trace, error) {
+	if trace == nil {
+		return nil, errors.New("Found nothing")
+	}
+
+	traceAddress := make([]int, len(trace.TraceAddress))
+	for k, v := range trace.TraceAddress {
+		traceAddress[k] = int(v)
+	}
+
+	return &model.Trace{
+		TxHash:       trace.TransactionHash,
+		TraceAddress: traceAddress,
+		Type:         trace.Type,
+		From:         trace.From,
+		To:           trace.To,
+		Value:        trace.Value,
+		Input:        data.HexOf(trace.Input),
+		Output:       data.HexOf(trace.Output),
+		Error:        trace.Error,
+		GasUsed:      fmt.Sprintf("%d", trace.GasUsed),
+		BlockHash:    trace.BlockHash,
+	}, nil
+}
+
+// Converting trace array to graphQL compatible data structure
+func getGraphQLCompatibleTraces(ctx context.Context, traces *data.Traces) ([]*model.Trace, error) {
+	if traces == nil {
+		return nil, errors.New("Found nothing")
+	}
+
+	if !(len(traces.Traces) > 0) {
+		return nil, errors.New("Found nothing")
+	}
+
+	_traces := make([]*model.Trace, len(traces.Traces))
+
+	for k, v := range traces.Traces {
+		_v, _ := getGraphQLCompatibleTrace(ctx, v)
+		_traces[k] = _v
+	}
+
+	return _traces, nil
+}
+
+// Converting withdrawal data to graphQL compatible data structure
+func getGraphQLCompatibleWithdrawal(ctx context.Context, withdrawal *data.Withdrawal) (*model.Withdrawal, error) {
+	if withdrawal == nil {
+		return nil, errors.New("Found nothing")
+	}
+
+	return &model.Withdrawal{
+		Index:          fmt.Sprintf("%d", withdrawal.Index),
+		ValidatorIndex: fmt.Sprintf("%d", withdrawal.ValidatorIndex),
+		Address:        withdrawal.Address,
+		Amount:         fmt.Sprintf("%d", withdrawal.Amount),
+		BlockHash:      withdrawal.BlockHash,
+		Chain:          fmt.Sprintf("%d", withdrawal.Chain),
+	}, nil
+}
+
+// Converting withdrawal array to graphQL compatible data structure
+func getGraphQLCompatibleWithdrawals(ctx context.Context, withdrawals *data.Withdrawals) ([]*model.Withdrawal, error) {
+	if withdrawals == nil {
+		return nil, errors.New("Found nothing")
+	}
+
+	if !(len(withdrawals.Withdrawals) > 0) {
+		return nil, errors.New("Found nothing")
+	}
+
+	_withdrawals := make([]*model.Withdrawal, len(withdrawals.Withdrawals))
+
+	for k, v := range withdrawals.Withdrawals {
+		_v, _ := getGraphQLCompatibleWithdrawal(ctx, v)
+		_withdrawals[k] = _v
+	}
+
+	return _withdrawals, nil
+}
+
+// Converting uncle data to graphQL compatible data structure
+func getGraphQLCompatibleUncle(ctx context.Context, uncle *data.Uncle) (*model.Uncle, error) {
+	if uncle == nil {
+		return nil, errors.New("Found nothing")
+	}
+
+	return &model.Uncle{
+		Position:   fmt.Sprintf("%d", uncle.Position),
+		Hash:       uncle.Hash,
+		Number:     fmt.Sprintf("%d", uncle.Number),
+		Time:       fmt.Sprintf("%d", uncle.Time),
+		ParentHash: uncle.ParentHash,
+		Difficulty: uncle.Difficulty,
+		GasUsed:    fmt.Sprintf("%d", uncle.GasUsed),
+		GasLimit:   fmt.Sprintf("%d", uncle.GasLimit),
+		Nonce:      uncle.Nonce,
+		Miner:      uncle.Miner,
+		ExtraData:  data.HexOf(uncle.ExtraData),
+	}, nil
+}
+
+// Converting uncle array to graphQL compatible data structure
+//
+// Most blocks don't reference any uncle, so empty array is returned
+// instead of error
+func getGraphQLCompatibleUncles(ctx context.Context, uncles *data.Uncles) ([]*model.Uncle, error) {
+	if uncles == nil {
+		return nil, errors.New("Failed to fetch uncles")
+	}
+
+	_uncles := make([]*model.Uncle, len(uncles.Uncles))
+
+	for k, v := range uncles.Uncles {
+		_v, _ := getGraphQLCompatibleUncle(ctx, v)
+		_uncles[k] = _v
+	}
+
+	return _uncles, nil
+}
+
+// Converting token transfer data to graphQL compatible data structure
+func getGraphQLCompatibleTokenTransfer(ctx context.Context, transfer *data.TokenTransfer) (*model.TokenTransfer, error) {
+	if transfer == nil {
+		return nil, errors.New("Found nothing")
+	}
+
+	return &model.TokenTransfer{
+		Token:      transfer.Token,
+		Standard:   transfer.Standard,
+		Operator:   transfer.Operator,
+		From:       transfer.From,
+		To:         transfer.To,
+		TokenID:    transfer.TokenID,
+		Amount:     transfer.Amount,
+		LogIndex:   fmt.Sprintf("%d", transfer.LogIndex),
+		BatchIndex: fmt.Sprintf("%d", transfer.BatchIndex),
+		TxHash:     transfer.TransactionHash,
+		BlockHash:  transfer.BlockHash,
+	}, nil
+}
+
+// Converting token transfer array to graphQL compatible data structure
+func getGraphQLCompatibleTokenTransfers(ctx context.Context, transfers *data.TokenTransfers) ([]*model.TokenTransfer, error) {
+	if transfers == nil {
+		return nil, errors.New("Found nothing")
+	}
+
+	if !(len(transfers.TokenTransfers) > 0) {
+		return nil, errors.New("Found nothing")
+	}
+
+	_transfers := make([]*model.TokenTransfer, len(transfers.TokenTransfers))
+
+	for k, v := range transfers.TokenTransfers {
+		_v, _ := getGraphQLCompatibleTokenTransfer(ctx, v)
+		_transfers[k] = _v
+	}
+
+	return _transfers, nil
+}
+
+// Converting decoded calldata/ event log to graphQL compatible data structure,
+// argument values which are not strings are put in their JSON encoded form
+func getGraphQLCompatibleDecoded(decoded *data.Decoded) *model.Decoded {
+	if decoded == nil {
+		return nil
+	}
+
+	args := make([]*model.DecodedArgument, len(decoded.Arguments))
+
+	for k, v := range decoded.Arguments {
+
+		value, ok := v.Value.(string)
+		if !ok {
+			encoded, err := json.Marshal(v.Value)
+			if err != nil {
+				return nil
+			}
+
+			value = string(encoded)
+		}
+
+		args[k] = &model.DecodedArgument{
+			Name:  v.Name,
+			Type:  v.Type,
+			Value: value,
+		}
+
+	}
+
+	return &model.Decoded{
+		Name:      decoded.Name,
+		Signature: decoded.Signature,
+		Args:      args,
+	}
+}
+
+// Converting guessed method/ event signature to graphQL compatible data structure
+func getGraphQLCompatibleSignature(signature *data.Signature) *model.Signature {
+	if signature == nil {
+		return nil
+	}
+
+	candidates := make([]string, len(signature.Candidates))
+	copy(candidates, signature.Candidates)
+
+	return &model.Signature{
+		Name:       signature.Name,
+		Signature:  signature.Signature,
+		Ambiguous:  signature.Ambiguous,
+		Candidates: candidates,
+	}
+}
+
+// Converting contract data to graphQL compatible data structure
+func getGraphQLCompatibleContract(ctx context.Context, contract *data.Contract) (*model.Contract, error) {
+	if contract == nil {
+		return nil, errors.New("Found nothing")
+	}
+
+	return &model.Contract{
+		Address:        contract.Address,
+		Creator:        contract.Creator,
+		TxHash:         contract.TransactionHash,
+		BlockHash:      contract.BlockHash,
+		BlockNumber:    fmt.Sprintf("%d", contract.BlockNumber),
+		CodeHash:       contract.CodeHash,
+		ProxyType:      contract.ProxyType,
+		Implementation: contract.Implementation,
+		UpgradedAt:     fmt.Sprintf("%d", contract.UpgradedAt),
+	}, nil
+}
+
+// Converting contract array to graphQL compatible data structure
+func getGraphQLCompatibleContracts(ctx context.Context, contracts *data.Contracts) ([]*model.Contract, error) {
+	if contracts == nil {
+		return nil, errors.New("Found nothing")
+	}
+
+	if !(len(contracts.Contracts) > 0) {
+		return nil, errors.New("Found nothing")
+	}
+
+	_contracts := make([]*model.Contract, len(contracts.Contracts))
+
+	for k, v := range contracts.Contracts {
+		_v, _ := getGraphQLCompatibleContract(ctx, v)
+		_contracts[k] = _v
+	}
+
+	return _contracts, nil
+}
+
 func getTopicSignaturesAsStringSlice(topics pq.StringArray) []string {
 	_tmp := make([]string, len(topics))
 
@@ -2,44 +2,157 @@
 
 package model
 
+type AccessTuple struct {
+	Address     string   `json:"address"`
+	StorageKeys []string `json:"storageKeys"`
+}
+
 type Block struct {
-	Hash            string  `json:"hash"`
-	Number          string  `json:"number"`
-	Time            string  `json:"time"`
-	ParentHash      string  `json:"parentHash"`
-	Difficulty      string  `json:"difficulty"`
-	GasUsed         string  `json:"gasUsed"`
-	GasLimit        string  `json:"gasLimit"`
-	Nonce           string  `json:"nonce"`
-	Miner           string  `json:"miner"`
-	Size            float64 `json:"size"`
-	StateRootHash   string  `json:"stateRootHash"`
-	UncleHash       string  `json:"uncleHash"`
-	TxRootHash      string  `json:"txRootHash"`
-	ReceiptRootHash string  `json:"receiptRootHash"`
-	ExtraData       string  `json:"extraData"`
+	Hash            string   `json:"hash"`
+	Number          string   `json:"number"`
+	Time            string   `json:"time"`
+	ParentHash      string   `json:"parentHash"`
+	Difficulty      string   `json:"difficulty"`
+	GasUsed         string   `json:"gasUsed"`
+	GasLimit        string   `json:"gasLimit"`
+	Nonce           string   `json:"nonce"`
+	Miner           string   `json:"miner"`
+	Size            float64  `json:"size"`
+	StateRootHash   string   `json:"stateRootHash"`
+	UncleHash       string   `json:"uncleHash"`
+	TxRootHash      string   `json:"txRootHash"`
+	ReceiptRootHash string   `json:"receiptRootHash"`
+	ExtraData       string   `json:"extraData"`
+	BaseFee         string   `json:"baseFee"`
+	BlobGasUsed     string   `json:"blobGasUsed"`
+	ExcessBlobGas   string   `json:"excessBlobGas"`
+	WithdrawalsRoot string   `json:"withdrawalsRoot"`
+	Finality        string   `json:"finality"`
+	Chain           string   `json:"chain"`
+	Uncles          []*Uncle `json:"uncles"`
+}
+
+type Contract struct {
+	Address        string `json:"address"`
+	Creator        string `json:"creator"`
+	TxHash         string `json:"txHash"`
+	BlockHash      string `json:"blockHash"`
+	BlockNumber    string `json:"blockNumber"`
+	CodeHash       string `json:"codeHash"`
+	ProxyType      string `json:"proxyType"`
+	Implementation string `json:"implementation"`
+	UpgradedAt     string `json:"upgradedAt"`
+}
+
+type Decoded struct {
+	Name      string             `json:"name"`
+	Signature string             `json:"signature"`
+	Args      []*DecodedArgument `json:"args"`
+}
+
+type DecodedArgument struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Value string `json:"value"`
 }
 
 type Event struct {
-	Origin    string   `json:"origin"`
-	Index     string   `json:"index"`
-	Topics    []string `json:"topics"`
-	Data      string   `json:"data"`
-	TxHash    string   `json:"txHash"`
-	BlockHash string   `json:"blockHash"`
+	Origin    string     `json:"origin"`
+	Index     string     `json:"index"`
+	Topics    []string   `json:"topics"`
+	Data      string     `json:"data"`
+	TxHash    string     `json:"txHash"`
+	BlockHash string     `json:"blockHash"`
+	Chain     string     `json:"chain"`
+	Decoded   *Decoded   `json:"decoded"`
+	Signature *Signature `json:"signature"`
+}
+
+type Signature struct {
+	Name       string   `json:"name"`
+	Signature  string   `json:"signature"`
+	Ambiguous  bool     `json:"ambiguous"`
+	Candidates []string `json:"candidates"`
+}
+
+type TokenTransfer struct {
+	Token      string `json:"token"`
+	Standard   string `json:"standard"`
+	Operator   string `json:"operator"`
+	From       string `json:"from"`
+	To         string `json:"to"`
+	TokenID    string `json:"tokenId"`
+	Amount     string `json:"amount"`
+	LogIndex   string `json:"logIndex"`
+	BatchIndex string `json:"batchIndex"`
+	TxHash     string `json:"txHash"`
+	BlockHash  string `json:"blockHash"`
+}
+
+type Trace struct {
+	TxHash       string `json:"txHash"`
+	TraceAddress []int  `json:"traceAddress"`
+	Type         string `json:"type"`
+	From         string `json:"from"`
+	To           string `json:"to"`
+	Value        string `json:"value"`
+	Input        string `json:"input"`
+	Output       string `json:"output"`
+	Error        string `json:"error"`
+	GasUsed      string `json:"gasUsed"`
+	BlockHash    string `json:"blockHash"`
 }
 
 type Transaction struct {
-	Hash      string `json:"hash"`
-	From      string `json:"from"`
-	To        string `json:"to"`
-	Contract  string `json:"contract"`
-	Value     string `json:"value"`
-	Data      string `json:"data"`
-	Gas       string `json:"gas"`
-	GasPrice  string `json:"gasPrice"`
-	Cost      string `json:"cost"`
-	Nonce     string `json:"nonce"`
-	State     string `json:"state"`
-	BlockHash string `json:"blockHash"`
+	Hash                 string         `json:"hash"`
+	From                 string         `json:"from"`
+	To                   string         `json:"to"`
+	Contract             string         `json:"contract"`
+	Value                string         `json:"value"`
+	Data                 string         `json:"data"`
+	Gas                  string         `json:"gas"`
+	GasPrice             string         `json:"gasPrice"`
+	Cost                 string         `json:"cost"`
+	Nonce                string         `json:"nonce"`
+	State                string         `json:"state"`
+	BlockHash            string         `json:"blockHash"`
+	Type                 string         `json:"type"`
+	ChainID              string         `json:"chainId"`
+	MaxFeePerGas         string         `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas string         `json:"maxPriorityFeePerGas"`
+	EffectiveGasPrice    string         `json:"effectiveGasPrice"`
+	GasUsed              string         `json:"gasUsed"`
+	CumulativeGasUsed    string         `json:"cumulativeGasUsed"`
+	AccessList           []*AccessTuple `json:"accessList"`
+	MaxFeePerBlobGas     string         `json:"maxFeePerBlobGas"`
+	BlobGasUsed          string         `json:"blobGasUsed"`
+	BlobGasPrice         string         `json:"blobGasPrice"`
+	BlobHashes           []string       `json:"blobHashes"`
+	LogsBloom            string         `json:"logsBloom"`
+	Chain                string         `json:"chain"`
+	Decoded              *Decoded       `json:"decoded"`
+	Signature            *Signature     `json:"signature"`
+}
+
+type Uncle struct {
+	Position   string `json:"position"`
+	Hash       string `json:"hash"`
+	Number     string `json:"number"`
+	Time       string `json:"time"`
+	ParentHash string `json:"parentHash"`
+	Difficulty string `json:"difficulty"`
+	GasUsed    string `json:"gasUsed"`
+	GasLimit   string `json:"gasLimit"`
+	Nonce      string `json:"nonce"`
+	Miner      string `json:"miner"`
+	ExtraData  string `json:"extraData"`
+}
+
+type Withdrawal struct {
+	Index          string `json:"index"`
+	ValidatorIndex string `json:"validatorIndex"`
+	Address        string `json:"address"`
+	Amount         string `json:"amount"`
+	BlockHash      string `json:"blockHash"`
+	Chain          string `json:"chain"`
 }
@@ -18,55 +18,94 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 )
 
-func (r *queryResolver) BlockByHash(ctx context.Context, hash string) (*model.Block, error) {
+func (r *blockResolver) Uncles(ctx context.Context, obj *model.Block) ([]*model.Uncle, error) {
+	chainID, err := strconv.ParseUint(obj.Chain, 10, 64)
+	if err != nil {
+		return nil, errors.New("Bad Chain")
+	}
+
+	return getGraphQLCompatibleUncles(ctx, _db.GetUnclesByBlockHash(db, chainID, common.HexToHash(obj.Hash)))
+}
+
+func (r *queryResolver) BlockByHash(ctx context.Context, hash string, chain *string) (*model.Block, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
 	if !(strings.HasPrefix(hash, "0x") && len(hash) == 66) {
 		return nil, errors.New("Bad Block Hash")
 	}
 
-	return getGraphQLCompatibleBlock(ctx, _db.GetBlockByHash(db, common.HexToHash(hash)))
+	return getGraphQLCompatibleBlock(ctx, _db.GetBlockByHash(db, _chain.ID, common.HexToHash(hash)))
 }
 
-func (r *queryResolver) BlockByNumber(ctx context.Context, number string) (*model.Block, error) {
+func (r *queryResolver) BlockByNumber(ctx context.Context, number string, chain *string) (*model.Block, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
 	_number, err := strconv.ParseUint(number, 10, 64)
 	if err != nil {
 		return nil, errors.New("Bad Block Number")
 	}
 
-	return getGraphQLCompatibleBlock(ctx, _db.GetBlockByNumber(db, _number))
+	return getGraphQLCompatibleBlock(ctx, _db.GetBlockByNumber(db, _chain.ID, _number))
 }
 
-func (r *queryResolver) BlocksByNumberRange(ctx context.Context, from string, to string) ([]*model.Block, error) {
+func (r *queryResolver) BlocksByNumberRange(ctx context.Context, from string, to string, chain *string) ([]*model.Block, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
 	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetBlockNumberRange())
 	if err != nil {
 		return nil, errors.New("Bad Block Number Range")
 	}
 
-	return getGraphQLCompatibleBlocks(ctx, _db.GetBlocksByNumberRange(db, _from, _to))
+	return getGraphQLCompatibleBlocks(ctx, _db.GetBlocksByNumberRange(db, _chain.ID, _from, _to))
 }
 
-func (r *queryResolver) BlocksByTimeRange(ctx context.Context, from string, to string) ([]*model.Block, error) {
+func (r *queryResolver) BlocksByTimeRange(ctx context.Context, from string, to string, chain *string) ([]*model.Block, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
 	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetTimeRange())
 	if err != nil {
 		return nil, errors.New("Bad Block Timestamp Range")
 	}
 
-	return getGraphQLCompatibleBlocks(ctx, _db.GetBlocksByTimeRange(db, _from, _to))
+	return getGraphQLCompatibleBlocks(ctx, _db.GetBlocksByTimeRange(db, _chain.ID, _from, _to))
 }
 
-func (r *queryResolver) Transaction(ctx context.Context, hash string) (*model.Transaction, error) {
+func (r *queryResolver) Transaction(ctx context.Context, hash string, chain *string) (*model.Transaction, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
 	if !(strings.HasPrefix(hash, "0x") && len(hash) == 66) {
 		return nil, errors.New("Bad Transaction Hash")
 	}
 
-	return getGraphQLCompatibleTransaction(ctx, _db.GetTransactionByHash(db, common.HexToHash(hash)), true)
+	return getGraphQLCompatibleTransaction(ctx, _db.GetTransactionByHash(db, _chain.ID, common.HexToHash(hash)), true)
 }
 
-func (r *queryResolver) TransactionCountByBlockHash(ctx context.Context, hash string) (int, error) {
+func (r *queryResolver) TransactionCountByBlockHash(ctx context.Context, hash string, chain *string) (int, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return 0, errors.New("Bad Chain")
+	}
+
 	if !(strings.HasPrefix(hash, "0x") && len(hash) == 66) {
 		return 0, errors.New("Bad Block Hash")
 	}
 
-	count := int(_db.GetTransactionCountByBlockHash(db, common.HexToHash(hash)))
+	count := int(_db.GetTransactionCountByBlockHash(db, _chain.ID, common.HexToHash(hash)))
 
 	// Attempting to calculate byte form of number
 	// so that we can keep track of how much data was transferred
@@ -77,21 +116,31 @@ func (r *queryResolver) TransactionCountByBlockHash(ctx context.Context, hash st
 	return count, nil
 }
 
-func (r *queryResolver) TransactionsByBlockHash(ctx context.Context, hash string) ([]*model.Transaction, error) {
+func (r *queryResolver) TransactionsByBlockHash(ctx context.Context, hash string, chain *string) ([]*model.Transaction, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
 	if !(strings.HasPrefix(hash, "0x") && len(hash) == 66) {
 		return nil, errors.New("Bad Block Hash")
 	}
 
-	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsByBlockHash(db, common.HexToHash(hash)))
+	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsByBlockHash(db, _chain.ID, common.HexToHash(hash)))
 }
 
-func (r *queryResolver) TransactionCountByBlockNumber(ctx context.Context, number string) (int, error) {
+func (r *queryResolver) TransactionCountByBlockNumber(ctx context.Context, number string, chain *string) (int, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return 0, errors.New("Bad Chain")
+	}
+
 	_number, err := strconv.ParseUint(number, 10, 64)
 	if err != nil {
 		return 0, errors.New("Bad Block Number")
 	}
 
-	count := int(_db.GetTransactionCountByBlockNumber(db, _number))
+	count := int(_db.GetTransactionCountByBlockNumber(db, _chain.ID, _number))
 
 	// Attempting to calculate byte form of number
 	// so that we can keep track of how much data was transferred
@@ -102,16 +151,26 @@ func (r *queryResolver) TransactionCountByBlockNumber(ctx context.Context, numbe
 	return count, nil
 }
 
-func (r *queryResolver) TransactionsByBlockNumber(ctx context.Context, number string) ([]*model.Transaction, error) {
+func (r *queryResolver) TransactionsByBlockNumber(ctx context.Context, number string, chain *string) ([]*model.Transaction, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
 	_number, err := strconv.ParseUint(number, 10, 64)
 	if err != nil {
 		return nil, errors.New("Bad Block Number")
 	}
 
-	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsByBlockNumber(db, _number))
+	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsByBlockNumber(db, _chain.ID, _number))
 }
 
-func (r *queryResolver) TransactionCountFromAccountByNumberRange(ctx context.Context, account string, from string, to string) (int, error) {
+func (r *queryResolver) TransactionCountFromAccountByNumberRange(ctx context.Context, account string, from string, to string, chain *string) (int, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return 0, errors.New("Bad Chain")
+	}
+
 	if !(strings.HasPrefix(account, "0x") && len(account) == 42) {
 		return 0, errors.New("Bad Account Address")
 	}
@@ -121,7 +180,7 @@ func (r *queryResolver) TransactionCountFromAccountByNumberRange(ctx context.Con
 		return 0, errors.New("Bad Block Number Range")
 	}
 
-	count := int(_db.GetTransactionCountFromAccountByBlockNumberRange(db, common.HexToAddress(account), _from, _to))
+	count := int(_db.GetTransactionCountFromAccountByBlockNumberRange(db, _chain.ID, common.HexToAddress(account), _from, _to))
 
 	// Attempting to calculate byte form of number
 	// so that we can keep track of how much data was transferred
@@ -132,7 +191,12 @@ func (r *queryResolver) TransactionCountFromAccountByNumberRange(ctx context.Con
 	return count, nil
 }
 
-func (r *queryResolver) TransactionsFromAccountByNumberRange(ctx context.Context, account string, from string, to string) ([]*model.Transaction, error) {
+func (r *queryResolver) TransactionsFromAccountByNumberRange(ctx context.Context, account string, from string, to string, chain *string) ([]*model.Transaction, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
 	if !(strings.HasPrefix(account, "0x") && len(account) == 42) {
 		return nil, errors.New("Bad Account Address")
 	}
@@ -142,10 +206,15 @@ func (r *queryResolver) TransactionsFromAccountByNumberRange(ctx context.Context
 		return nil, errors.New("Bad Block Number Range")
 	}
 
-	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsFromAccountByBlockNumberRange(db, common.HexToAddress(account), _from, _to))
+	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsFromAccountByBlockNumberRange(db, _chain.ID, common.HexToAddress(account), _from, _to))
 }
 
-func (r *queryResolver) TransactionCountFromAccountByTimeRange(ctx context.Context, account string, from string, to string) (int, error) {
+func (r *queryResolver) TransactionCountFromAccountByTimeRange(ctx context.Context, account string, from string, to string, chain *string) (int, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return 0, errors.New("Bad Chain")
+	}
+
 	if !(strings.HasPrefix(account, "0x") && len(account) == 42) {
 		return 0, errors.New("Bad Account Address")
 	}
@@ -155,7 +224,7 @@ func (r *queryResolver) TransactionCountFromAccountByTimeRange(ctx context.Conte
 		return 0, errors.New("Bad Block Timestamp Range")
 	}
 
-	count := int(_db.GetTransactionCountFromAccountByBlockTimeRange(db, common.HexToAddress(account), _from, _to))
+	count := int(_db.GetTransactionCountFromAccountByBlockTimeRange(db, _chain.ID, common.HexToAddress(account), _from, _to))
 
 	// Attempting to calculate byte form of number
 	// so that we can keep track of how much data was transferred
@@ -166,7 +235,12 @@ func (r *queryResolver) TransactionCountFromAccountByTimeRange(ctx context.Conte
 	return count, nil
 }
 
-func (r *queryResolver) TransactionsFromAccountByTimeRange(ctx context.Context, account string, from string, to string) ([]*model.Transaction, error) {
+func (r *queryResolver) TransactionsFromAccountByTimeRange(ctx context.Context, account string, from string, to string, chain *string) ([]*model.Transaction, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
 	if !(strings.HasPrefix(account, "0x") && len(account) == 42) {
 		return nil, errors.New("Bad Account Address")
 	}
@@ -176,10 +250,15 @@ func (r *queryResolver) TransactionsFromAccountByTimeRange(ctx context.Context,
 		return nil, errors.New("Bad Block Timestamp Range")
 	}
 
-	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsFromAccountByBlockTimeRange(db, common.HexToAddress(account), _from, _to))
+	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsFromAccountByBlockTimeRange(db, _chain.ID, common.HexToAddress(account), _from, _to))
 }
 
-func (r *queryResolver) TransactionCountToAccountByNumberRange(ctx context.Context, account string, from string, to string) (int, error) {
+func (r *queryResolver) TransactionCountToAccountByNumberRange(ctx context.Context, account string, from string, to string, chain *string) (int, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return 0, errors.New("Bad Chain")
+	}
+
 	if !(strings.HasPrefix(account, "0x") && len(account) == 42) {
 		return 0, errors.New("Bad Account Address")
 	}
@@ -189,7 +268,7 @@ func (r *queryResolver) TransactionCountToAccountByNumberRange(ctx context.Conte
 		return 0, errors.New("Bad Block Number Range")
 	}
 
-	count := int(_db.GetTransactionCountToAccountByBlockNumberRange(db, common.HexToAddress(account), _from, _to))
+	count := int(_db.GetTransactionCountToAccountByBlockNumberRange(db, _chain.ID, common.HexToAddress(account), _from, _to))
 
 	// Attempting to calculate byte form of number
 	// so that we can keep track of how much data was transferred
@@ -200,7 +279,12 @@ func (r *queryResolver) TransactionCountToAccountByNumberRange(ctx context.Conte
 	return count, nil
 }
 
-func (r *queryResolver) TransactionsToAccountByNumberRange(ctx context.Context, account string, from string, to string) ([]*model.Transaction, error) {
+func (r *queryResolver) TransactionsToAccountByNumberRange(ctx context.Context, account string, from string, to string, chain *string) ([]*model.Transaction, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
 	if !(strings.HasPrefix(account, "0x") && len(account) == 42) {
 		return nil, errors.New("Bad Account Address")
 	}
@@ -210,10 +294,15 @@ func (r *queryResolver) TransactionsToAccountByNumberRange(ctx context.Context,
 		return nil, errors.New("Bad Block Number Range")
 	}
 
-	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsToAccountByBlockNumberRange(db, common.HexToAddress(account), _from, _to))
+	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsToAccountByBlockNumberRange(db, _chain.ID, common.HexToAddress(account), _from, _to))
 }
 
-func (r *queryResolver) TransactionCountToAccountByTimeRange(ctx context.Context, account string, from string, to string) (int, error) {
+func (r *queryResolver) TransactionCountToAccountByTimeRange(ctx context.Context, account string, from string, to string, chain *string) (int, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return 0, errors.New("Bad Chain")
+	}
+
 	if !(strings.HasPrefix(account, "0x") && len(account) == 42) {
 		return 0, errors.New("Bad Account Address")
 	}
@@ -223,7 +312,7 @@ func (r *queryResolver) TransactionCountToAccountByTimeRange(ctx context.Context
 		return 0, errors.New("Bad Block Timestamp Range")
 	}
 
-	count := int(_db.GetTransactionCountToAccountByBlockTimeRange(db, common.HexToAddress(account), _from, _to))
+	count := int(_db.GetTransactionCountToAccountByBlockTimeRange(db, _chain.ID, common.HexToAddress(account), _from, _to))
 
 	// Attempting to calculate byte form of number
 	// so that we can keep track of how much data was transferred
@@ -234,7 +323,12 @@ func (r *queryResolver) TransactionCountToAccountByTimeRange(ctx context.Context
 	return count, nil
 }
 
-func (r *queryResolver) TransactionsToAccountByTimeRange(ctx context.Context, account string, from string, to string) ([]*model.Transaction, error) {
+func (r *queryResolver) TransactionsToAccountByTimeRange(ctx context.Context, account string, from string, to string, chain *string) ([]*model.Transaction, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
 	if !(strings.HasPrefix(account, "0x") && len(account) == 42) {
 		return nil, errors.New("Bad Account Address")
 	}
@@ -244,10 +338,15 @@ func (r *queryResolver) TransactionsToAccountByTimeRange(ctx context.Context, ac
 		return nil, errors.New("Bad Block Timestamp Range")
 	}
 
-	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsToAccountByBlockTimeRange(db, common.HexToAddress(account), _from, _to))
+	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsToAccountByBlockTimeRange(db, _chain.ID, common.HexToAddress(account), _from, _to))
 }
 
-func (r *queryResolver) TransactionCountBetweenAccountsByNumberRange(ctx context.Context, fromAccount string, toAccount string, from string, to string) (int, error) {
+func (r *queryResolver) TransactionCountBetweenAccountsByNumberRange(ctx context.Context, fromAccount string, toAccount string, from string, to string, chain *string) (int, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return 0, errors.New("Bad Chain")
+	}
+
 	if !(strings.HasPrefix(fromAccount, "0x") && len(fromAccount) == 42) {
 		return 0, errors.New("Bad From Account Address")
 	}
@@ -261,7 +360,7 @@ func (r *queryResolver) TransactionCountBetweenAccountsByNumberRange(ctx context
 		return 0, errors.New("Bad Block Number Range")
 	}
 
-	count := int(_db.GetTransactionCountBetweenAccountsByBlockNumberRange(db, common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _from, _to))
+	count := int(_db.GetTransactionCountBetweenAccountsByBlockNumberRange(db, _chain.ID, common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _from, _to))
 
 	// Attempting to calculate byte form of number
 	// so that we can keep track of how much data was transferred
@@ -272,7 +371,12 @@ func (r *queryResolver) TransactionCountBetweenAccountsByNumberRange(ctx context
 	return count, nil
 }
 
-func (r *queryResolver) TransactionsBetweenAccountsByNumberRange(ctx context.Context, fromAccount string, toAccount string, from string, to string) ([]*model.Transaction, error) {
+func (r *queryResolver) TransactionsBetweenAccountsByNumberRange(ctx context.Context, fromAccount string, toAccount string, from string, to string, chain *string) ([]*model.Transaction, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
 	if !(strings.HasPrefix(fromAccount, "0x") && len(fromAccount) == 42) {
 		return nil, errors.New("Bad From Account Address")
 	}
@@ -286,10 +390,15 @@ func (r *queryResolver) TransactionsBetweenAccountsByNumberRange(ctx context.Con
 		return nil, errors.New("Bad Block Number Range")
 	}
 
-	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsBetweenAccountsByBlockNumberRange(db, common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _from, _to))
+	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsBetweenAccountsByBlockNumberRange(db, _chain.ID, common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _from, _to))
 }
 
-func (r *queryResolver) TransactionCountBetweenAccountsByTimeRange(ctx context.Context, fromAccount string, toAccount string, from string, to string) (int, error) {
+func (r *queryResolver) TransactionCountBetweenAccountsByTimeRange(ctx context.Context, fromAccount string, toAccount string, from string, to string, chain *string) (int, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return 0, errors.New("Bad Chain")
+	}
+
 	if !(strings.HasPrefix(fromAccount, "0x") && len(fromAccount) == 42) {
 		return 0, errors.New("Bad From Account Address")
 	}
@@ -303,7 +412,7 @@ func (r *queryResolver) TransactionCountBetweenAccountsByTimeRange(ctx context.C
 		return 0, errors.New("Bad Block Timestamp Range")
 	}
 
-	count := int(_db.GetTransactionCountBetweenAccountsByBlockTimeRange(db, common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _from, _to))
+	count := int(_db.GetTransactionCountBetweenAccountsByBlockTimeRange(db, _chain.ID, common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _from, _to))
 
 	// Attempting to calculate byte form of number
 	// so that we can keep track of how much data was transferred
@@ -314,7 +423,12 @@ func (r *queryResolver) TransactionCountBetweenAccountsByTimeRange(ctx context.C
 	return count, nil
 }
 
-func (r *queryResolver) TransactionsBetweenAccountsByTimeRange(ctx context.Context, fromAccount string, toAccount string, from string, to string) ([]*model.Transaction, error) {
+func (r *queryResolver) TransactionsBetweenAccountsByTimeRange(ctx context.Context, fromAccount string, toAccount string, from string, to string, chain *string) ([]*model.Transaction, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
 	if !(strings.HasPrefix(fromAccount, "0x") && len(fromAccount) == 42) {
 		return nil, errors.New("Bad From Account Address")
 	}
@@ -328,10 +442,15 @@ func (r *queryResolver) TransactionsBetweenAccountsByTimeRange(ctx context.Conte
 		return nil, errors.New("Bad Block Timestamp Range")
 	}
 
-	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsBetweenAccountsByBlockTimeRange(db, common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _from, _to))
+	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsBetweenAccountsByBlockTimeRange(db, _chain.ID, common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _from, _to))
 }
 
-func (r *queryResolver) ContractsCreatedFromAccountByNumberRange(ctx context.Context, account string, from string, to string) ([]*model.Transaction, error) {
+func (r *queryResolver) ContractsCreatedFromAccountByNumberRange(ctx context.Context, account string, from string, to string, chain *string) ([]*model.Transaction, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
 	if !(strings.HasPrefix(account, "0x") && len(account) == 42) {
 		return nil, errors.New("Bad Account Address")
 	}
@@ -341,10 +460,15 @@ func (r *queryResolver) ContractsCreatedFromAccountByNumberRange(ctx context.Con
 		return nil, errors.New("Bad Block Number Range")
 	}
 
-	return getGraphQLCompatibleTransactions(ctx, _db.GetContractCreationTransactionsFromAccountByBlockNumberRange(db, common.HexToAddress(account), _from, _to))
+	return getGraphQLCompatibleTransactions(ctx, _db.GetContractCreationTransactionsFromAccountByBlockNumberRange(db, _chain.ID, common.HexToAddress(account), _from, _to))
 }
 
-func (r *queryResolver) ContractsCreatedFromAccountByTimeRange(ctx context.Context, account string, from string, to string) ([]*model.Transaction, error) {
+func (r *queryResolver) ContractsCreatedFromAccountByTimeRange(ctx context.Context, account string, from string, to string, chain *string) ([]*model.Transaction, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
 	if !(strings.HasPrefix(account, "0x") && len(account) == 42) {
 		return nil, errors.New("Bad Account Address")
 	}
@@ -354,10 +478,15 @@ func (r *queryResolver) ContractsCreatedFromAccountByTimeRange(ctx context.Conte
 		return nil, errors.New("Bad Block Timestamp Range")
 	}
 
-	return getGraphQLCompatibleTransactions(ctx, _db.GetContractCreationTransactionsFromAccountByBlockTimeRange(db, common.HexToAddress(account), _from, _to))
+	return getGraphQLCompatibleTransactions(ctx, _db.GetContractCreationTransactionsFromAccountByBlockTimeRange(db, _chain.ID, common.HexToAddress(account), _from, _to))
 }
 
-func (r *queryResolver) TransactionFromAccountWithNonce(ctx context.Context, account string, nonce string) (*model.Transaction, error) {
+func (r *queryResolver) TransactionFromAccountWithNonce(ctx context.Context, account string, nonce string, chain *string) (*model.Transaction, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
 	if !(strings.HasPrefix(account, "0x") && len(account) == 42) {
 		return nil, errors.New("Bad Account Address")
 	}
@@ -367,10 +496,15 @@ func (r *queryResolver) TransactionFromAccountWithNonce(ctx context.Context, acc
 		return nil, errors.New("Bad Account Nonce")
 	}
 
-	return getGraphQLCompatibleTransaction(ctx, _db.GetTransactionFromAccountWithNonce(db, common.HexToAddress(account), _nonce), true)
+	return getGraphQLCompatibleTransaction(ctx, _db.GetTransactionFromAccountWithNonce(db, _chain.ID, common.HexToAddress(account), _nonce), true)
 }
 
-func (r *queryResolver) EventsFromContractByNumberRange(ctx context.Context, contract string, from string, to string) ([]*model.Event, error) {
+func (r *queryResolver) EventsFromContractByNumberRange(ctx context.Context, contract string, from string, to string, chain *string) ([]*model.Event, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
 	if !(strings.HasPrefix(contract, "0x") && len(contract) == 42) {
 		return nil, errors.New("Bad Contract Address")
 	}
@@ -380,10 +514,15 @@ func (r *queryResolver) EventsFromContractByNumberRange(ctx context.Context, con
 		return nil, errors.New("Bad Block Number Range")
 	}
 
-	return getGraphQLCompatibleEvents(ctx, _db.GetEventsFromContractByBlockNumberRange(db, common.HexToAddress(contract), _from, _to))
+	return getGraphQLCompatibleEvents(ctx, _db.GetEventsFromContractByBlockNumberRange(db, _chain.ID, common.HexToAddress(contract), _from, _to))
 }
 
-func (r *queryResolver) EventsFromContractByTimeRange(ctx context.Context, contract string, from string, to string) ([]*model.Event, error) {
+func (r *queryResolver) EventsFromContractByTimeRange(ctx context.Context, contract string, from string, to string, chain *string) ([]*model.Event, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
 	if !(strings.HasPrefix(contract, "0x") && len(contract) == 42) {
 		return nil, errors.New("Bad Contract Address")
 	}
@@ -393,26 +532,41 @@ func (r *queryResolver) EventsFromContractByTimeRange(ctx context.Context, contr
 		return nil, errors.New("Bad Block Timestamp Range")
 	}
 
-	return getGraphQLCompatibleEvents(ctx, _db.GetEventsFromContractByBlockTimeRange(db, common.HexToAddress(contract), _from, _to))
+	return getGraphQLCompatibleEvents(ctx, _db.GetEventsFromContractByBlockTimeRange(db, _chain.ID, common.HexToAddress(contract), _from, _to))
 }
 
-func (r *queryResolver) EventsByBlockHash(ctx context.Context, hash string) ([]*model.Event, error) {
+func (r *queryResolver) EventsByBlockHash(ctx context.Context, hash string, chain *string) ([]*model.Event, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
 	if !(strings.HasPrefix(hash, "0x") && len(hash) == 66) {
 		return nil, errors.New("Bad Block Hash")
 	}
 
-	return getGraphQLCompatibleEvents(ctx, _db.GetEventsByBlockHash(db, common.HexToHash(hash)))
+	return getGraphQLCompatibleEvents(ctx, _db.GetEventsByBlockHash(db, _chain.ID, common.HexToHash(hash)))
 }
 
-func (r *queryResolver) EventsByTxHash(ctx context.Context, hash string) ([]*model.Event, error) {
+func (r *queryResolver) EventsByTxHash(ctx context.Context, hash string, chain *string) ([]*model.Event, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
 	if !(strings.HasPrefix(hash, "0x") && len(hash) == 66) {
 		return nil, errors.New("Bad Transaction Hash")
 	}
 
-	return getGraphQLCompatibleEvents(ctx, _db.GetEventsByTransactionHash(db, common.HexToHash(hash)))
+	return getGraphQLCompatibleEvents(ctx, _db.GetEventsByTransactionHash(db, _chain.ID, common.HexToHash(hash)))
 }
 
-func (r *queryResolver) EventsFromContractWithTopicsByNumberRange(ctx context.Context, contract string, from string, to string, topics []string) ([]*model.Event, error) {
+func (r *queryResolver) EventsFromContractWithTopicsByNumberRange(ctx context.Context, contract string, from string, to string, topics []string, chain *string) ([]*model.Event, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
 	if !(strings.HasPrefix(contract, "0x") && len(contract) == 42) {
 		return nil, errors.New("Bad Contract Address")
 	}
@@ -422,10 +576,15 @@ func (r *queryResolver) EventsFromContractWithTopicsByNumberRange(ctx context.Co
 		return nil, errors.New("Bad Block Number Range")
 	}
 
-	return getGraphQLCompatibleEvents(ctx, _db.GetEventsFromContractWithTopicsByBlockNumberRange(db, common.HexToAddress(contract), _from, _to, cmn.CreateEventTopicMap(FillUpTopicArray(topics))))
+	return getGraphQLCompatibleEvents(ctx, _db.GetEventsFromContractWithTopicsByBlockNumberRange(db, _chain.ID, common.HexToAddress(contract), _from, _to, cmn.CreateEventTopicMap(FillUpTopicArray(topics))))
 }
 
-func (r *queryResolver) EventsFromContractWithTopicsByTimeRange(ctx context.Context, contract string, from string, to string, topics []string) ([]*model.Event, error) {
+func (r *queryResolver) EventsFromContractWithTopicsByTimeRange(ctx context.Context, contract string, from string, to string, topics []string, chain *string) ([]*model.Event, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
 	if !(strings.HasPrefix(contract, "0x") && len(contract) == 42) {
 		return nil, errors.New("Bad Contract Address")
 	}
@@ -435,10 +594,15 @@ func (r *queryResolver) EventsFromContractWithTopicsByTimeRange(ctx context.Cont
 		return nil, errors.New("Bad Block Timestamp Range")
 	}
 
-	return getGraphQLCompatibleEvents(ctx, _db.GetEventsFromContractWithTopicsByBlockTimeRange(db, common.HexToAddress(contract), _from, _to, cmn.CreateEventTopicMap(FillUpTopicArray(topics))))
+	return getGraphQLCompatibleEvents(ctx, _db.GetEventsFromContractWithTopicsByBlockTimeRange(db, _chain.ID, common.HexToAddress(contract), _from, _to, cmn.CreateEventTopicMap(FillUpTopicArray(topics))))
 }
 
-func (r *queryResolver) LastXEventsFromContract(ctx context.Context, contract string, x int) ([]*model.Event, error) {
+func (r *queryResolver) LastXEventsFromContract(ctx context.Context, contract string, x int, chain *string) ([]*model.Event, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
 	if !(strings.HasPrefix(contract, "0x") && len(contract) == 42) {
 		return nil, errors.New("Bad Contract Address")
 	}
@@ -447,10 +611,15 @@ func (r *queryResolver) LastXEventsFromContract(ctx context.Context, contract st
 		return nil, errors.New("Too Many Events Requested")
 	}
 
-	return getGraphQLCompatibleEvents(ctx, _db.GetLastXEventsFromContract(db, common.HexToAddress(contract), x))
+	return getGraphQLCompatibleEvents(ctx, _db.GetLastXEventsFromContract(db, _chain.ID, common.HexToAddress(contract), x))
 }
 
-func (r *queryResolver) EventByBlockHashAndLogIndex(ctx context.Context, hash string, index string) (*model.Event, error) {
+func (r *queryResolver) EventByBlockHashAndLogIndex(ctx context.Context, hash string, index string, chain *string) (*model.Event, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
 	if !(strings.HasPrefix(hash, "0x") && len(hash) == 66) {
 		return nil, errors.New("Bad Block Hash")
 	}
@@ -460,10 +629,15 @@ func (r *queryResolver) EventByBlockHashAndLogIndex(ctx context.Context, hash st
 		return nil, errors.New("Bad Log Index")
 	}
 
-	return getGraphQLCompatibleEvent(ctx, _db.GetEventByBlockHashAndLogIndex(db, common.HexToHash(hash), uint(_index)), true)
+	return getGraphQLCompatibleEvent(ctx, _db.GetEventByBlockHashAndLogIndex(db, _chain.ID, common.HexToHash(hash), uint(_index)), true)
 }
 
-func (r *queryResolver) EventByBlockNumberAndLogIndex(ctx context.Context, number string, index string) (*model.Event, error) {
+func (r *queryResolver) EventByBlockNumberAndLogIndex(ctx context.Context, number string, index string, chain *string) (*model.Event, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
 	_number, err := strconv.ParseUint(number, 10, 64)
 	if err != nil {
 		return nil, errors.New("Bad Block Number")
@@ -474,24 +648,275 @@ func (r *queryResolver) EventByBlockNumberAndLogIndex(ctx context.Context, numbe
 		return nil, errors.New("Bad Log Index")
 	}
 
-	return getGraphQLCompatibleEvent(ctx, _db.GetEventByBlockNumberAndLogIndex(db, _number, uint(_index)), true)
+	return getGraphQLCompatibleEvent(ctx, _db.GetEventByBlockNumberAndLogIndex(db, _chain.ID, _number, uint(_index)), true)
 }
 
-// Query returns generated.QueryResolver implementation.
-func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
-
-type queryResolver struct{ *Resolver }
+func (r *queryResolver) TracesByTxHash(ctx context.Context, hash string, chain *string) ([]*model.Trace, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
 
-// !!! WARNING !!!
-// The code below was going to be deleted when updating resolvers. It has been copied here so you have
-// one last chance to move it out of harms way if you want. There are two reasons this happens:
-//  - When renaming or deleting a resolver the old code will be put in here. You can safely delete
-//    it when you're done.
-//  - You have helper methods in this file. Move them out to keep these resolver files clean.
-func (r *queryResolver) TransactionByHash(ctx context.Context, hash string) (*model.Transaction, error) {
 	if !(strings.HasPrefix(hash, "0x") && len(hash) == 66) {
 		return nil, errors.New("Bad Transaction Hash")
 	}
 
-	return getGraphQLCompatibleTransaction(ctx, _db.GetTransactionByHash(db, common.HexToHash(hash)), true)
+	return getGraphQLCompatibleTraces(ctx, _db.GetTracesByTransactionHash(db, _chain.ID, common.HexToHash(hash)))
+}
+
+func (r *queryResolver) TracesByAccountByNumberRange(ctx context.Context, account string, from string, to string, chain *string) ([]*model.Trace, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
+	if !(strings.HasPrefix(account, "0x") && len(account) == 42) {
+		return nil, errors.New("Bad Account Address")
+	}
+
+	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetBlockNumberRange())
+	if err != nil {
+		return nil, errors.New("Bad Block Number Range")
+	}
+
+	return getGraphQLCompatibleTraces(ctx, _db.GetTracesByAccountByBlockNumberRange(db, _chain.ID, common.HexToAddress(account), _from, _to))
+}
+
+func (r *queryResolver) TracesByAccountByTimeRange(ctx context.Context, account string, from string, to string, chain *string) ([]*model.Trace, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
+	if !(strings.HasPrefix(account, "0x") && len(account) == 42) {
+		return nil, errors.New("Bad Account Address")
+	}
+
+	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetTimeRange())
+	if err != nil {
+		return nil, errors.New("Bad Block Timestamp Range")
+	}
+
+	return getGraphQLCompatibleTraces(ctx, _db.GetTracesByAccountByBlockTimeRange(db, _chain.ID, common.HexToAddress(account), _from, _to))
+}
+
+func (r *queryResolver) WithdrawalsByBlockHash(ctx context.Context, hash string, chain *string) ([]*model.Withdrawal, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
+	if !(strings.HasPrefix(hash, "0x") && len(hash) == 66) {
+		return nil, errors.New("Bad Block Hash")
+	}
+
+	return getGraphQLCompatibleWithdrawals(ctx, _db.GetWithdrawalsByBlockHash(db, _chain.ID, common.HexToHash(hash)))
+}
+
+func (r *queryResolver) WithdrawalsByBlockNumber(ctx context.Context, number string, chain *string) ([]*model.Withdrawal, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
+	_number, err := strconv.ParseUint(number, 10, 64)
+	if err != nil {
+		return nil, errors.New("Bad Block Number")
+	}
+
+	return getGraphQLCompatibleWithdrawals(ctx, _db.GetWithdrawalsByBlockNumber(db, _chain.ID, _number))
+}
+
+func (r *queryResolver) WithdrawalsToAddressByNumberRange(ctx context.Context, address string, from string, to string, chain *string) ([]*model.Withdrawal, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
+	if !(strings.HasPrefix(address, "0x") && len(address) == 42) {
+		return nil, errors.New("Bad Account Address")
+	}
+
+	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetBlockNumberRange())
+	if err != nil {
+		return nil, errors.New("Bad Block Number Range")
+	}
+
+	return getGraphQLCompatibleWithdrawals(ctx, _db.GetWithdrawalsToAddressByBlockNumberRange(db, _chain.ID, common.HexToAddress(address), _from, _to))
+}
+
+func (r *queryResolver) WithdrawalsToAddressByTimeRange(ctx context.Context, address string, from string, to string, chain *string) ([]*model.Withdrawal, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
+	if !(strings.HasPrefix(address, "0x") && len(address) == 42) {
+		return nil, errors.New("Bad Account Address")
+	}
+
+	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetTimeRange())
+	if err != nil {
+		return nil, errors.New("Bad Block Timestamp Range")
+	}
+
+	return getGraphQLCompatibleWithdrawals(ctx, _db.GetWithdrawalsToAddressByBlockTimeRange(db, _chain.ID, common.HexToAddress(address), _from, _to))
+}
+
+func (r *queryResolver) TokenTransfersByNumberRange(ctx context.Context, from string, to string, chain *string) ([]*model.TokenTransfer, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
+	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetBlockNumberRange())
+	if err != nil {
+		return nil, errors.New("Bad Block Number Range")
+	}
+
+	return getGraphQLCompatibleTokenTransfers(ctx, _db.GetTokenTransfersByBlockNumberRange(db, _chain.ID, _from, _to))
+}
+
+func (r *queryResolver) TokenTransfersByTimeRange(ctx context.Context, from string, to string, chain *string) ([]*model.TokenTransfer, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
+	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetTimeRange())
+	if err != nil {
+		return nil, errors.New("Bad Block Timestamp Range")
+	}
+
+	return getGraphQLCompatibleTokenTransfers(ctx, _db.GetTokenTransfersByBlockTimeRange(db, _chain.ID, _from, _to))
+}
+
+func (r *queryResolver) TokenTransfersOfTokenByNumberRange(ctx context.Context, token string, from string, to string, chain *string) ([]*model.TokenTransfer, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
+	if !(strings.HasPrefix(token, "0x") && len(token) == 42) {
+		return nil, errors.New("Bad Token Address")
+	}
+
+	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetBlockNumberRange())
+	if err != nil {
+		return nil, errors.New("Bad Block Number Range")
+	}
+
+	return getGraphQLCompatibleTokenTransfers(ctx, _db.GetTokenTransfersOfTokenByBlockNumberRange(db, _chain.ID, common.HexToAddress(token), _from, _to))
+}
+
+func (r *queryResolver) TokenTransfersOfTokenByTimeRange(ctx context.Context, token string, from string, to string, chain *string) ([]*model.TokenTransfer, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
+	if !(strings.HasPrefix(token, "0x") && len(token) == 42) {
+		return nil, errors.New("Bad Token Address")
+	}
+
+	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetTimeRange())
+	if err != nil {
+		return nil, errors.New("Bad Block Timestamp Range")
+	}
+
+	return getGraphQLCompatibleTokenTransfers(ctx, _db.GetTokenTransfersOfTokenByBlockTimeRange(db, _chain.ID, common.HexToAddress(token), _from, _to))
 }
+
+func (r *queryResolver) TokenTransfersOfHolderByNumberRange(ctx context.Context, holder string, from string, to string, chain *string) ([]*model.TokenTransfer, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
+	if !(strings.HasPrefix(holder, "0x") && len(holder) == 42) {
+		return nil, errors.New("Bad Account Address")
+	}
+
+	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetBlockNumberRange())
+	if err != nil {
+		return nil, errors.New("Bad Block Number Range")
+	}
+
+	return getGraphQLCompatibleTokenTransfers(ctx, _db.GetTokenTransfersOfHolderByBlockNumberRange(db, _chain.ID, common.HexToAddress(holder), _from, _to))
+}
+
+func (r *queryResolver) TokenTransfersOfHolderByTimeRange(ctx context.Context, holder string, from string, to string, chain *string) ([]*model.TokenTransfer, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
+	if !(strings.HasPrefix(holder, "0x") && len(holder) == 42) {
+		return nil, errors.New("Bad Account Address")
+	}
+
+	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetTimeRange())
+	if err != nil {
+		return nil, errors.New("Bad Block Timestamp Range")
+	}
+
+	return getGraphQLCompatibleTokenTransfers(ctx, _db.GetTokenTransfersOfHolderByBlockTimeRange(db, _chain.ID, common.HexToAddress(holder), _from, _to))
+}
+
+func (r *queryResolver) Contract(ctx context.Context, address string, chain *string) (*model.Contract, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
+	if !(strings.HasPrefix(address, "0x") && len(address) == 42) {
+		return nil, errors.New("Bad Contract Address")
+	}
+
+	return getGraphQLCompatibleContract(ctx, _db.GetContract(db, _chain.ID, common.HexToAddress(address)))
+}
+
+func (r *queryResolver) ContractsByCreatorByNumberRange(ctx context.Context, creator string, from string, to string, chain *string) ([]*model.Contract, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
+	if !(strings.HasPrefix(creator, "0x") && len(creator) == 42) {
+		return nil, errors.New("Bad Account Address")
+	}
+
+	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetBlockNumberRange())
+	if err != nil {
+		return nil, errors.New("Bad Block Number Range")
+	}
+
+	return getGraphQLCompatibleContracts(ctx, _db.GetContractsByCreatorByBlockNumberRange(db, _chain.ID, common.HexToAddress(creator), _from, _to))
+}
+
+func (r *queryResolver) ContractsByCreatorByTimeRange(ctx context.Context, creator string, from string, to string, chain *string) ([]*model.Contract, error) {
+	_chain := chainOf(chain)
+	if _chain == nil {
+		return nil, errors.New("Bad Chain")
+	}
+
+	if !(strings.HasPrefix(creator, "0x") && len(creator) == 42) {
+		return nil, errors.New("Bad Account Address")
+	}
+
+	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetTimeRange())
+	if err != nil {
+		return nil, errors.New("Bad Block Timestamp Range")
+	}
+
+	return getGraphQLCompatibleContracts(ctx, _db.GetContractsByCreatorByBlockTimeRange(db, _chain.ID, common.HexToAddress(creator), _from, _to))
+}
+
+// Block returns generated.BlockResolver implementation.
+func (r *Resolver) Block() generated.BlockResolver { return &blockResolver{r} }
+
+// Query returns generated.QueryResolver implementation.
+func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
+
+type blockResolver struct{ *Resolver }
+type queryResolver struct{ *Resolver }
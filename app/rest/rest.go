@@ -2,6 +2,7 @@ package rest
 
 import (
 	"context"
+	"crypto/subtle"
 	"fmt"
 	"log"
 	"net/http"
@@ -12,12 +13,16 @@ import (
 
 	"github.com/gin-contrib/cors"
 
+	blk "github.com/denniswon/validationcloud/app/block"
+	"github.com/denniswon/validationcloud/app/chain"
 	cmn "github.com/denniswon/validationcloud/app/common"
 	cfg "github.com/denniswon/validationcloud/app/config"
 	d "github.com/denniswon/validationcloud/app/data"
 	"github.com/denniswon/validationcloud/app/db"
+	"github.com/denniswon/validationcloud/app/decoder"
 	ps "github.com/denniswon/validationcloud/app/pubsub"
 	"github.com/denniswon/validationcloud/app/rest/graph/generated"
+	"github.com/denniswon/validationcloud/app/watchlist"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/gin-gonic/gin"
 	"github.com/go-redis/redis/v8"
@@ -30,7 +35,11 @@ import (
 )
 
 // RunHTTPServer - Holds definition for all REST API(s) to be exposed
-func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Client) {
+//
+// When blocks are not being indexed in same process, dead letters are read from what
+// indexer persists, while admin API(s) changing state of block processor queue are
+// not served, as that queue is owned by indexer
+func RunHTTPServer(_db *gorm.DB, _chains d.Chains, _redisClient *redis.Client, indexing bool) {
 
 	respondWithJSON := func(data []byte, c *gin.Context) {
 		if data != nil {
@@ -43,6 +52,16 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 		})
 	}
 
+	// Attaching finality status to block(s), as per last known
+	// safe & finalized heads of chain they belong to
+	withFinality := func(blocks ...*d.Block) {
+		for _, v := range blocks {
+			if _chain := _chains.ByID(v.Chain); _chain != nil {
+				v.Finality = _chain.Status.FinalityOf(v.Number)
+			}
+		}
+	}
+
 	// Checking if webserver in production mode or not
 	checkIfInProduction := func() bool {
 		return strings.ToLower(cfg.Get("Production")) == "yes"
@@ -61,44 +80,155 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 	// enabled cors
 	router.Use(cors.Default())
 
-	grp := router.Group("/v1")
+	// Every query is targeting one of chains being indexed, picked using `chain`
+	// query param, either by its name or chain ID, first configured one when not specified
+	grp := router.Group("/v1", func(c *gin.Context) {
+
+		_chain := _chains.Find(c.Query("chain"))
+		if _chain == nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"msg": "Bad chain",
+			})
+			return
+		}
+
+		c.Set("chain", _chain)
+		c.Next()
+
+	})
 
 	{
 
 		// For checking the service's syncing status
 		grp.GET("/synced", func(c *gin.Context) {
 
+			_chain := c.MustGet("chain").(*d.Chain)
+			_status := _chain.Status
+
 			currentBlockNumber := _status.GetLatestBlockNumber()
-			blockCountInDB := _status.BlockCountInDB()
-			remaining := (currentBlockNumber + 1) - blockCountInDB
 			elapsed := _status.ElapsedTime()
 
-			status := fmt.Sprintf("%.2f %%", (float64(blockCountInDB)/float64(currentBlockNumber+1))*100)
+			// Only blocks within window, as per configured `StartBlock` &
+			// `HistoryDepth`, are supposed to be synced
+			lowestBlockNumber := cfg.GetLowestBlockToSync(currentBlockNumber)
+			if prunedBelow := _status.PrunedBelow(); prunedBelow > lowestBlockNumber {
+				lowestBlockNumber = prunedBelow
+			}
+
+			if lowestBlockNumber > currentBlockNumber {
+				lowestBlockNumber = currentBlockNumber
+			}
+
+			blockCountInDB := _status.BlockCountInDB()
+			if lowestBlockNumber != 0 {
+				blockCountInDB = db.GetBlockCountInRange(_db, _chain.ID, lowestBlockNumber, currentBlockNumber)
+			}
+
+			windowSize := currentBlockNumber - lowestBlockNumber + 1
+
+			var remaining uint64
+			if windowSize > blockCountInDB {
+				remaining = windowSize - blockCountInDB
+			}
+
+			status := fmt.Sprintf("%.2f %%", (float64(blockCountInDB)/float64(windowSize))*100)
 			eta := "0s"
 			if remaining > 0 {
 				eta = (time.Duration((elapsed.Seconds()/float64(_status.Done()))*float64(remaining)) * time.Second).String()
 			}
 
+			var deadLettered uint64
+			if indexing {
+				deadLettered = _chain.Queue.Stat().DeadLettered
+			} else {
+				deadLettered = db.GetDeadLetterCount(_db, _chain.ID)
+			}
+
 			c.JSON(http.StatusOK, gin.H{
+				"chain":     _chain.ID,
 				"synced":    status,
 				"processed": _status.Done(),
 				"elapsed":   elapsed.String(),
 				"eta":       eta,
+				"mode":      _status.GetMode(),
+				"from":      lowestBlockNumber,
+				"pruning": gin.H{
+					"enabled":     cfg.IsPruningEnabled(),
+					"prunedBelow": _status.PrunedBelow(),
+					"pruned":      _status.GetBlocksPruned(),
+				},
+				"finality": gin.H{
+					"mode":      _chain.FinalityMode,
+					"safe":      _status.SafeBlockNumber(),
+					"finalized": _status.FinalizedBlockNumber(),
+				},
+				"deadLettered": deadLettered,
 				"status":	_status.State,
 			})
 
 		})
 
+		// Health of blockchain node endpoints, calls are being routed to
+		grp.GET("/nodes", func(c *gin.Context) {
+
+			_connection := c.MustGet("chain").(*d.Chain).Connection
+
+			c.JSON(http.StatusOK, gin.H{
+				"rpc":       chain.StatsOf(_connection.RPC),
+				"websocket": chain.StatsOf(_connection.Websocket),
+			})
+
+		})
+
 		// Query block data using block hash/ number/ block number range ( 10 at max )
 		grp.GET("/block", func(c *gin.Context) {
 
+			_chain := c.MustGet("chain").(*d.Chain)
+
 			hash := c.Query("hash")
 			number := c.Query("number")
 			tx := c.Query("tx")
+			uncles := c.Query("uncles")
+
+			// Given block hash, finds out all uncles referenced by that block
+			if strings.HasPrefix(hash, "0x") && len(hash) == 66 && uncles == "yes" {
+				if uncles := db.GetUnclesByBlockHash(_db, _chain.ID, common.HexToHash(hash)); uncles != nil {
+					respondWithJSON(uncles.ToJSON(), c)
+					return
+				}
+
+				c.JSON(http.StatusNotFound, gin.H{
+					"msg": "Not found",
+				})
+				return
+			}
+
+			// Given block number, finds out all uncles referenced by that block
+			if number != "" && uncles == "yes" {
+
+				_num, err := cmn.ParseNumber(number)
+				if err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{
+						"msg": "Bad block number",
+					})
+					return
+				}
+
+				if uncles := db.GetUnclesByBlockNumber(_db, _chain.ID, _num); uncles != nil {
+					respondWithJSON(uncles.ToJSON(), c)
+					return
+				}
+
+				c.JSON(http.StatusNotFound, gin.H{
+					"msg": "Not found",
+				})
+				return
+			}
 
 			// Block hash based all tx retrieval request handler
 			if strings.HasPrefix(hash, "0x") && len(hash) == 66 && tx == "yes" {
-				if tx := db.GetTransactionsByBlockHash(_db, common.HexToHash(hash)); tx != nil {
+				if tx := db.GetTransactionsByBlockHash(_db, _chain.ID, common.HexToHash(hash)); tx != nil {
+					decoder.Decorate(_db, tx)
 					respondWithJSON(tx.ToJSON(), c)
 					return
 				}
@@ -120,7 +250,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if tx := db.GetTransactionsByBlockNumber(_db, _num); tx != nil {
+				if tx := db.GetTransactionsByBlockNumber(_db, _chain.ID, _num); tx != nil {
+					decoder.Decorate(_db, tx)
 					respondWithJSON(tx.ToJSON(), c)
 					return
 				}
@@ -133,7 +264,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 
 			// Block hash based single block retrieval request handler
 			if strings.HasPrefix(hash, "0x") && len(hash) == 66 {
-				if block := db.GetBlockByHash(_db, common.HexToHash(hash)); block != nil {
+				if block := db.GetBlockByHash(_db, _chain.ID, common.HexToHash(hash)); block != nil {
+					withFinality(block)
 					respondWithJSON(block.ToJSON(), c)
 					return
 				}
@@ -155,7 +287,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if block := db.GetBlockByNumber(_db, _num); block != nil {
+				if block := db.GetBlockByNumber(_db, _chain.ID, _num); block != nil {
+					withFinality(block)
 					respondWithJSON(block.ToJSON(), c)
 					return
 				}
@@ -181,7 +314,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if blocks := db.GetBlocksByNumberRange(_db, _from, _to); blocks != nil {
+				if blocks := db.GetBlocksByNumberRange(_db, _chain.ID, _from, _to); blocks != nil {
+					withFinality(blocks.Blocks...)
 					respondWithJSON(blocks.ToJSON(), c)
 					return
 				}
@@ -207,7 +341,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if blocks := db.GetBlocksByTimeRange(_db, _from, _to); blocks != nil {
+				if blocks := db.GetBlocksByTimeRange(_db, _chain.ID, _from, _to); blocks != nil {
+					withFinality(blocks.Blocks...)
 					respondWithJSON(blocks.ToJSON(), c)
 					return
 				}
@@ -227,11 +362,14 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 		// Transaction fetch ( by query params ) request handler
 		grp.GET("/transaction", func(c *gin.Context) {
 
+			_chain := c.MustGet("chain").(*d.Chain)
+
 			hash := c.Query("hash")
 
 			// Simply returns single tx object, when queried using tx hash
 			if strings.HasPrefix(hash, "0x") && len(hash) == 66 {
-				if tx := db.GetTransactionByHash(_db, common.HexToHash(hash)); tx != nil {
+				if tx := db.GetTransactionByHash(_db, _chain.ID, common.HexToHash(hash)); tx != nil {
+					decoder.Decorate(_db, tx)
 					respondWithJSON(tx.ToJSON(), c)
 					return
 				}
@@ -275,7 +413,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if tx := db.GetTransactionFromAccountWithNonce(_db, common.HexToAddress(fromAccount), _nonce); tx != nil {
+				if tx := db.GetTransactionFromAccountWithNonce(_db, _chain.ID, common.HexToAddress(fromAccount), _nonce); tx != nil {
+					decoder.Decorate(_db, tx)
 					respondWithJSON(tx.ToJSON(), c)
 					return
 				}
@@ -299,7 +438,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if tx := db.GetContractCreationTransactionsFromAccountByBlockNumberRange(_db, common.HexToAddress(deployer), _fromBlock, _toBlock); tx != nil {
+				if tx := db.GetContractCreationTransactionsFromAccountByBlockNumberRange(_db, _chain.ID, common.HexToAddress(deployer), _fromBlock, _toBlock); tx != nil {
+					decoder.Decorate(_db, tx)
 					respondWithJSON(tx.ToJSON(), c)
 					return
 				}
@@ -323,7 +463,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if tx := db.GetContractCreationTransactionsFromAccountByBlockTimeRange(_db, common.HexToAddress(deployer), _fromTime, _toTime); tx != nil {
+				if tx := db.GetContractCreationTransactionsFromAccountByBlockTimeRange(_db, _chain.ID, common.HexToAddress(deployer), _fromTime, _toTime); tx != nil {
+					decoder.Decorate(_db, tx)
 					respondWithJSON(tx.ToJSON(), c)
 					return
 				}
@@ -347,7 +488,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if tx := db.GetTransactionsBetweenAccountsByBlockNumberRange(_db, common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _fromBlock, _toBlock); tx != nil {
+				if tx := db.GetTransactionsBetweenAccountsByBlockNumberRange(_db, _chain.ID, common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _fromBlock, _toBlock); tx != nil {
+					decoder.Decorate(_db, tx)
 					respondWithJSON(tx.ToJSON(), c)
 					return
 				}
@@ -371,7 +513,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if tx := db.GetTransactionsBetweenAccountsByBlockTimeRange(_db, common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _fromTime, _toTime); tx != nil {
+				if tx := db.GetTransactionsBetweenAccountsByBlockTimeRange(_db, _chain.ID, common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _fromTime, _toTime); tx != nil {
+					decoder.Decorate(_db, tx)
 					respondWithJSON(tx.ToJSON(), c)
 					return
 				}
@@ -395,7 +538,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if tx := db.GetTransactionsFromAccountByBlockNumberRange(_db, common.HexToAddress(fromAccount), _fromBlock, _toBlock); tx != nil {
+				if tx := db.GetTransactionsFromAccountByBlockNumberRange(_db, _chain.ID, common.HexToAddress(fromAccount), _fromBlock, _toBlock); tx != nil {
+					decoder.Decorate(_db, tx)
 					respondWithJSON(tx.ToJSON(), c)
 					return
 				}
@@ -419,7 +563,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if tx := db.GetTransactionsFromAccountByBlockTimeRange(_db, common.HexToAddress(fromAccount), _fromTime, _toTime); tx != nil {
+				if tx := db.GetTransactionsFromAccountByBlockTimeRange(_db, _chain.ID, common.HexToAddress(fromAccount), _fromTime, _toTime); tx != nil {
+					decoder.Decorate(_db, tx)
 					respondWithJSON(tx.ToJSON(), c)
 					return
 				}
@@ -443,7 +588,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if tx := db.GetTransactionsToAccountByBlockNumberRange(_db, common.HexToAddress(toAccount), _fromBlock, _toBlock); tx != nil {
+				if tx := db.GetTransactionsToAccountByBlockNumberRange(_db, _chain.ID, common.HexToAddress(toAccount), _fromBlock, _toBlock); tx != nil {
+					decoder.Decorate(_db, tx)
 					respondWithJSON(tx.ToJSON(), c)
 					return
 				}
@@ -467,7 +613,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if tx := db.GetTransactionsToAccountByBlockTimeRange(_db, common.HexToAddress(toAccount), _fromTime, _toTime); tx != nil {
+				if tx := db.GetTransactionsToAccountByBlockTimeRange(_db, _chain.ID, common.HexToAddress(toAccount), _fromTime, _toTime); tx != nil {
+					decoder.Decorate(_db, tx)
 					respondWithJSON(tx.ToJSON(), c)
 					return
 				}
@@ -488,6 +635,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 		// Event(s) fetched by query params handler end point
 		grp.GET("/event", func(c *gin.Context) {
 
+			_chain := c.MustGet("chain").(*d.Chain)
+
 			fromBlock := c.Query("fromBlock")
 			toBlock := c.Query("toBlock")
 
@@ -524,7 +673,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if event := db.GetEventByBlockHashAndLogIndex(_db, common.HexToHash(blockHash), uint(_logIndex)); event != nil {
+				if event := db.GetEventByBlockHashAndLogIndex(_db, _chain.ID, common.HexToHash(blockHash), uint(_logIndex)); event != nil {
+					decoder.Decorate(_db, event)
 					respondWithJSON(event.ToJSON(), c)
 					return
 				}
@@ -556,7 +706,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if event := db.GetEventByBlockNumberAndLogIndex(_db, _blockNumber, uint(_logIndex)); event != nil {
+				if event := db.GetEventByBlockNumberAndLogIndex(_db, _chain.ID, _blockNumber, uint(_logIndex)); event != nil {
+					decoder.Decorate(_db, event)
 					respondWithJSON(event.ToJSON(), c)
 					return
 				}
@@ -571,7 +722,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 			// Given blockhash, retrieves all events emitted by tx present in block
 			if strings.HasPrefix(blockHash, "0x") && len(blockHash) == 66 {
 
-				if event := db.GetEventsByBlockHash(_db, common.HexToHash(blockHash)); event != nil {
+				if event := db.GetEventsByBlockHash(_db, _chain.ID, common.HexToHash(blockHash)); event != nil {
+					decoder.Decorate(_db, event)
 					respondWithJSON(event.ToJSON(), c)
 					return
 				}
@@ -586,7 +738,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 			// Given txhash, retrieves all events emitted by that tx ( i.e. during tx execution )
 			if strings.HasPrefix(txHash, "0x") && len(txHash) == 66 {
 
-				if event := db.GetEventsByTransactionHash(_db, common.HexToHash(txHash)); event != nil {
+				if event := db.GetEventsByTransactionHash(_db, _chain.ID, common.HexToHash(txHash)); event != nil {
+					decoder.Decorate(_db, event)
 					respondWithJSON(event.ToJSON(), c)
 					return
 				}
@@ -616,7 +769,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if event := db.GetLastXEventsFromContract(_db, common.HexToAddress(contract), _count); event != nil {
+				if event := db.GetLastXEventsFromContract(_db, _chain.ID, common.HexToAddress(contract), _count); event != nil {
+					decoder.Decorate(_db, event)
 					respondWithJSON(event.ToJSON(), c)
 					return
 				}
@@ -652,8 +806,9 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 
 				}
 
-				if event := db.GetEventsFromContractWithTopicsByBlockNumberRange(_db, common.HexToAddress(contract), _fromBlock, _toBlock, topics); event != nil {
+				if event := db.GetEventsFromContractWithTopicsByBlockNumberRange(_db, _chain.ID, common.HexToAddress(contract), _fromBlock, _toBlock, topics); event != nil {
 
+					decoder.Decorate(_db, event)
 					respondWithJSON(event.ToJSON(), c)
 					return
 
@@ -690,8 +845,9 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 
 				}
 
-				if event := db.GetEventsFromContractWithTopicsByBlockTimeRange(_db, common.HexToAddress(contract), _fromTime, _toTime, topics); event != nil {
+				if event := db.GetEventsFromContractWithTopicsByBlockTimeRange(_db, _chain.ID, common.HexToAddress(contract), _fromTime, _toTime, topics); event != nil {
 
+					decoder.Decorate(_db, event)
 					respondWithJSON(event.ToJSON(), c)
 					return
 
@@ -715,7 +871,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if event := db.GetEventsFromContractByBlockNumberRange(_db, common.HexToAddress(contract), _fromBlock, _toBlock); event != nil {
+				if event := db.GetEventsFromContractByBlockNumberRange(_db, _chain.ID, common.HexToAddress(contract), _fromBlock, _toBlock); event != nil {
+					decoder.Decorate(_db, event)
 					respondWithJSON(event.ToJSON(), c)
 					return
 				}
@@ -739,7 +896,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if event := db.GetEventsFromContractByBlockTimeRange(_db, common.HexToAddress(contract), _fromTime, _toTime); event != nil {
+				if event := db.GetEventsFromContractByBlockTimeRange(_db, _chain.ID, common.HexToAddress(contract), _fromTime, _toTime); event != nil {
+					decoder.Decorate(_db, event)
 					respondWithJSON(event.ToJSON(), c)
 					return
 				}
@@ -757,6 +915,817 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 
 		})
 
+		// Calls made during tx execution, queried either using tx hash or
+		// account, which made/ received calls, along with block number/ time range
+		grp.GET("/trace", func(c *gin.Context) {
+
+			_chain := c.MustGet("chain").(*d.Chain)
+
+			txHash := c.Query("txHash")
+			account := c.Query("account")
+
+			fromBlock := c.Query("fromBlock")
+			toBlock := c.Query("toBlock")
+
+			fromTime := c.Query("fromTime")
+			toTime := c.Query("toTime")
+
+			// Given tx hash, returns all calls made during its execution
+			if strings.HasPrefix(txHash, "0x") && len(txHash) == 66 {
+
+				if traces := db.GetTracesByTransactionHash(_db, _chain.ID, common.HexToHash(txHash)); traces != nil {
+					respondWithJSON(traces.ToJSON(), c)
+					return
+				}
+
+				c.JSON(http.StatusNotFound, gin.H{
+					"msg": "Not found",
+				})
+				return
+
+			}
+
+			// Given block number range & account, returns all calls made by/ to account
+			if fromBlock != "" && toBlock != "" && strings.HasPrefix(account, "0x") && len(account) == 42 {
+
+				_fromBlock, _toBlock, err := cmn.RangeChecker(fromBlock, toBlock, cfg.GetBlockNumberRange())
+				if err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{
+						"msg": "Bad block number range",
+					})
+					return
+				}
+
+				if traces := db.GetTracesByAccountByBlockNumberRange(_db, _chain.ID, common.HexToAddress(account), _fromBlock, _toBlock); traces != nil {
+					respondWithJSON(traces.ToJSON(), c)
+					return
+				}
+
+				c.JSON(http.StatusNotFound, gin.H{
+					"msg": "Not found",
+				})
+				return
+
+			}
+
+			// Given block time range & account, returns all calls made by/ to account
+			if fromTime != "" && toTime != "" && strings.HasPrefix(account, "0x") && len(account) == 42 {
+
+				_fromTime, _toTime, err := cmn.RangeChecker(fromTime, toTime, cfg.GetTimeRange())
+				if err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{
+						"msg": "Bad block time range",
+					})
+					return
+				}
+
+				if traces := db.GetTracesByAccountByBlockTimeRange(_db, _chain.ID, common.HexToAddress(account), _fromTime, _toTime); traces != nil {
+					respondWithJSON(traces.ToJSON(), c)
+					return
+				}
+
+				c.JSON(http.StatusNotFound, gin.H{
+					"msg": "Not found",
+				})
+				return
+
+			}
+
+			c.JSON(http.StatusBadRequest, gin.H{
+				"msg": "Bad query param(s)",
+			})
+
+		})
+
+		// Validator withdrawals, queried either using block hash/ number they were processed in
+		// or recipient address, along with block number/ time range
+		grp.GET("/withdrawal", func(c *gin.Context) {
+
+			_chain := c.MustGet("chain").(*d.Chain)
+
+			blockHash := c.Query("blockHash")
+			blockNumber := c.Query("blockNumber")
+			address := c.Query("address")
+
+			fromBlock := c.Query("fromBlock")
+			toBlock := c.Query("toBlock")
+
+			fromTime := c.Query("fromTime")
+			toTime := c.Query("toTime")
+
+			// Given block hash, returns all withdrawals processed in that block
+			if strings.HasPrefix(blockHash, "0x") && len(blockHash) == 66 {
+
+				if withdrawals := db.GetWithdrawalsByBlockHash(_db, _chain.ID, common.HexToHash(blockHash)); withdrawals != nil {
+					respondWithJSON(withdrawals.ToJSON(), c)
+					return
+				}
+
+				c.JSON(http.StatusNotFound, gin.H{
+					"msg": "Not found",
+				})
+				return
+
+			}
+
+			// Given block number, returns all withdrawals processed in that block
+			if blockNumber != "" {
+
+				_num, err := cmn.ParseNumber(blockNumber)
+				if err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{
+						"msg": "Bad block number",
+					})
+					return
+				}
+
+				if withdrawals := db.GetWithdrawalsByBlockNumber(_db, _chain.ID, _num); withdrawals != nil {
+					respondWithJSON(withdrawals.ToJSON(), c)
+					return
+				}
+
+				c.JSON(http.StatusNotFound, gin.H{
+					"msg": "Not found",
+				})
+				return
+
+			}
+
+			// Given block number range & recipient address, returns all withdrawals credited to it
+			if fromBlock != "" && toBlock != "" && strings.HasPrefix(address, "0x") && len(address) == 42 {
+
+				_fromBlock, _toBlock, err := cmn.RangeChecker(fromBlock, toBlock, cfg.GetBlockNumberRange())
+				if err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{
+						"msg": "Bad block number range",
+					})
+					return
+				}
+
+				if withdrawals := db.GetWithdrawalsToAddressByBlockNumberRange(_db, _chain.ID, common.HexToAddress(address), _fromBlock, _toBlock); withdrawals != nil {
+					respondWithJSON(withdrawals.ToJSON(), c)
+					return
+				}
+
+				c.JSON(http.StatusNotFound, gin.H{
+					"msg": "Not found",
+				})
+				return
+
+			}
+
+			// Given block time range & recipient address, returns all withdrawals credited to it
+			if fromTime != "" && toTime != "" && strings.HasPrefix(address, "0x") && len(address) == 42 {
+
+				_fromTime, _toTime, err := cmn.RangeChecker(fromTime, toTime, cfg.GetTimeRange())
+				if err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{
+						"msg": "Bad block time range",
+					})
+					return
+				}
+
+				if withdrawals := db.GetWithdrawalsToAddressByBlockTimeRange(_db, _chain.ID, common.HexToAddress(address), _fromTime, _toTime); withdrawals != nil {
+					respondWithJSON(withdrawals.ToJSON(), c)
+					return
+				}
+
+				c.JSON(http.StatusNotFound, gin.H{
+					"msg": "Not found",
+				})
+				return
+
+			}
+
+			c.JSON(http.StatusBadRequest, gin.H{
+				"msg": "Bad query param(s)",
+			})
+
+		})
+
+		// Token transfers decoded from standard ERC-20/ 721/ 1155 transfer events, queried using
+		// block number/ time range, optionally narrowed down to token or holder
+		grp.GET("/transfer", func(c *gin.Context) {
+
+			_chain := c.MustGet("chain").(*d.Chain)
+
+			token := c.Query("token")
+			holder := c.Query("holder")
+
+			fromBlock := c.Query("fromBlock")
+			toBlock := c.Query("toBlock")
+
+			fromTime := c.Query("fromTime")
+			toTime := c.Query("toTime")
+
+			if token != "" && !(strings.HasPrefix(token, "0x") && len(token) == 42) {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad token address",
+				})
+				return
+			}
+
+			if holder != "" && !(strings.HasPrefix(holder, "0x") && len(holder) == 42) {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad holder address",
+				})
+				return
+			}
+
+			var transfers *d.TokenTransfers
+
+			switch {
+
+			// Given block number range, returns token transfers made in that range
+			case fromBlock != "" && toBlock != "":
+
+				_fromBlock, _toBlock, err := cmn.RangeChecker(fromBlock, toBlock, cfg.GetBlockNumberRange())
+				if err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{
+						"msg": "Bad block number range",
+					})
+					return
+				}
+
+				if token != "" {
+					transfers = db.GetTokenTransfersOfTokenByBlockNumberRange(_db, _chain.ID, common.HexToAddress(token), _fromBlock, _toBlock)
+				} else if holder != "" {
+					transfers = db.GetTokenTransfersOfHolderByBlockNumberRange(_db, _chain.ID, common.HexToAddress(holder), _fromBlock, _toBlock)
+				} else {
+					transfers = db.GetTokenTransfersByBlockNumberRange(_db, _chain.ID, _fromBlock, _toBlock)
+				}
+
+			// Given block time range, returns token transfers made in that time span
+			case fromTime != "" && toTime != "":
+
+				_fromTime, _toTime, err := cmn.RangeChecker(fromTime, toTime, cfg.GetTimeRange())
+				if err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{
+						"msg": "Bad block time range",
+					})
+					return
+				}
+
+				if token != "" {
+					transfers = db.GetTokenTransfersOfTokenByBlockTimeRange(_db, _chain.ID, common.HexToAddress(token), _fromTime, _toTime)
+				} else if holder != "" {
+					transfers = db.GetTokenTransfersOfHolderByBlockTimeRange(_db, _chain.ID, common.HexToAddress(holder), _fromTime, _toTime)
+				} else {
+					transfers = db.GetTokenTransfersByBlockTimeRange(_db, _chain.ID, _fromTime, _toTime)
+				}
+
+			default:
+
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad query param(s)",
+				})
+				return
+
+			}
+
+			if transfers != nil {
+				respondWithJSON(transfers.ToJSON(), c)
+				return
+			}
+
+			c.JSON(http.StatusNotFound, gin.H{
+				"msg": "Not found",
+			})
+
+		})
+
+		// Query contract using its address, or contracts deployed by creator in
+		// block number range/ time span
+		grp.GET("/contract", func(c *gin.Context) {
+
+			_chain := c.MustGet("chain").(*d.Chain)
+
+			address := c.Query("address")
+			creator := c.Query("creator")
+
+			fromBlock := c.Query("fromBlock")
+			toBlock := c.Query("toBlock")
+
+			fromTime := c.Query("fromTime")
+			toTime := c.Query("toTime")
+
+			// Given contract address, returns its creator, creation tx & implementation,
+			// if it's a proxy
+			if strings.HasPrefix(address, "0x") && len(address) == 42 {
+
+				if contract := db.GetContract(_db, _chain.ID, common.HexToAddress(address)); contract != nil {
+					respondWithJSON(contract.ToJSON(), c)
+					return
+				}
+
+				c.JSON(http.StatusNotFound, gin.H{
+					"msg": "Not found",
+				})
+				return
+
+			}
+
+			// Given block number range & creator address, returns all contracts deployed by it
+			if fromBlock != "" && toBlock != "" && strings.HasPrefix(creator, "0x") && len(creator) == 42 {
+
+				_fromBlock, _toBlock, err := cmn.RangeChecker(fromBlock, toBlock, cfg.GetBlockNumberRange())
+				if err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{
+						"msg": "Bad block number range",
+					})
+					return
+				}
+
+				if contracts := db.GetContractsByCreatorByBlockNumberRange(_db, _chain.ID, common.HexToAddress(creator), _fromBlock, _toBlock); contracts != nil {
+					respondWithJSON(contracts.ToJSON(), c)
+					return
+				}
+
+				c.JSON(http.StatusNotFound, gin.H{
+					"msg": "Not found",
+				})
+				return
+
+			}
+
+			// Given block time range & creator address, returns all contracts deployed by it
+			if fromTime != "" && toTime != "" && strings.HasPrefix(creator, "0x") && len(creator) == 42 {
+
+				_fromTime, _toTime, err := cmn.RangeChecker(fromTime, toTime, cfg.GetTimeRange())
+				if err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{
+						"msg": "Bad block time range",
+					})
+					return
+				}
+
+				if contracts := db.GetContractsByCreatorByBlockTimeRange(_db, _chain.ID, common.HexToAddress(creator), _fromTime, _toTime); contracts != nil {
+					respondWithJSON(contracts.ToJSON(), c)
+					return
+				}
+
+				c.JSON(http.StatusNotFound, gin.H{
+					"msg": "Not found",
+				})
+				return
+
+			}
+
+			c.JSON(http.StatusBadRequest, gin.H{
+				"msg": "Bad query param(s)",
+			})
+
+		})
+
+		// Chain reorganization(s) detected by the service, queried using block number range
+		// of first orphaned block
+		grp.GET("/reorg", func(c *gin.Context) {
+
+			_chain := c.MustGet("chain").(*d.Chain)
+
+			fromBlock := c.Query("fromBlock")
+			toBlock := c.Query("toBlock")
+
+			if fromBlock != "" && toBlock != "" {
+
+				_from, _to, err := cmn.RangeChecker(fromBlock, toBlock, cfg.GetBlockNumberRange())
+				if err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{
+						"msg": "Bad block number range",
+					})
+					return
+				}
+
+				if reorgs := db.GetReorgsByBlockNumberRange(_db, _chain.ID, _from, _to); reorgs != nil {
+					respondWithJSON(reorgs.ToJSON(), c)
+					return
+				}
+
+				c.JSON(http.StatusNotFound, gin.H{
+					"msg": "Not found",
+				})
+				return
+
+			}
+
+			c.JSON(http.StatusBadRequest, gin.H{
+				"msg": "Bad query param(s)",
+			})
+
+		})
+
+	}
+
+	// Admin API(s) are only enabled when `AdminToken` is set in config & every request
+	// needs to carry it as bearer token
+	admin := router.Group("/v1/admin", func(c *gin.Context) {
+
+		token := cfg.Get("AdminToken")
+		if token == "" {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"msg": "Admin API disabled",
+			})
+			return
+		}
+
+		supplied := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(supplied), []byte(token)) != 1 {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"msg": "Bad admin token",
+			})
+			return
+		}
+
+		c.Next()
+
+	})
+
+	{
+
+		// Contract ABI(s) used for decoding calldata of tx(s) sent to contract &
+		// event logs emitted by it, registered/ queried/ removed by contract address
+		admin.POST("/abi", func(c *gin.Context) {
+
+			address := c.Query("address")
+			if !(strings.HasPrefix(address, "0x") && len(address) == 42) {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad contract address",
+				})
+				return
+			}
+
+			body, err := c.GetRawData()
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Failed to read ABI",
+				})
+				return
+			}
+
+			if _, err := decoder.Parse(body); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": fmt.Sprintf("Bad ABI : %s", err.Error()),
+				})
+				return
+			}
+
+			if err := decoder.Register(_db, common.HexToAddress(address), body); err != nil {
+				log.Printf("[!] Failed to register ABI : %s\n", err.Error())
+
+				c.JSON(http.StatusInternalServerError, gin.H{
+					"msg": "Failed to register ABI",
+				})
+				return
+			}
+
+			c.JSON(http.StatusOK, gin.H{
+				"msg": "Registered ABI",
+			})
+
+		})
+
+		admin.GET("/abi", func(c *gin.Context) {
+
+			address := c.Query("address")
+			if !(strings.HasPrefix(address, "0x") && len(address) == 42) {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad contract address",
+				})
+				return
+			}
+
+			if abi := db.GetABI(_db, common.HexToAddress(address)); abi != nil {
+				respondWithJSON(abi.ToJSON(), c)
+				return
+			}
+
+			c.JSON(http.StatusNotFound, gin.H{
+				"msg": "Not found",
+			})
+
+		})
+
+		admin.DELETE("/abi", func(c *gin.Context) {
+
+			address := c.Query("address")
+			if !(strings.HasPrefix(address, "0x") && len(address) == 42) {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad contract address",
+				})
+				return
+			}
+
+			removed, err := decoder.Unregister(_db, common.HexToAddress(address))
+			if err != nil {
+				log.Printf("[!] Failed to remove ABI : %s\n", err.Error())
+
+				c.JSON(http.StatusInternalServerError, gin.H{
+					"msg": "Failed to remove ABI",
+				})
+				return
+			}
+
+			if !removed {
+				c.JSON(http.StatusNotFound, gin.H{
+					"msg": "Not found",
+				})
+				return
+			}
+
+			c.JSON(http.StatusOK, gin.H{
+				"msg": "Removed ABI",
+			})
+
+		})
+
+		// Method & event signatures used for guessing what tx(s)/ event(s) of contracts,
+		// without registered ABI, are doing, imported line by line from request body
+		admin.POST("/signatures", func(c *gin.Context) {
+
+			count, err := decoder.ImportSignatures(c.Request.Body)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": fmt.Sprintf("Bad signature(s) : %s", err.Error()),
+				})
+				return
+			}
+
+			c.JSON(http.StatusOK, gin.H{
+				"msg":      "Imported signatures",
+				"imported": count,
+			})
+
+		})
+
+		// Addresses being watched, when running in watchlist mode, added/ queried/
+		// removed by address, without address whole watchlist is returned
+		admin.POST("/watchlist", func(c *gin.Context) {
+
+			address := c.Query("address")
+			if !(strings.HasPrefix(address, "0x") && len(address) == 42) {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad address",
+				})
+				return
+			}
+
+			if err := watchlist.Add(_db, common.HexToAddress(address), c.Query("label")); err != nil {
+				log.Printf("[!] Failed to add address to watchlist : %s\n", err.Error())
+
+				c.JSON(http.StatusInternalServerError, gin.H{
+					"msg": "Failed to add address to watchlist",
+				})
+				return
+			}
+
+			c.JSON(http.StatusOK, gin.H{
+				"msg": "Added address to watchlist",
+			})
+
+		})
+
+		admin.GET("/watchlist", func(c *gin.Context) {
+
+			address := c.Query("address")
+
+			if address == "" {
+
+				if watched := db.GetWatchlist(_db); watched != nil {
+					respondWithJSON(watched.ToJSON(), c)
+					return
+				}
+
+				c.JSON(http.StatusInternalServerError, gin.H{
+					"msg": "Failed to read watchlist",
+				})
+				return
+
+			}
+
+			if !(strings.HasPrefix(address, "0x") && len(address) == 42) {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad address",
+				})
+				return
+			}
+
+			if watched := db.GetWatchedAddress(_db, common.HexToAddress(address)); watched != nil {
+				respondWithJSON(watched.ToJSON(), c)
+				return
+			}
+
+			c.JSON(http.StatusNotFound, gin.H{
+				"msg": "Not found",
+			})
+
+		})
+
+		admin.DELETE("/watchlist", func(c *gin.Context) {
+
+			address := c.Query("address")
+			if !(strings.HasPrefix(address, "0x") && len(address) == 42) {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad address",
+				})
+				return
+			}
+
+			removed, err := watchlist.Remove(_db, common.HexToAddress(address))
+			if err != nil {
+				log.Printf("[!] Failed to remove address from watchlist : %s\n", err.Error())
+
+				c.JSON(http.StatusInternalServerError, gin.H{
+					"msg": "Failed to remove address from watchlist",
+				})
+				return
+			}
+
+			if !removed {
+				c.JSON(http.StatusNotFound, gin.H{
+					"msg": "Not found",
+				})
+				return
+			}
+
+			c.JSON(http.StatusOK, gin.H{
+				"msg": "Removed address from watchlist",
+			})
+
+		})
+
+		// Blocks given up on, after failing `MaxAttempts` times, of chain picked using
+		// `chain` query param, listed/ requeued/ discarded by block number
+		admin.GET("/deadletters", func(c *gin.Context) {
+
+			_chain := _chains.Find(c.Query("chain"))
+			if _chain == nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad chain",
+				})
+				return
+			}
+
+			if indexing {
+				c.JSON(http.StatusOK, gin.H{
+					"chain":       _chain.ID,
+					"deadLetters": _chain.Queue.DeadLetters(),
+				})
+				return
+			}
+
+			deadLetters, err := db.GetDeadLetters(_db, _chain.ID)
+			if err != nil {
+				log.Printf("[!] Failed to read dead letters : %s\n", err.Error())
+
+				c.JSON(http.StatusInternalServerError, gin.H{
+					"msg": "Failed to read dead letters",
+				})
+				return
+			}
+
+			c.JSON(http.StatusOK, gin.H{
+				"chain":       _chain.ID,
+				"deadLetters": deadLetters,
+			})
+
+		})
+
+		// Requeued/ discarded block would never reach indexer, running in other process
+		if indexing {
+
+			admin.POST("/deadletters", func(c *gin.Context) {
+
+				_chain := _chains.Find(c.Query("chain"))
+				if _chain == nil {
+					c.JSON(http.StatusBadRequest, gin.H{
+						"msg": "Bad chain",
+					})
+					return
+				}
+
+				_num, err := strconv.ParseUint(c.Query("number"), 10, 64)
+				if err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{
+						"msg": "Bad block number",
+					})
+					return
+				}
+
+				if !_chain.Queue.Requeue(_num) {
+					c.JSON(http.StatusNotFound, gin.H{
+						"msg": "Not found",
+					})
+					return
+				}
+
+				c.JSON(http.StatusOK, gin.H{
+					"msg": "Requeued block",
+				})
+
+			})
+
+			admin.DELETE("/deadletters", func(c *gin.Context) {
+
+				_chain := _chains.Find(c.Query("chain"))
+				if _chain == nil {
+					c.JSON(http.StatusBadRequest, gin.H{
+						"msg": "Bad chain",
+					})
+					return
+				}
+
+				_num, err := strconv.ParseUint(c.Query("number"), 10, 64)
+				if err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{
+						"msg": "Bad block number",
+					})
+					return
+				}
+
+				if !_chain.Queue.Discard(_num) {
+					c.JSON(http.StatusNotFound, gin.H{
+						"msg": "Not found",
+					})
+					return
+				}
+
+				c.JSON(http.StatusOK, gin.H{
+					"msg": "Discarded block",
+				})
+
+			})
+
+		}
+
+		// Integrity of stored blocks of chain, picked using `chain` query param, checked by
+		// rebuilding their tx & receipt tries, along with parent hash links, in block number
+		// range, inconsistent ones can be requeued, to be processed afresh
+		integrity := func(c *gin.Context, requeue bool) {
+
+			_chain := _chains.Find(c.Query("chain"))
+			if _chain == nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad chain",
+				})
+				return
+			}
+
+			_from, _to, err := cmn.RangeChecker(c.Query("fromBlock"), c.Query("toBlock"), cfg.GetBlockNumberRange())
+			if err != nil || _from > _to {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad block number range",
+				})
+				return
+			}
+
+			mismatches, unverifiable, err := blk.CheckIntegrity(_db, _chain.ID, _from, _to)
+			if err != nil {
+				log.Printf("[!] Failed to check integrity of blocks : %s\n", err.Error())
+
+				c.JSON(http.StatusInternalServerError, gin.H{
+					"msg": "Failed to check integrity",
+				})
+				return
+			}
+
+			if !requeue {
+				c.JSON(http.StatusOK, gin.H{
+					"chain":        _chain.ID,
+					"inconsistent": mismatches,
+					"unverifiable": unverifiable,
+				})
+				return
+			}
+
+			requeued, err := blk.RequeueInconsistentBlocks(_db, _chain.ID, _chain.Queue, mismatches)
+			if err != nil {
+				log.Printf("[!] Failed to requeue inconsistent blocks : %s\n", err.Error())
+
+				c.JSON(http.StatusInternalServerError, gin.H{
+					"msg": "Failed to requeue",
+				})
+				return
+			}
+
+			c.JSON(http.StatusOK, gin.H{
+				"chain":        _chain.ID,
+				"inconsistent": mismatches,
+				"unverifiable": unverifiable,
+				"requeued":     requeued,
+			})
+
+		}
+
+		admin.GET("/integrity", func(c *gin.Context) {
+			integrity(c, false)
+		})
+
+		if indexing {
+			admin.POST("/integrity", func(c *gin.Context) {
+				integrity(c, true)
+			})
+		}
+
 	}
 
 	router.GET("/v1/ws", func(c *gin.Context) {
@@ -798,6 +1767,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 		pubsubManager := ps.SubscriptionManager{
 			Topics:     make(map[string]map[string]*ps.SubscriptionRequest),
 			Consumers:  make(map[string]ps.Consumer),
+			Chains:     _chains,
 			Client:     _redisClient,
 			Connection: conn,
 			DB:         _db,
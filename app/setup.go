@@ -8,59 +8,180 @@ import (
 	cfg "github.com/denniswon/validationcloud/app/config"
 	d "github.com/denniswon/validationcloud/app/data"
 	"github.com/denniswon/validationcloud/app/db"
+	"github.com/denniswon/validationcloud/app/decoder"
 	q "github.com/denniswon/validationcloud/app/queue"
 	"github.com/denniswon/validationcloud/app/rest/graph"
+	"github.com/denniswon/validationcloud/app/watchlist"
 	"github.com/go-redis/redis/v8"
 	"gorm.io/gorm"
 )
 
 // Setting ground up i.e. acquiring resources required & determining with
 // some basic checks whether we can proceed to next step or not
-func bootstrap(configFile string) (*d.BlockChainNodeConnection, *redis.Client, *d.RedisInfo, *gorm.DB, *d.StatusHolder, *q.BlockProcessorQueue) {
+//
+// Each configured chain gets its own node connections, sync state holder, pubsub
+// topics & block processor queue, while database & redis server are shared
+func bootstrap(configFile string) (d.Chains, *redis.Client, *gorm.DB) {
+
+	bootstrapConfig(configFile)
+
+	_redisClient := bootstrapRedis(true)
+	_db := bootstrapDB()
+	_chains := bootstrapChains(_db, _redisClient, true)
+
+	return _chains, _redisClient, _db
+}
+
+// bootstrapConfig - Reads configuration, along with signatures to be used for
+// decoding, needed by every command
+func bootstrapConfig(configFile string) {
 
 	err := cfg.Read(configFile)
 	if err != nil {
 		log.Fatalf("[!] Failed to read `.env` : %s\n", err.Error())
 	}
 
-	// Maintaining both HTTP & Websocket based connection to blockchain
-	_connection := &d.BlockChainNodeConnection{
-		RPC:       getClient(true),
-		Websocket: getClient(false),
+	// Signatures shipped along with binary are extended with ones
+	// found in local file, if any
+	if file := cfg.Get("SignatureFile"); file != "" {
+
+		count, err := decoder.ImportSignatureFile(file)
+		if err != nil {
+			log.Fatalf("[!] Failed to import signatures : %s\n", err.Error())
+		}
+
+		log.Printf("[+] Imported %d signatures from %s\n", count, file)
+
 	}
 
+}
+
+// bootstrapRedis - Connects to redis server, used for publishing & subscribing to
+// real time notifications
+//
+// All keys are flushed only when asked to i.e. by process doing indexing, others
+// must not wipe redis under indexer, which might be running
+func bootstrapRedis(flush bool) *redis.Client {
+
 	_redisClient := getRedisClient()
 
 	if _redisClient == nil {
 		log.Fatalf("[!] Failed to connect to Redis Server\n")
 	}
 
+	if !flush {
+		return _redisClient
+	}
+
 	if err := _redisClient.FlushAll(context.Background()).Err(); err != nil {
 		log.Printf("[!] Failed to flush all keys from redis : %s\n", err.Error())
 	}
 
+	return _redisClient
+
+}
+
+// bootstrapDB - Connects to database, while migrating schema to latest
+func bootstrapDB() *gorm.DB {
+
 	_db := db.Connect()
 
+	// Addresses listed in local file, if any, are put in watchlist, which
+	// can be edited later using admin API
+	if file := cfg.Get("WatchlistFile"); file != "" {
+
+		count, err := watchlist.ImportFile(_db, file)
+		if err != nil {
+			log.Fatalf("[!] Failed to import watchlist : %s\n", err.Error())
+		}
+
+		log.Printf("[+] Imported %d address(es) into watchlist from %s\n", count, file)
+
+	}
+
 	// Passing db handle to graph for resolving graphQL queries
 	graph.GetDatabaseConnection(_db)
 
-	_status := &d.StatusHolder{
-		State: &d.SyncState{
-			BlockCountAtStartUp:     db.GetBlockCount(_db),
-			MaxBlockNumberAtStartUp: db.GetCurrentBlockNumber(_db),
-		},
-		Mutex: &sync.RWMutex{},
-	}
+	return _db
+
+}
+
+// bootstrapChains - Connects to blockchain nodes of all configured chains
+//
+// Block processor queue picks up from where it left off during previous run, only
+// when asked to, otherwise it starts empty, without its state being persisted,
+// which is what one-off commands need, as they must not take over blocks
+// being processed by long running indexer
+//
+// Redis client can be nil, when nothing is to be published
+func bootstrapChains(_db *gorm.DB, _redisClient *redis.Client, restore bool) d.Chains {
+
+	_chains := make(d.Chains, 0)
+
+	for k, v := range cfg.GetChains() {
+
+		// Maintaining both HTTP & Websocket based connection to blockchain
+		_connection := &d.BlockChainNodeConnection{
+			RPC:       getClient(v.Name, v.RPCUrls, true),
+			Websocket: getClient(v.Name, v.WebsocketUrls, false),
+		}
+
+		chainID := getChainID(_connection.RPC, v)
+		if _chains.ByID(chainID) != nil {
+			log.Fatalf("[!] Chain %d configured more than once\n", chainID)
+		}
+
+		_connection.ChainID = chainID
+
+		// Data indexed before multiple chains were supported, belongs
+		// to first configured chain
+		if k == 0 {
+			if err := db.AdoptLegacyRows(_db, chainID); err != nil {
+				log.Fatalf("[!] Failed to adopt already indexed data : %s\n", err.Error())
+			}
+		}
+
+		_status := &d.StatusHolder{
+			State: &d.SyncState{
+				BlockCountAtStartUp:     db.GetBlockCount(_db, chainID),
+				MaxBlockNumberAtStartUp: db.GetCurrentBlockNumber(_db, chainID),
+			},
+			Mutex: &sync.RWMutex{},
+		}
+
+		_queue := q.New(db.GetCurrentBlockNumber(_db, chainID), v.IsFinalityTagsEnabled())
+
+		// block processor queue, picking up from where it left off during
+		// previous run, so that blocks waiting for confirmation aren't forgotten
+		if restore {
+
+			if err := _queue.Restore(&db.QueueStore{DB: _db, ChainID: chainID}); err != nil {
+				log.Fatalf("[!] Failed to restore block processor queue : %s\n", err.Error())
+			}
+
+			if count := len(_queue.Blocks); count != 0 {
+				log.Printf("[+] Restored %d block(s) into processor queue\n", count)
+			}
+
+		}
+
+		_chains = append(_chains, &d.Chain{
+			ID:           chainID,
+			Name:         v.Name,
+			FinalityMode: v.FinalityMode,
+			Connection:   _connection,
+			Status:       _status,
+			Redis:        d.NewRedisInfo(_redisClient, chainID),
+			Queue:        _queue,
+		})
+
+		log.Printf("[+] Connected to chain %s\n", _chains[len(_chains)-1].Label())
 
-	_redisInfo := &d.RedisInfo{
-		Client:            _redisClient,
-		BlockPublishTopic: "block",
-		TxPublishTopic:    "transaction",
-		EventPublishTopic: "event",
 	}
 
-	// block processor queue
-	_queue := q.New(db.GetCurrentBlockNumber(_db))
+	// Passing chains to graph for targeting queries & telling finality of blocks
+	graph.GetChains(_chains)
+
+	return _chains
 
-	return _connection, _redisClient, _redisInfo, _db, _status, _queue
 }
@@ -129,22 +129,22 @@ func SigRSV(isig interface{}) ([32]byte, [32]byte, uint8) {
 }
 
 func TransactionSender( block *types.Block, tx *types.Transaction) (common.Address, error) {
-	// Supports EIP-2930 and EIP-2718 and EIP-1559 and EIP-155 and legacy transactions
-    message, err := tx.AsMessage(types.LatestSignerForChainID(tx.ChainId()), block.BaseFee())
-
-	if err != nil {
-		message, err = tx.AsMessage(types.NewEIP2930Signer(tx.ChainId()), block.BaseFee())
-		if err != nil {
-			message, err = tx.AsMessage(types.NewEIP155Signer(tx.ChainId()), block.BaseFee())
-			if err != nil {
-				message, err = tx.AsMessage(types.HomesteadSigner{}, block.BaseFee())
-				if err != nil {
-					return common.Address{}, err
-				}
-			}
+	// Supports EIP-4844 and EIP-2930 and EIP-2718 and EIP-1559 and EIP-155 and legacy transactions
+	signers := []types.Signer{
+		types.LatestSignerForChainID(tx.ChainId()),
+		types.NewEIP2930Signer(tx.ChainId()),
+		types.NewEIP155Signer(tx.ChainId()),
+		types.HomesteadSigner{},
+	}
+
+	var sender common.Address
+	var err error
+
+	for _, signer := range signers {
+		if sender, err = types.Sender(signer, tx); err == nil {
+			return sender, nil
 		}
 	}
-	// log.Printf("tx as msg[ block : %d ] : %v\n", block.NumberU64(), message)
-	sender := message.From()
-	return sender, nil
+
+	return common.Address{}, err
 }
\ No newline at end of file
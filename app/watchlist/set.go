@@ -0,0 +1,114 @@
+package watchlist
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Set - Addresses being watched, not modified once built, so that same set
+// can be shared among blocks being processed concurrently
+type Set struct {
+	addresses map[common.Address]struct{}
+}
+
+// NewSet - Builds set of watched addresses
+func NewSet(addresses ...common.Address) *Set {
+
+	set := &Set{addresses: make(map[common.Address]struct{}, len(addresses))}
+
+	for _, v := range addresses {
+		set.addresses[v] = struct{}{}
+	}
+
+	return set
+
+}
+
+// Len - Number of addresses being watched
+func (s *Set) Len() int {
+
+	if s == nil {
+		return 0
+	}
+
+	return len(s.addresses)
+
+}
+
+// Contains - Checks whether address is being watched
+func (s *Set) Contains(address common.Address) bool {
+
+	if s == nil {
+		return false
+	}
+
+	_, ok := s.addresses[address]
+	return ok
+
+}
+
+// ContainsHex - Checks whether hex encoded address is being watched,
+// empty/ malformed ones never are
+func (s *Set) ContainsHex(address string) bool {
+
+	if !(strings.HasPrefix(address, "0x") && len(address) == 42) {
+		return false
+	}
+
+	return s.Contains(common.HexToAddress(address))
+
+}
+
+// MatchesBloom - Checks whether any of watched addresses may have emitted log
+// or may have been put in log topic, as per logs bloom of block/ receipt
+//
+// False positives are possible, but if it says no, none of logs can match
+func (s *Set) MatchesBloom(bloom types.Bloom) bool {
+
+	if s == nil {
+		return false
+	}
+
+	for v := range s.addresses {
+
+		if types.BloomLookup(bloom, v) || types.BloomLookup(bloom, common.BytesToHash(v.Bytes())) {
+			return true
+		}
+
+	}
+
+	return false
+
+}
+
+// MatchesEvent - Checks whether event is emitted by any of watched addresses
+// or any of them is put in one of its indexed topics
+//
+// First topic is looked at too, because in anonymous event, it's an indexed
+// argument, not event signature, same as `MatchesBloom` does
+func (s *Set) MatchesEvent(origin string, topics []string) bool {
+
+	if s.ContainsHex(origin) {
+		return true
+	}
+
+	for _, v := range topics {
+
+		topic := common.HexToHash(v)
+
+		// Addresses are left padded with 12 zero bytes
+		if common.BytesToHash(topic[12:]) != topic {
+			continue
+		}
+
+		if s.Contains(common.BytesToAddress(topic[12:])) {
+			return true
+		}
+
+	}
+
+	return false
+
+}
@@ -0,0 +1,62 @@
+package watchlist
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestSet(t *testing.T) {
+
+	watched := common.HexToAddress("0xcb3fA413B23b12E402Cfcd8FA120f983FB70d8E8")
+	other := common.HexToAddress("0x1")
+
+	set := NewSet(watched)
+
+	if !set.Contains(watched) || set.Contains(other) {
+		t.Fatal("expected only watched address to be contained")
+	}
+
+	if !set.ContainsHex(watched.Hex()) || set.ContainsHex("") || set.ContainsHex("0x1234") {
+		t.Fatal("expected only well formed watched address to be contained")
+	}
+
+	var empty *Set
+	if empty.Len() != 0 || empty.Contains(watched) || empty.MatchesBloom(types.Bloom{}) {
+		t.Fatal("expected nil set to match nothing")
+	}
+
+	signature := common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef").Hex()
+
+	for _, v := range []struct {
+		name    string
+		origin  string
+		topics  []string
+		matches bool
+	}{
+		{"emitted", watched.Hex(), []string{signature}, true},
+		{"indexed", other.Hex(), []string{signature, common.BytesToHash(other.Bytes()).Hex(), common.BytesToHash(watched.Bytes()).Hex()}, true},
+		{"unrelated", other.Hex(), []string{signature, common.BytesToHash(other.Bytes()).Hex()}, false},
+		// Anonymous event, first topic is indexed argument
+		{"anonymous", other.Hex(), []string{common.BytesToHash(watched.Bytes()).Hex()}, true},
+		// Not left padded with zero bytes, so not an address
+		{"not-address", other.Hex(), []string{signature, "0x01" + strings.Repeat("00", 11) + watched.Hex()[2:]}, false},
+	} {
+
+		if set.MatchesEvent(v.origin, v.topics) != v.matches {
+			t.Errorf("%s : expected match to be %v", v.name, v.matches)
+		}
+
+	}
+
+	emitted := types.CreateBloom(types.Receipts{{Logs: []*types.Log{{Address: watched}}}})
+	indexed := types.CreateBloom(types.Receipts{{Logs: []*types.Log{{Address: other, Topics: []common.Hash{common.BytesToHash(watched.Bytes())}}}}})
+	unrelated := types.CreateBloom(types.Receipts{{Logs: []*types.Log{{Address: other}}}})
+
+	if !set.MatchesBloom(emitted) || !set.MatchesBloom(indexed) || set.MatchesBloom(unrelated) {
+		t.Fatal("expected bloom to match only when watched address emitted/ got indexed in log")
+	}
+
+}
@@ -0,0 +1,162 @@
+package watchlist
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/denniswon/validationcloud/app/db"
+	"github.com/ethereum/go-ethereum/common"
+	"gorm.io/gorm"
+)
+
+// refreshInterval - For how long watchlist is used, before reading it from DB
+// again, so that addresses added via some other instance of service also
+// get picked up
+const refreshInterval = time.Minute
+
+var (
+	current   *Set
+	fetchedAt time.Time
+	lock      sync.RWMutex
+)
+
+// reload - Reads watchlist from DB & puts it in cache, on failure
+// previously read watchlist keeps being used
+func reload(_db *gorm.DB) *Set {
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	watchlist := db.GetWatchlist(_db)
+	if watchlist == nil {
+
+		log.Printf("[!] Failed to read watchlist, using last known one\n")
+
+		fetchedAt = time.Now()
+		return current
+
+	}
+
+	addresses := make([]common.Address, len(watchlist.Addresses))
+	for k, v := range watchlist.Addresses {
+		addresses[k] = common.HexToAddress(v.Address)
+	}
+
+	current = NewSet(addresses...)
+	fetchedAt = time.Now()
+
+	return current
+
+}
+
+// Current - Addresses being watched, as read from DB, at max `refreshInterval` ago
+func Current(_db *gorm.DB) *Set {
+
+	lock.RLock()
+	set, at := current, fetchedAt
+	lock.RUnlock()
+
+	if set != nil && time.Since(at) < refreshInterval {
+		return set
+	}
+
+	return reload(_db)
+
+}
+
+// Add - Puts address in watchlist, so that tx(s) & event(s) touching it, in
+// blocks processed from now on, get indexed
+func Add(_db *gorm.DB, address common.Address, label string) error {
+
+	if err := db.UpsertWatchedAddress(_db, &db.Watchlist{
+		Address: address.Hex(),
+		Label:   label,
+		AddedAt: uint64(time.Now().UTC().Unix()),
+	}); err != nil {
+		return err
+	}
+
+	reload(_db)
+	return nil
+
+}
+
+// Remove - Takes address out of watchlist, returns whether it was
+// being watched or not
+func Remove(_db *gorm.DB, address common.Address) (bool, error) {
+
+	removed, err := db.DeleteWatchedAddress(_db, address)
+	if err != nil {
+		return false, err
+	}
+
+	reload(_db)
+	return removed, nil
+
+}
+
+// Import - Reads addresses line by line & puts them in watchlist, returns how
+// many got imported
+//
+// Each line is address, optionally followed by label, separated by whitespace,
+// empty lines & lines starting with `#` are skipped
+func Import(_db *gorm.DB, reader io.Reader) (int, error) {
+
+	scanner := bufio.NewScanner(reader)
+
+	var count, line int
+
+	for scanner.Scan() {
+
+		line++
+
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		fields := strings.Fields(text)
+		if !common.IsHexAddress(fields[0]) {
+			return count, fmt.Errorf("line %d : bad address %q", line, fields[0])
+		}
+
+		if err := db.UpsertWatchedAddress(_db, &db.Watchlist{
+			Address: common.HexToAddress(fields[0]).Hex(),
+			Label:   strings.Join(fields[1:], " "),
+			AddedAt: uint64(time.Now().UTC().Unix()),
+		}); err != nil {
+			return count, fmt.Errorf("line %d : %s", line, err.Error())
+		}
+
+		count++
+
+	}
+
+	if err := scanner.Err(); err != nil {
+		return count, err
+	}
+
+	reload(_db)
+	return count, nil
+
+}
+
+// ImportFile - Imports addresses from local file into watchlist
+func ImportFile(_db *gorm.DB, file string) (int, error) {
+
+	fd, err := os.Open(file)
+	if err != nil {
+		return 0, err
+	}
+
+	defer fd.Close()
+
+	return Import(_db, fd)
+
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"log"
+	"os"
 	"path/filepath"
 
 	"github.com/denniswon/validationcloud/app"
@@ -12,5 +13,5 @@ func main() {
 	if err != nil {
 		log.Fatalf("[!] Failed to find `.env` : %s\n", err.Error())
 	}
-	app.Run(configFile)
+	app.Execute(configFile, os.Args[1:])
 }